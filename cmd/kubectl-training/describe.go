@@ -0,0 +1,245 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// maxPodFailures is the number of the recent pod failures shown by describe.
+const maxPodFailures = 5
+
+// podFailure is a failed container or pod of a job.
+type podFailure struct {
+	time      metav1.Time
+	pod       string
+	replica   string
+	container string
+	reason    string
+	exitCode  string
+	message   string
+}
+
+// describe prints the replica statuses, the conditions and the recent pod failures of a job.
+func (c *cli) describe(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("describe")
+	ref, err := c.parseJob(fs, args)
+	if err != nil {
+		return err
+	}
+	_, job, err := c.findJob(ctx, ref)
+	if err != nil {
+		return err
+	}
+	pods, err := c.jobPods(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", job.GetName())
+	fmt.Fprintf(w, "Namespace:\t%s\n", job.GetNamespace())
+	fmt.Fprintf(w, "Kind:\t%s\n", job.kind)
+	fmt.Fprintf(w, "State:\t%s\n", jobState(job.status))
+	fmt.Fprintf(w, "Age:\t%s\n", age(job.GetCreationTimestamp()))
+	if job.status.StartTime != nil {
+		fmt.Fprintf(w, "Start Time:\t%s\n", job.status.StartTime.UTC().Format(timeFormat))
+	}
+	if job.status.CompletionTime != nil {
+		fmt.Fprintf(w, "Completion Time:\t%s\n", job.status.CompletionTime.UTC().Format(timeFormat))
+	}
+	if job.runPolicy.Suspend != nil && *job.runPolicy.Suspend {
+		fmt.Fprintln(w, "Suspended:\ttrue")
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, "\nReplicas:")
+	w = tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "  TYPE\tDESIRED\tACTIVE\tREADY\tSUCCEEDED\tFAILED\tRESTARTS")
+	for _, rType := range sortedReplicaTypes(job.replicaSpecs) {
+		desired := "-"
+		if spec := job.replicaSpecs[rType]; spec != nil && spec.Replicas != nil {
+			desired = fmt.Sprint(*spec.Replicas)
+		}
+		status := job.status.ReplicaStatuses[rType]
+		if status == nil {
+			status = &kubeflowv1.ReplicaStatus{}
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%d\t%d\t%d\n", rType, desired, status.Active, status.Ready,
+			status.Succeeded, status.Failed, status.Restarts)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, "\nConditions:")
+	if len(job.status.Conditions) == 0 {
+		fmt.Fprintln(c.out, "  <none>")
+	} else {
+		w = tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tLAST TRANSITION\tMESSAGE")
+		for _, condition := range job.status.Conditions {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason,
+				condition.LastTransitionTime.UTC().Format(timeFormat), condition.Message)
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if details := job.status.FailureDetails; details != nil {
+		fmt.Fprintln(c.out, "\nFailure:")
+		w = tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+		fmt.Fprintf(w, "  Replica Type:\t%s\n", details.ReplicaType)
+		fmt.Fprintf(w, "  Pod:\t%s\n", details.PodName)
+		if len(details.ContainerName) != 0 {
+			fmt.Fprintf(w, "  Container:\t%s\n", details.ContainerName)
+		}
+		if details.ExitCode != nil {
+			fmt.Fprintf(w, "  Exit Code:\t%d\n", *details.ExitCode)
+		}
+		fmt.Fprintf(w, "  Reason:\t%s\n", details.Reason)
+		if len(details.Message) != 0 {
+			fmt.Fprintf(w, "  Message:\t%s\n", strings.TrimSpace(details.Message))
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(c.out, "\nRecent Pod Failures:")
+	failures := podFailures(pods)
+	if len(failures) == 0 {
+		fmt.Fprintln(c.out, "  <none>")
+		return nil
+	}
+	if len(failures) > maxPodFailures {
+		failures = failures[:maxPodFailures]
+	}
+	w = tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "  TIME\tREPLICA\tPOD\tCONTAINER\tREASON\tEXIT CODE\tMESSAGE")
+	for _, failure := range failures {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", failure.time.UTC().Format(timeFormat), failure.replica,
+			failure.pod, failure.container, failure.reason, failure.exitCode, lastLine(failure.message))
+	}
+	return w.Flush()
+}
+
+// timeFormat is the format of the times shown by describe.
+const timeFormat = "2006-01-02T15:04:05Z"
+
+// jobPods returns the pods of the job, sorted by replica type and index.
+func (c *cli) jobPods(ctx context.Context, job *trainingJob) ([]corev1.Pod, error) {
+	selector := labels.Set{kubeflowv1.JobNameLabel: job.GetName()}.AsSelector().String()
+	podList, err := c.kube.CoreV1().Pods(job.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pods of %s: %w", job.GetName(), err)
+	}
+	pods := podList.Items
+	sort.Slice(pods, func(i, j int) bool {
+		if replicaName(&pods[i]) != replicaName(&pods[j]) {
+			return replicaName(&pods[i]) < replicaName(&pods[j])
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
+
+// replicaName returns the replica type and the index of the pod, e.g. worker-0.
+func replicaName(pod *corev1.Pod) string {
+	rType, index := pod.Labels[kubeflowv1.ReplicaTypeLabel], pod.Labels[kubeflowv1.ReplicaIndexLabel]
+	switch {
+	case len(rType) == 0:
+		return pod.Name
+	case len(index) == 0:
+		return rType
+	}
+	return rType + "-" + index
+}
+
+// podFailures returns the failed containers and pods, the most recent first.
+func podFailures(pods []corev1.Pod) []podFailure {
+	var failures []podFailure
+	for i := range pods {
+		pod := &pods[i]
+		containerFailed := false
+		for _, status := range pod.Status.ContainerStatuses {
+			for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+				terminated := state.Terminated
+				if terminated == nil || terminated.ExitCode == 0 {
+					continue
+				}
+				containerFailed = true
+				failures = append(failures, podFailure{
+					time:      terminated.FinishedAt,
+					pod:       pod.Name,
+					replica:   replicaName(pod),
+					container: status.Name,
+					reason:    terminated.Reason,
+					exitCode:  fmt.Sprint(terminated.ExitCode),
+					message:   terminated.Message,
+				})
+			}
+		}
+		if pod.Status.Phase == corev1.PodFailed && !containerFailed {
+			// The pod failed without a failed container, e.g. it was evicted.
+			failureTime := pod.CreationTimestamp
+			if pod.Status.StartTime != nil {
+				failureTime = *pod.Status.StartTime
+			}
+			failures = append(failures, podFailure{
+				time:    failureTime,
+				pod:     pod.Name,
+				replica: replicaName(pod),
+				reason:  pod.Status.Reason,
+				message: pod.Status.Message,
+			})
+		}
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[j].time.Before(&failures[i].time)
+	})
+	return failures
+}
+
+// lastLine returns the last line of the message, the termination messages usually end with the error.
+func lastLine(message string) string {
+	message = strings.TrimSpace(message)
+	if i := strings.LastIndexByte(message, '\n'); i >= 0 {
+		return message[i+1:]
+	}
+	return message
+}
+
+func sortedReplicaTypes(replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec) []kubeflowv1.ReplicaType {
+	rTypes := make([]kubeflowv1.ReplicaType, 0, len(replicas))
+	for rType := range replicas {
+		rTypes = append(rTypes, rType)
+	}
+	sort.Slice(rTypes, func(i, j int) bool { return rTypes[i] < rTypes[j] })
+	return rTypes
+}
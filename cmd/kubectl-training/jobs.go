@@ -0,0 +1,215 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/client/clientset/versioned"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// trainingJob is a job of any kind with the fields the kinds have in common.
+type trainingJob struct {
+	metav1.Object
+	kind         string
+	replicaSpecs map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec
+	runPolicy    *kubeflowv1.RunPolicy
+	status       *kubeflowv1.JobStatus
+}
+
+func newTrainingJob(obj runtime.Object) (*trainingJob, error) {
+	switch job := obj.(type) {
+	case *kubeflowv1.TFJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.TFJobKind, replicaSpecs: job.Spec.TFReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	case *kubeflowv1.PyTorchJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.PyTorchJobKind, replicaSpecs: job.Spec.PyTorchReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	case *kubeflowv1.MXJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.MXJobKind, replicaSpecs: job.Spec.MXReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	case *kubeflowv1.XGBoostJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.XGBoostJobKind, replicaSpecs: job.Spec.XGBReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	case *kubeflowv1.MPIJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.MPIJobKind, replicaSpecs: job.Spec.MPIReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	case *kubeflowv1.PaddleJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.PaddleJobKind, replicaSpecs: job.Spec.PaddleReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	case *kubeflowv1.JAXJob:
+		return &trainingJob{Object: job, kind: kubeflowv1.JAXJobKind, replicaSpecs: job.Spec.JAXReplicaSpecs,
+			runPolicy: &job.Spec.RunPolicy, status: &job.Status}, nil
+	}
+	return nil, fmt.Errorf("unexpected job type %T", obj)
+}
+
+// jobState returns the state of the job shown by the commands, the type of its latest true condition.
+func jobState(status *kubeflowv1.JobStatus) string {
+	switch {
+	case commonutil.IsSucceeded(*status):
+		return string(kubeflowv1.JobSucceeded)
+	case commonutil.IsFailed(*status):
+		return string(kubeflowv1.JobFailed)
+	}
+	for i := len(status.Conditions) - 1; i >= 0; i-- {
+		if status.Conditions[i].Status == corev1.ConditionTrue {
+			return string(status.Conditions[i].Type)
+		}
+	}
+	return "Pending"
+}
+
+// jobInterface is implemented by the typed clients of the generated clientset.
+type jobInterface[J runtime.Object, L runtime.Object] interface {
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (J, error)
+	Update(ctx context.Context, job J, opts metav1.UpdateOptions) (J, error)
+}
+
+// jobKind reads and updates the jobs of a kind.
+type jobKind struct {
+	kind   string
+	plural string
+	list   func(ctx context.Context, namespace string) ([]*trainingJob, error)
+	get    func(ctx context.Context, namespace, name string) (*trainingJob, error)
+	update func(ctx context.Context, job *trainingJob) error
+}
+
+func newJobKind[J runtime.Object, L runtime.Object](kind, plural string, client func(namespace string) jobInterface[J, L]) jobKind {
+	return jobKind{
+		kind:   kind,
+		plural: plural,
+		list: func(ctx context.Context, namespace string) ([]*trainingJob, error) {
+			list, err := client(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return nil, err
+			}
+			jobs := make([]*trainingJob, 0, len(items))
+			for _, item := range items {
+				job, err := newTrainingJob(item)
+				if err != nil {
+					return nil, err
+				}
+				jobs = append(jobs, job)
+			}
+			return jobs, nil
+		},
+		get: func(ctx context.Context, namespace, name string) (*trainingJob, error) {
+			job, err := client(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return newTrainingJob(job)
+		},
+		update: func(ctx context.Context, job *trainingJob) error {
+			_, err := client(job.GetNamespace()).Update(ctx, job.Object.(J), metav1.UpdateOptions{})
+			return err
+		},
+	}
+}
+
+// jobKinds returns the kinds of jobs managed by the training operator.
+func jobKinds(c versioned.Interface) []jobKind {
+	v1 := c.KubeflowV1()
+	return []jobKind{
+		newJobKind(kubeflowv1.TFJobKind, kubeflowv1.TFJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.TFJob, *kubeflowv1.TFJobList] { return v1.TFJobs(ns) }),
+		newJobKind(kubeflowv1.PyTorchJobKind, kubeflowv1.PyTorchJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.PyTorchJob, *kubeflowv1.PyTorchJobList] {
+				return v1.PyTorchJobs(ns)
+			}),
+		newJobKind(kubeflowv1.MXJobKind, kubeflowv1.MXJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.MXJob, *kubeflowv1.MXJobList] { return v1.MXJobs(ns) }),
+		newJobKind(kubeflowv1.XGBoostJobKind, kubeflowv1.XGBoostJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.XGBoostJob, *kubeflowv1.XGBoostJobList] {
+				return v1.XGBoostJobs(ns)
+			}),
+		newJobKind(kubeflowv1.MPIJobKind, kubeflowv1.MPIJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.MPIJob, *kubeflowv1.MPIJobList] { return v1.MPIJobs(ns) }),
+		newJobKind(kubeflowv1.PaddleJobKind, kubeflowv1.PaddleJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.PaddleJob, *kubeflowv1.PaddleJobList] {
+				return v1.PaddleJobs(ns)
+			}),
+		newJobKind(kubeflowv1.JAXJobKind, kubeflowv1.JAXJobPlural,
+			func(ns string) jobInterface[*kubeflowv1.JAXJob, *kubeflowv1.JAXJobList] { return v1.JAXJobs(ns) }),
+	}
+}
+
+// matches checks whether the kind is referenced by the name, e.g. PyTorchJob, pytorchjob or pytorchjobs.
+func (k jobKind) matches(name string) bool {
+	return strings.EqualFold(name, k.kind) || strings.EqualFold(name, k.plural)
+}
+
+// findJob returns the job referenced by the argument of a command, either <kind>/<name> or the name
+// of a job of any kind.
+func (c *cli) findJob(ctx context.Context, ref string) (*jobKind, *trainingJob, error) {
+	kindName, name, found := strings.Cut(ref, "/")
+	if !found {
+		kindName, name = "", ref
+	}
+	var (
+		matchedKind *jobKind
+		matchedJob  *trainingJob
+	)
+	kinds := jobKinds(c.training)
+	for i := range kinds {
+		kind := &kinds[i]
+		if len(kindName) != 0 && !kind.matches(kindName) {
+			continue
+		}
+		job, err := kind.get(ctx, c.namespace, name)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if matchedJob != nil {
+			return nil, nil, fmt.Errorf("%q matches a %s and a %s, use <kind>/<name>", name, matchedKind.kind, kind.kind)
+		}
+		matchedKind, matchedJob = kind, job
+	}
+	if matchedJob == nil {
+		if len(kindName) != 0 && !c.isJobKind(kindName) {
+			return nil, nil, fmt.Errorf("unknown kind %q", kindName)
+		}
+		return nil, nil, fmt.Errorf("job %q not found in namespace %q", ref, c.namespace)
+	}
+	return matchedKind, matchedJob, nil
+}
+
+func (c *cli) isJobKind(name string) bool {
+	for _, kind := range jobKinds(c.training) {
+		if kind.matches(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// list prints the jobs of all kinds with their state.
+func (c *cli) list(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("list")
+	allNamespaces := fs.Bool("all-namespaces", false, "List the jobs of all namespaces.")
+	fs.BoolVar(allNamespaces, "A", false, "Shorthand for --all-namespaces.")
+	kindName := fs.String("kind", "", "List the jobs of this kind only, e.g. pytorchjob.")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return fmt.Errorf("list takes no arguments")
+	}
+	if len(*kindName) != 0 && !c.isJobKind(*kindName) {
+		return fmt.Errorf("unknown kind %q", *kindName)
+	}
+	namespace := c.namespace
+	if *allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	var jobs []*trainingJob
+	for _, kind := range jobKinds(c.training) {
+		if len(*kindName) != 0 && !kind.matches(*kindName) {
+			continue
+		}
+		kindJobs, err := kind.list(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to list the %s: %w", kind.plural, err)
+		}
+		jobs = append(jobs, kindJobs...)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].GetNamespace() != jobs[j].GetNamespace() {
+			return jobs[i].GetNamespace() < jobs[j].GetNamespace()
+		}
+		if jobs[i].GetName() != jobs[j].GetName() {
+			return jobs[i].GetName() < jobs[j].GetName()
+		}
+		return jobs[i].kind < jobs[j].kind
+	})
+
+	if len(jobs) == 0 {
+		if *allNamespaces {
+			fmt.Fprintln(c.out, "No jobs found.")
+		} else {
+			fmt.Fprintf(c.out, "No jobs found in %s namespace.\n", namespace)
+		}
+		return nil
+	}
+	w := tabwriter.NewWriter(c.out, 0, 8, 3, ' ', 0)
+	if *allNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
+	fmt.Fprintln(w, "NAME\tKIND\tSTATE\tAGE")
+	for _, job := range jobs {
+		if *allNamespaces {
+			fmt.Fprintf(w, "%s\t", job.GetNamespace())
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", job.GetName(), job.kind, jobState(job.status), age(job.GetCreationTimestamp()))
+	}
+	return w.Flush()
+}
+
+// age returns the time since the timestamp like kubectl.
+func age(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(timestamp.Time))
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// logs prints the logs of the pods of all the replicas of a job, each line prefixed with the replica,
+// e.g. [worker-0].
+func (c *cli) logs(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("logs")
+	follow := fs.Bool("follow", false, "Stream the logs of the replicas.")
+	fs.BoolVar(follow, "f", false, "Shorthand for --follow.")
+	tail := fs.Int64("tail", -1, "Lines of the recent logs of each replica to print, all the logs if negative.")
+	container := fs.String("container", "", "Print the logs of this container, defaults to the container of the framework.")
+	fs.StringVar(container, "c", "", "Shorthand for --container.")
+	replicaType := fs.String("replica-type", "", "Print the logs of the replicas of this type only, e.g. worker.")
+	ref, err := c.parseJob(fs, args)
+	if err != nil {
+		return err
+	}
+	_, job, err := c.findJob(ctx, ref)
+	if err != nil {
+		return err
+	}
+	pods, err := c.jobPods(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	var selected []corev1.Pod
+	for _, pod := range pods {
+		if len(*replicaType) != 0 && !strings.EqualFold(pod.Labels[kubeflowv1.ReplicaTypeLabel], *replicaType) {
+			continue
+		}
+		selected = append(selected, pod)
+	}
+	if len(selected) == 0 {
+		return fmt.Errorf("no pods found for %s %s", job.kind, job.GetName())
+	}
+
+	out := &prefixWriter{out: c.out}
+	stream := func(pod *corev1.Pod) error {
+		opts := &corev1.PodLogOptions{Follow: *follow, Container: *container}
+		if len(opts.Container) == 0 && len(pod.Spec.Containers) > 1 {
+			opts.Container = defaultContainer(pod)
+		}
+		if *tail >= 0 {
+			opts.TailLines = tail
+		}
+		logs, err := c.kube.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+		if err != nil {
+			return fmt.Errorf("[%s] %w", replicaName(pod), err)
+		}
+		defer logs.Close()
+		return out.copy(replicaName(pod), logs)
+	}
+
+	if !*follow {
+		// Print the replicas one after the other.
+		var errs []error
+		for i := range selected {
+			if err = stream(&selected[i]); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for i := range selected {
+		wg.Add(1)
+		go func(pod *corev1.Pod) {
+			defer wg.Done()
+			if err := stream(pod); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(&selected[i])
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// defaultContainer returns the container running the training code, the one annotated with
+// kubectl.kubernetes.io/default-container or the first container.
+func defaultContainer(pod *corev1.Pod) string {
+	if name, ok := pod.Annotations["kubectl.kubernetes.io/default-container"]; ok {
+		return name
+	}
+	for _, container := range pod.Spec.Containers {
+		switch container.Name {
+		case kubeflowv1.TFJobDefaultContainerName, kubeflowv1.PyTorchJobDefaultContainerName,
+			kubeflowv1.MXJobDefaultContainerName, kubeflowv1.XGBoostJobDefaultContainerName,
+			kubeflowv1.MPIJobDefaultContainerName, kubeflowv1.PaddleJobDefaultContainerName,
+			kubeflowv1.JAXJobDefaultContainerName:
+			return container.Name
+		}
+	}
+	return pod.Spec.Containers[0].Name
+}
+
+// prefixWriter writes the lines of the logs of the replicas with the replica as prefix.
+type prefixWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *prefixWriter) copy(prefix string, logs io.Reader) error {
+	reader := bufio.NewReader(logs)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) != 0 {
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n"
+			}
+			w.mu.Lock()
+			_, writeErr := fmt.Fprintf(w.out, "[%s] %s", prefix, line)
+			w.mu.Unlock()
+			if writeErr != nil {
+				return writeErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("[%s] %w", prefix, err)
+		}
+	}
+}
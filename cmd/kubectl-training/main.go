@@ -0,0 +1,160 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// kubectl-training is a kubectl plugin managing the jobs of the training operator,
+// run as `kubectl training <command>`.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"k8s.io/client-go/kubernetes"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeflow/training-operator/pkg/client/clientset/versioned"
+)
+
+const usage = `kubectl training manages the jobs of the training operator.
+
+Usage:
+  kubectl training <command> [flags] [arguments]
+
+Commands:
+  list                  List the jobs of all kinds
+  describe <job>        Show the replicas, conditions and recent pod failures of a job
+  logs <job>            Print the logs of all the replicas of a job
+  suspend <job>         Suspend a job
+  resume <job>          Resume a suspended job
+  wait <job>            Wait for a condition of a job, e.g. --for=condition=Succeeded
+
+A job is referenced by <kind>/<name>, e.g. pytorchjob/mnist, or by its name when
+no job of another kind has the same name.
+
+Use "kubectl training <command> -h" for the flags of a command.
+`
+
+// cli holds the flags shared by the commands and the clients built from them.
+type cli struct {
+	kubeconfig  string
+	kubeContext string
+	namespace   string
+
+	out      io.Writer
+	training versioned.Interface
+	kube     kubernetes.Interface
+}
+
+// command runs a command with its arguments.
+type command func(c *cli, ctx context.Context, args []string) error
+
+var commands = map[string]command{
+	"list":     (*cli).list,
+	"describe": (*cli).describe,
+	"logs":     (*cli).logs,
+	"suspend":  (*cli).suspend,
+	"resume":   (*cli).resume,
+	"wait":     (*cli).wait,
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "--help" || os.Args[1] == "help" {
+		fmt.Fprint(os.Stderr, usage)
+		return
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	c := &cli{out: os.Stdout}
+	if err := cmd(c, ctx, os.Args[2:]); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// newFlagSet returns the flags of the command with the flags selecting the cluster and the namespace.
+func (c *cli) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(&c.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file, defaults to $KUBECONFIG or ~/.kube/config.")
+	fs.StringVar(&c.kubeContext, "context", "", "The kubeconfig context to use.")
+	fs.StringVar(&c.namespace, "namespace", "", "The namespace of the jobs, defaults to the namespace of the context.")
+	fs.StringVar(&c.namespace, "n", "", "Shorthand for --namespace.")
+	return fs
+}
+
+// parse parses the flags of the command and builds the clients, it returns the arguments of the command.
+func (c *cli) parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	// Allow the flags after the arguments like kubectl, e.g. `describe mnist -n team`.
+	var positional []string
+	for fs.NArg() > 0 {
+		positional = append(positional, fs.Arg(0))
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			return nil, err
+		}
+	}
+	if c.training != nil && c.kube != nil {
+		return positional, nil
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = c.kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: c.kubeContext})
+	if len(c.namespace) == 0 {
+		namespace, _, err := clientConfig.Namespace()
+		if err != nil {
+			return nil, err
+		}
+		c.namespace = namespace
+	}
+	cfg, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	if c.training, err = versioned.NewForConfig(cfg); err != nil {
+		return nil, err
+	}
+	if c.kube, err = kubernetes.NewForConfig(cfg); err != nil {
+		return nil, err
+	}
+	return positional, nil
+}
+
+// parseJob parses the flags of a command taking a job as its only argument.
+func (c *cli) parseJob(fs *flag.FlagSet, args []string) (string, error) {
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return "", err
+	}
+	if len(args) != 1 {
+		return "", fmt.Errorf("%s expects a job, e.g. %s pytorchjob/mnist", fs.Name(), fs.Name())
+	}
+	return args[0], nil
+}
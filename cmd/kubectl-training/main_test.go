@@ -0,0 +1,256 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/client/clientset/versioned/fake"
+)
+
+func newCLI(jobs []runtime.Object, pods ...runtime.Object) (*cli, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	return &cli{
+		out:      out,
+		training: fake.NewSimpleClientset(jobs...),
+		kube:     kubefake.NewSimpleClientset(pods...),
+	}, out
+}
+
+func newStatus(conditions ...kubeflowv1.JobConditionType) kubeflowv1.JobStatus {
+	status := kubeflowv1.JobStatus{}
+	for _, condType := range conditions {
+		status.Conditions = append(status.Conditions, kubeflowv1.JobCondition{Type: condType, Status: corev1.ConditionTrue})
+	}
+	return status
+}
+
+func newTestPod(name, rType, index string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				kubeflowv1.JobNameLabel:      "mnist",
+				kubeflowv1.ReplicaTypeLabel:  rType,
+				kubeflowv1.ReplicaIndexLabel: index,
+			},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "pytorch"}}},
+	}
+}
+
+func TestList(t *testing.T) {
+	c, out := newCLI([]runtime.Object{
+		&kubeflowv1.PyTorchJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "mnist", Namespace: "default"},
+			Status:     newStatus(kubeflowv1.JobCreated, kubeflowv1.JobRunning),
+		},
+		&kubeflowv1.TFJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "dist", Namespace: "default"},
+			Status:     newStatus(kubeflowv1.JobCreated, kubeflowv1.JobFailed),
+		},
+		&kubeflowv1.MPIJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "allreduce", Namespace: "team"},
+		},
+	})
+	if err := c.list(context.Background(), []string{"-A"}); err != nil {
+		t.Fatalf("list() error = %v", err)
+	}
+	var got [][]string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		fields := strings.Fields(line)
+		got = append(got, fields[:len(fields)-1])
+	}
+	want := [][]string{
+		{"NAMESPACE", "NAME", "KIND", "STATE"},
+		{"default", "dist", "TFJob", "Failed"},
+		{"default", "mnist", "PyTorchJob", "Running"},
+		{"team", "allreduce", "MPIJob", "Pending"},
+	}
+	if diff := cmp.Diff(want, got); len(diff) != 0 {
+		t.Errorf("Unexpected jobs (-want,+got):\n%s", diff)
+	}
+}
+
+func TestFindJob(t *testing.T) {
+	c, _ := newCLI([]runtime.Object{
+		&kubeflowv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: "mnist", Namespace: "default"}},
+		&kubeflowv1.TFJob{ObjectMeta: metav1.ObjectMeta{Name: "mnist", Namespace: "default"}},
+		&kubeflowv1.XGBoostJob{ObjectMeta: metav1.ObjectMeta{Name: "boost", Namespace: "default"}},
+	})
+	c.namespace = "default"
+	cases := map[string]struct {
+		ref      string
+		wantKind string
+		wantErr  string
+	}{
+		"name": {
+			ref:      "boost",
+			wantKind: kubeflowv1.XGBoostJobKind,
+		},
+		"kind and name": {
+			ref:      "pytorchjob/mnist",
+			wantKind: kubeflowv1.PyTorchJobKind,
+		},
+		"plural and name": {
+			ref:      "tfjobs/mnist",
+			wantKind: kubeflowv1.TFJobKind,
+		},
+		"ambiguous name": {
+			ref:     "mnist",
+			wantErr: "use <kind>/<name>",
+		},
+		"not found": {
+			ref:     "mxjob/mnist",
+			wantErr: "not found",
+		},
+		"unknown kind": {
+			ref:     "rayjob/mnist",
+			wantErr: "unknown kind",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, job, err := c.findJob(context.Background(), tc.ref)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("Unexpected error: \nwant: %v\ngot: %v\n", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("findJob() error = %v", err)
+			}
+			if job.kind != tc.wantKind {
+				t.Errorf("Unexpected kind: \nwant: %v\ngot: %v\n", tc.wantKind, job.kind)
+			}
+		})
+	}
+}
+
+func TestSuspendResume(t *testing.T) {
+	c, out := newCLI([]runtime.Object{
+		&kubeflowv1.PaddleJob{ObjectMeta: metav1.ObjectMeta{Name: "ocr", Namespace: "default"}},
+	})
+	ctx := context.Background()
+	if err := c.suspend(ctx, []string{"ocr", "-n", "default"}); err != nil {
+		t.Fatalf("suspend() error = %v", err)
+	}
+	job, err := c.training.KubeflowV1().PaddleJobs("default").Get(ctx, "ocr", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get the job: %v", err)
+	}
+	if !ptr.Deref(job.Spec.RunPolicy.Suspend, false) {
+		t.Errorf("Unexpected suspend: \nwant: %v\ngot: %v\n", true, job.Spec.RunPolicy.Suspend)
+	}
+
+	if err = c.resume(ctx, []string{"paddlejob/ocr", "-n", "default"}); err != nil {
+		t.Fatalf("resume() error = %v", err)
+	}
+	if job, err = c.training.KubeflowV1().PaddleJobs("default").Get(ctx, "ocr", metav1.GetOptions{}); err != nil {
+		t.Fatalf("Failed to get the job: %v", err)
+	}
+	if ptr.Deref(job.Spec.RunPolicy.Suspend, true) {
+		t.Errorf("Unexpected suspend: \nwant: %v\ngot: %v\n", false, job.Spec.RunPolicy.Suspend)
+	}
+	want := "paddlejob/ocr suspended\npaddlejob/ocr resumed\n"
+	if got := out.String(); got != want {
+		t.Errorf("Unexpected output: \nwant: %q\ngot: %q\n", want, got)
+	}
+}
+
+func TestLogs(t *testing.T) {
+	c, out := newCLI([]runtime.Object{
+		&kubeflowv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: "mnist", Namespace: "default"}},
+	}, newTestPod("mnist-worker-0", "worker", "0"), newTestPod("mnist-master-0", "master", "0"))
+	if err := c.logs(context.Background(), []string{"mnist", "-n", "default"}); err != nil {
+		t.Fatalf("logs() error = %v", err)
+	}
+	// The fake clientset returns "fake logs" for every pod.
+	want := "[master-0] fake logs\n[worker-0] fake logs\n"
+	if got := out.String(); got != want {
+		t.Errorf("Unexpected logs: \nwant: %q\ngot: %q\n", want, got)
+	}
+}
+
+func TestWait(t *testing.T) {
+	cases := map[string]struct {
+		status  kubeflowv1.JobStatus
+		wantErr string
+	}{
+		"condition is true": {
+			status: newStatus(kubeflowv1.JobCreated, kubeflowv1.JobRunning, kubeflowv1.JobSucceeded),
+		},
+		"job failed": {
+			status:  newStatus(kubeflowv1.JobCreated, kubeflowv1.JobFailed),
+			wantErr: "is failed",
+		},
+		"timeout": {
+			status:  newStatus(kubeflowv1.JobCreated, kubeflowv1.JobRunning),
+			wantErr: "timed out",
+		},
+	}
+	waitPollInterval = 10 * time.Millisecond
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, _ := newCLI([]runtime.Object{
+				&kubeflowv1.JAXJob{ObjectMeta: metav1.ObjectMeta{Name: "gpt", Namespace: "default"}, Status: tc.status},
+			})
+			err := c.wait(context.Background(), []string{"jaxjob/gpt", "-n", "default", "--for=condition=succeeded", "--timeout=50ms"})
+			if len(tc.wantErr) == 0 && err != nil {
+				t.Fatalf("wait() error = %v", err)
+			}
+			if len(tc.wantErr) != 0 && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("Unexpected error: \nwant: %v\ngot: %v\n", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPodFailures(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(earlier.Add(time.Minute))
+	oom := newTestPod("mnist-worker-0", "worker", "0")
+	oom.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "pytorch",
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled", FinishedAt: earlier},
+		},
+	}}
+	evicted := newTestPod("mnist-worker-1", "worker", "1")
+	evicted.Status = corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted", StartTime: &later}
+
+	got := podFailures([]corev1.Pod{*oom, *evicted})
+	want := []podFailure{
+		{time: later, pod: "mnist-worker-1", replica: "worker-1", reason: "Evicted"},
+		{time: earlier, pod: "mnist-worker-0", replica: "worker-0", container: "pytorch", reason: "OOMKilled", exitCode: "137"},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(podFailure{})); len(diff) != 0 {
+		t.Errorf("Unexpected pod failures (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// suspend sets RunPolicy.Suspend of a job, the operator deletes its pods.
+func (c *cli) suspend(ctx context.Context, args []string) error {
+	return c.setSuspend(ctx, "suspend", "suspended", args, true)
+}
+
+// resume unsets RunPolicy.Suspend of a job, the operator recreates its pods.
+func (c *cli) resume(ctx context.Context, args []string) error {
+	return c.setSuspend(ctx, "resume", "resumed", args, false)
+}
+
+func (c *cli) setSuspend(ctx context.Context, name, result string, args []string, suspend bool) error {
+	fs := c.newFlagSet(name)
+	ref, err := c.parseJob(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var job *trainingJob
+	unchanged := false
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		kind, found, err := c.findJob(ctx, ref)
+		if err != nil {
+			return err
+		}
+		job = found
+		if commonutil.IsFinished(*job.status) {
+			return fmt.Errorf("%s %s is finished", job.kind, job.GetName())
+		}
+		if ptr.Deref(job.runPolicy.Suspend, false) == suspend {
+			unchanged = true
+			return nil
+		}
+		job.runPolicy.Suspend = ptr.To(suspend)
+		return kind.update(ctx, job)
+	})
+	if err != nil {
+		return err
+	}
+	if unchanged {
+		result = "already " + result
+	}
+	fmt.Fprintf(c.out, "%s/%s %s\n", strings.ToLower(job.kind), job.GetName(), result)
+	return nil
+}
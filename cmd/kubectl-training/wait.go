@@ -0,0 +1,84 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// waitPollInterval is the interval between the checks of the job.
+var waitPollInterval = 2 * time.Second
+
+// wait waits until a condition of a job is true, e.g. --for=condition=Succeeded. It fails once the job
+// is finished without the condition.
+func (c *cli) wait(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("wait")
+	forCondition := fs.String("for", "", "The condition to wait for, e.g. condition=Succeeded or condition=Running.")
+	timeout := fs.Duration("timeout", 30*time.Second, "The time to wait for, forever if zero.")
+	ref, err := c.parseJob(fs, args)
+	if err != nil {
+		return err
+	}
+	condType, found := strings.CutPrefix(*forCondition, "condition=")
+	if !found || len(condType) == 0 {
+		return fmt.Errorf("--for must be condition=<type>, e.g. --for=condition=Succeeded")
+	}
+
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	var job *trainingJob
+	err = wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+		if _, job, err = c.findJob(ctx, ref); err != nil {
+			return false, err
+		}
+		if hasCondition(job.status, condType) {
+			return true, nil
+		}
+		if commonutil.IsFinished(*job.status) {
+			return false, fmt.Errorf("%s %s is %s", job.kind, job.GetName(), strings.ToLower(jobState(job.status)))
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out waiting for the condition %s of %s", condType, ref)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "%s/%s condition met\n", strings.ToLower(job.kind), job.GetName())
+	return nil
+}
+
+// hasCondition checks whether the condition of the type is true, the type is case-insensitive.
+func hasCondition(status *kubeflowv1.JobStatus, condType string) bool {
+	for _, condition := range status.Conditions {
+		if strings.EqualFold(string(condition.Type), condType) && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
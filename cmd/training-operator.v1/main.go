@@ -17,9 +17,11 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"go.uber.org/zap/zapcore"
@@ -31,24 +33,30 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
-	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/cert"
 	"github.com/kubeflow/training-operator/pkg/config"
 	controllerv1 "github.com/kubeflow/training-operator/pkg/controller.v1"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	trainingcronjobcontroller "github.com/kubeflow/training-operator/pkg/controller.v1/trainingcronjob"
+	"github.com/kubeflow/training-operator/pkg/webhooks"
 	//+kubebuilder:scaffold:imports
 )
 
 const (
 	// EnvKubeflowNamespace is an environment variable for namespace when deployed on kubernetes
 	EnvKubeflowNamespace = "KUBEFLOW_NAMESPACE"
+	// EnvPodNamespace is an environment variable for the namespace the operator pod runs in
+	EnvPodNamespace = "MY_POD_NAMESPACE"
 )
 
 var (
@@ -65,6 +73,10 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		os.Exit(runRender(os.Args[2:]))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var leaderElectionID string
@@ -73,6 +85,9 @@ func main() {
 	var gangSchedulerName string
 	var namespace string
 	var webhookServerPort int
+	var enableWebhooks bool
+	var webhookServiceName string
+	var webhookSecretName string
 	var controllerThreads int
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -81,25 +96,18 @@ func main() {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&leaderElectionID, "leader-election-id", "1ca428e5.training-operator.kubeflow.org", "The ID for leader election.")
 	flag.Var(&enabledSchemes, "enable-scheme", "Enable scheme(s) as --enable-scheme=tfjob --enable-scheme=pytorchjob, case insensitive."+
-		" Now supporting TFJob, PyTorchJob, MXNetJob, XGBoostJob, PaddleJob. By default, all supported schemes will be enabled.")
-	flag.StringVar(&gangSchedulerName, "gang-scheduler-name", "", "Now Supporting volcano and scheduler-plugins."+
-		" Note: If you set another scheduler name, the training-operator assumes it's the scheduler-plugins.")
+		" Now supporting TFJob, PyTorchJob, MXNetJob, XGBoostJob, PaddleJob, JAXJob. By default, all supported schemes will be enabled.")
+	flag.StringVar(&gangSchedulerName, "gang-scheduler-name", "", "Now Supporting volcano, scheduler-plugins and koord-scheduler."+
+		" Note: If you set another scheduler name which is not registered, the training-operator assumes it's the scheduler-plugins.")
 	flag.StringVar(&namespace, "namespace", os.Getenv(EnvKubeflowNamespace), "The namespace to monitor kubeflow jobs. If unset, it monitors all namespaces cluster-wide."+
 		"If set, it only monitors kubeflow jobs in the given namespace.")
 	flag.IntVar(&webhookServerPort, "webhook-server-port", 9443, "Endpoint port for the webhook server.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", true, "Enable the validating and defaulting admission webhooks for the enabled schemes.")
+	flag.StringVar(&webhookServiceName, "webhook-service-name", "training-operator-webhook", "The name of the service fronting the webhook server.")
+	flag.StringVar(&webhookSecretName, "webhook-secret-name", "training-operator-webhook-cert", "The name of the secret storing the webhook serving certificate.")
 	flag.IntVar(&controllerThreads, "controller-threads", 1, "Number of worker threads used by the controller.")
 
-	// PyTorch related flags
-	flag.StringVar(&config.Config.PyTorchInitContainerImage, "pytorch-init-container-image",
-		config.PyTorchInitContainerImageDefault, "The image for pytorch init container")
-	flag.StringVar(&config.Config.PyTorchInitContainerTemplateFile, "pytorch-init-container-template-file",
-		config.PyTorchInitContainerTemplateFileDefault, "The template file for pytorch init container")
-	flag.IntVar(&config.Config.PyTorchInitContainerMaxTries, "pytorch-init-container-max-tries",
-		config.PyTorchInitContainerMaxTriesDefault, "The number of tries for the pytorch init container")
-
-	// MPI related flags
-	flag.StringVar(&config.Config.MPIKubectlDeliveryImage, "mpi-kubectl-delivery-image",
-		config.MPIKubectlDeliveryImageDefault, "The image for mpi launcher init container")
+	bindConfigFlags(flag.CommandLine)
 
 	opts := zap.Options{
 		Development:     true,
@@ -110,6 +118,8 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	webhookCertDir := filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs")
+
 	var cacheOpts cache.Options
 	if namespace != "" {
 		cacheOpts = cache.Options{
@@ -125,7 +135,8 @@ func main() {
 			BindAddress: metricsAddr,
 		},
 		WebhookServer: &webhook.DefaultServer{Options: webhook.Options{
-			Port: webhookServerPort,
+			Port:    webhookServerPort,
+			CertDir: webhookCertDir,
 		}},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
@@ -137,6 +148,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Based on the user configuration, we start different controllers and webhooks
+	if enabledSchemes.Empty() {
+		enabledSchemes.FillAll()
+	}
+
+	if enableWebhooks {
+		certOpts := cert.Options{
+			Namespace:   os.Getenv(EnvPodNamespace),
+			ServiceName: webhookServiceName,
+			SecretName:  webhookSecretName,
+			CertDir:     webhookCertDir,
+		}
+		setupWebhooks(mgr, certOpts)
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook ready check")
+			os.Exit(1)
+		}
+	}
+
 	// Set up controllers using goroutines to start the manager quickly.
 	go setupControllers(mgr, enabledSchemes, gangSchedulerName, controllerThreads)
 
@@ -163,23 +193,24 @@ func setupControllers(mgr ctrl.Manager, enabledSchemes controllerv1.EnabledSchem
 
 	// Prepare GangSchedulingSetupFunc
 	gangSchedulingSetupFunc := common.GenNonGangSchedulerSetupFunc()
-	if strings.EqualFold(gangSchedulerName, string(common.GangSchedulerVolcano)) {
-		cfg := mgr.GetConfig()
-		volcanoClientSet := volcanoclient.NewForConfigOrDie(cfg)
-		gangSchedulingSetupFunc = common.GenVolcanoSetupFunc(volcanoClientSet)
-		gvk := v1beta1.SchemeGroupVersion.WithKind("PodGroup")
-		validateCRD(mgr, gvk)
-	} else if gangSchedulerName != "" {
-		gangSchedulingSetupFunc = common.GenSchedulerPluginsSetupFunc(mgr.GetClient(), gangSchedulerName)
-		gvk := schedulerpluginsv1alpha1.SchemeGroupVersion.WithKind("PodGroup")
-		validateCRD(mgr, gvk)
+	if gangSchedulerName != "" && !strings.EqualFold(gangSchedulerName, string(common.GangSchedulerNone)) {
+		backend, registered := control.GetPodGroupBackend(gangSchedulerName)
+		if !registered {
+			setupLog.Info("gang scheduler is not registered, assuming it's the scheduler-plugins", "name", gangSchedulerName)
+			backend = control.SchedulerPluginsBackend
+		}
+		for _, gvk := range backend.CRDs {
+			validateCRD(mgr, gvk)
+		}
+		pgControl, err := backend.New(mgr, gangSchedulerName)
+		if err != nil {
+			setupLog.Error(err, "unable to set up gang scheduler", "name", gangSchedulerName)
+			os.Exit(1)
+		}
+		gangSchedulingSetupFunc = common.GenPodGroupSetupFunc(gangSchedulerName, pgControl)
 	}
 
 	// TODO: We need a general manager. all rest reconciler addsToManager
-	// Based on the user configuration, we start different controllers
-	if enabledSchemes.Empty() {
-		enabledSchemes.FillAll()
-	}
 	errMsg := "failed to set up controllers"
 	for _, s := range enabledSchemes {
 		setupFunc, supported := controllerv1.SupportedSchemeReconciler[s]
@@ -192,6 +223,36 @@ func setupControllers(mgr ctrl.Manager, enabledSchemes controllerv1.EnabledSchem
 			os.Exit(1)
 		}
 	}
+
+	// The TrainingCronJobs create the jobs of any enabled scheme.
+	if err := trainingcronjobcontroller.NewReconciler(mgr).SetupWithManager(mgr, controllerThreads); err != nil {
+		setupLog.Error(err, "unable to create controller", "kind", kubeflowv1.TrainingCronJobKind)
+		os.Exit(1)
+	}
+}
+
+func setupWebhooks(mgr ctrl.Manager, certOpts cert.Options) {
+	setupLog.Info("registering webhooks...")
+
+	// The manager cache is not started yet, so use a direct client to provision the certificate.
+	c, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		setupLog.Error(err, "unable to create client for webhook certificates")
+		os.Exit(1)
+	}
+	if err := cert.EnsureCerts(context.Background(), c, certOpts); err != nil {
+		setupLog.Error(err, "unable to provision webhook certificates")
+		os.Exit(1)
+	}
+
+	// The webhook configurations match the jobs of every scheme and fail closed, so the webhooks of
+	// the schemes without a controller are served as well, otherwise their jobs can't be created.
+	for s, setupFunc := range webhooks.SupportedSchemeWebhook {
+		if err := setupFunc(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "scheme", s)
+			os.Exit(1)
+		}
+	}
 }
 
 func validateCRD(mgr ctrl.Manager, gvk schema.GroupVersionKind) {
@@ -205,3 +266,20 @@ func validateCRD(mgr ctrl.Manager, gvk schema.GroupVersionKind) {
 		os.Exit(1)
 	}
 }
+
+// bindConfigFlags binds the flags of the configuration of the replicas, e.g. the PyTorch init container.
+func bindConfigFlags(fs *flag.FlagSet) {
+	// PyTorch related flags
+	fs.StringVar(&config.Config.PyTorchInitContainerImage, "pytorch-init-container-image",
+		config.PyTorchInitContainerImageDefault, "The image for pytorch init container")
+	fs.StringVar(&config.Config.PyTorchInitContainerTemplateFile, "pytorch-init-container-template-file",
+		config.PyTorchInitContainerTemplateFileDefault, "The template file for pytorch init container")
+	fs.IntVar(&config.Config.PyTorchInitContainerMaxTries, "pytorch-init-container-max-tries",
+		config.PyTorchInitContainerMaxTriesDefault, "The number of tries for the pytorch init container")
+	fs.BoolVar(&config.Config.PyTorchInitContainerDisabled, "disable-pytorch-init-container", false,
+		"Disable the pytorch init container waiting for the master, the startupPolicy of the jobs can order the startup of the replicas instead")
+
+	// MPI related flags
+	fs.StringVar(&config.Config.MPIKubectlDeliveryImage, "mpi-kubectl-delivery-image",
+		config.MPIKubectlDeliveryImageDefault, "The image for mpi launcher init container")
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	log "github.com/sirupsen/logrus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/kubeflow/training-operator/pkg/render"
+)
+
+const renderUsage = `Usage: training-operator render -f FILE [flags]
+
+Render prints the objects the training operator creates for the jobs of FILE, e.g. the pods,
+services, configmaps, roles and podgroups, without connecting to a cluster. FILE may hold other
+objects the jobs reference, e.g. their training runtimes. Use "-f -" to read the standard input.
+
+Flags:
+`
+
+// runRender runs the render subcommand and returns the exit code.
+func runRender(args []string) int {
+	fs := flag.NewFlagSet("render", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), renderUsage)
+		fs.PrintDefaults()
+	}
+	var file string
+	var opts render.Options
+	var verbose bool
+	fs.StringVar(&file, "f", "", "The file of the jobs to render, - for the standard input.")
+	fs.StringVar(&opts.Namespace, "n", "default", "The namespace of the objects which don't set one.")
+	fs.StringVar(&opts.GangSchedulerName, "gang-scheduler-name", "", "The gang scheduler to render the podgroups for,"+
+		" e.g. volcano or scheduler-plugins. No podgroup is rendered if unset.")
+	fs.BoolVar(&verbose, "v", false, "Log the reconciliation of the jobs to the standard error.")
+	bindConfigFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if len(file) == 0 || fs.NArg() != 0 {
+		fs.Usage()
+		return 2
+	}
+
+	if verbose {
+		ctrl.SetLogger(zap.New(zap.UseDevMode(true), zap.WriteTo(os.Stderr)))
+	} else {
+		ctrl.SetLogger(logr.Discard())
+		log.SetOutput(io.Discard)
+	}
+
+	in := os.Stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		in = f
+	}
+	objs, err := render.Decode(in, render.NewScheme())
+	if err == nil {
+		objs, err = render.Render(objs, opts)
+	}
+	if err == nil {
+		err = render.Print(os.Stdout, objs)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "render %s: %v\n", file, err)
+		return 1
+	}
+	return 0
+}
@@ -35,8 +35,49 @@ const (
 
 	// JobRoleLabel represents the label key for the job role, e.g. master.
 	JobRoleLabel = "training.kubeflow.org/job-role"
+
+	// RestartGenerationLabel is set on the pods of a job with RestartScope Job to the
+	// RestartGeneration of the job the pods were created for.
+	RestartGenerationLabel = "training.kubeflow.org/restart-generation"
+
+	// RestartGenerationEnvVar is set in the containers of the pods of a job with RestartScope Job to
+	// the RestartGeneration of the job, e.g. for the training code to resume from its checkpoint.
+	RestartGenerationEnvVar = "TRAINING_RESTART_GENERATION"
+
+	// CheckpointRequestedAnnotation is set on the pods of a job with GracefulTermination,
+	// before they are deleted. The value is the time of the request in RFC3339 form.
+	CheckpointRequestedAnnotation = "training.kubeflow.org/checkpoint-requested"
+
+	// CheckpointCompleteAnnotation is set to "true" by the training code on the pod with the master role,
+	// once the checkpoint requested by CheckpointRequestedAnnotation is written.
+	CheckpointCompleteAnnotation = "training.kubeflow.org/checkpoint-complete"
+
+	// PodCheckpointComplete is the pod condition type the training code can set instead of
+	// CheckpointCompleteAnnotation, e.g. through a readiness gate.
+	PodCheckpointComplete v1.PodConditionType = "training.kubeflow.org/checkpoint-complete"
+
+	// QueueNameLabel names the queue of a queueing system, such as Kueue, the job is submitted to.
+	// Jobs with the label are created suspended and resumed by the queueing system once admitted.
+	QueueNameLabel = "kueue.x-k8s.io/queue-name"
+
+	// AdmissionAnnotation is set by the queueing system when it resumes a job with QueueNameLabel.
+	// The value is the JSON encoded map of ReplicaType to ReplicaAdmission.
+	AdmissionAnnotation = "training.kubeflow.org/admission"
 )
 
+// ReplicaAdmission holds the scheduling constraints a queueing system assigned to the replicas
+// of a replica type on admission, e.g. to place them on the nodes of a resource flavor.
+// They are added to the pods of the replicas, the templates in the ReplicaSpecs are not changed.
+type ReplicaAdmission struct {
+	// NodeSelector is merged into the node selector of the pods, it takes precedence on conflicts.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are added to the tolerations of the pods.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+}
+
 // JobStatus represents the current observed state of the training Job.
 type JobStatus struct {
 	// Conditions is an array of current observed job conditions.
@@ -60,6 +101,48 @@ type JobStatus struct {
 	// be set in happens-before order across separate operations.
 	// It is represented in RFC3339 form and is in UTC.
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// FailureDetails describes the first pod failure which caused the job to fail.
+	// It is set once the job has a Failed condition.
+	// +optional
+	FailureDetails *FailureDetails `json:"failureDetails,omitempty"`
+
+	// RestartGeneration is the number of times all the replicas of the job were restarted
+	// together with RestartScope Job.
+	// +optional
+	RestartGeneration int32 `json:"restartGeneration,omitempty"`
+}
+
+// FailureDetails describes the first failing pod of a failed job.
+type FailureDetails struct {
+	// ReplicaType is the replica type of the failing pod.
+	// +optional
+	ReplicaType ReplicaType `json:"replicaType,omitempty"`
+
+	// PodName is the name of the failing pod.
+	// +optional
+	PodName string `json:"podName,omitempty"`
+
+	// ContainerName is the name of the container of the pod which terminated with an error.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// ExitCode is the exit code of the container.
+	// +optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// Reason is why the container terminated or the pod failed, e.g. OOMKilled, Error,
+	// Evicted or DeadlineExceeded.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the tail of the termination message of the container, or the message of the pod.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FailureTime is when the container terminated.
+	// +optional
+	FailureTime *metav1.Time `json:"failureTime,omitempty"`
 }
 
 // ReplicaType represents the type of the replica. Each operator needs to define its
@@ -77,6 +160,28 @@ type ReplicaStatus struct {
 	// The number of pods which reached phase Failed.
 	Failed int32 `json:"failed,omitempty"`
 
+	// The number of running pods which have a Ready condition.
+	Ready int32 `json:"ready,omitempty"`
+
+	// The number of pods which are being deleted.
+	Terminating int32 `json:"terminating,omitempty"`
+
+	// The total number of times the pods were restarted by the operator since the job was created.
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// The number of Restarts which count towards the BackoffLimit of the job, i.e. all the restarts
+	// but those of the failures ignored by the PodFailurePolicy.
+	// +optional
+	CountedRestarts int32 `json:"countedRestarts,omitempty"`
+
+	// The number of consecutive restarts delayed by the RestartBackoff of the replica type.
+	// +optional
+	BackoffRestarts int32 `json:"backoffRestarts,omitempty"`
+
+	// The time the pods restarted with the RestartBackoff of the replica type are recreated at.
+	// +optional
+	NextRestartTime *metav1.Time `json:"nextRestartTime,omitempty"`
+
 	// Deprecated: Use Selector instead
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
@@ -84,6 +189,47 @@ type ReplicaStatus struct {
 	// matchExpressions are ANDed. An empty Selector matches all objects. A null
 	// Selector matches no objects.
 	Selector string `json:"selector,omitempty"`
+
+	// The observed state of the pod of each index, ordered by index.
+	// Only the first MaxReplicaIndexStatuses indexes are reported.
+	// +optional
+	// +listType=map
+	// +listMapKey=index
+	// +kubebuilder:validation:MaxItems=64
+	Indexes []ReplicaIndexStatus `json:"indexes,omitempty"`
+}
+
+// MaxReplicaIndexStatuses is the maximum number of indexes reported in ReplicaStatus.Indexes.
+const MaxReplicaIndexStatuses = 64
+
+// ReplicaIndexStatus represents the current observed state of the pod of a replica index.
+type ReplicaIndexStatus struct {
+	// The index of the replica.
+	Index int32 `json:"index"`
+
+	// The name of the pod of the index.
+	// +optional
+	PodName string `json:"podName,omitempty"`
+
+	// The phase of the pod of the index.
+	// +optional
+	Phase v1.PodPhase `json:"phase,omitempty"`
+
+	// The name of the node the pod of the index is scheduled to.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// The number of times the pod of the index was restarted by the operator since the job was created.
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// The number of times the containers of the current pod of the index were restarted by the kubelet.
+	// +optional
+	ContainerRestarts int32 `json:"containerRestarts,omitempty"`
+
+	// The exit code of the container of the pod of the index which terminated last.
+	// +optional
+	LastExitCode *int32 `json:"lastExitCode,omitempty"`
 }
 
 // ReplicaSpec is a description of the replica
@@ -101,6 +247,131 @@ type ReplicaSpec struct {
 	// One of Always, OnFailure, Never and ExitCode.
 	// Default to Never.
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// PodFailurePolicy specifies how failed pods of the replicas are handled.
+	// If set, the pods are created with the restart policy Never and each failed
+	// pod is matched against the rules, the first matching rule takes effect.
+	// Failed pods which match no rule are handled according to RestartPolicy.
+	// Not supported by MPIJob.
+	// +optional
+	PodFailurePolicy *PodFailurePolicy `json:"podFailurePolicy,omitempty"`
+
+	// RestartBackoff delays the recreation of the pods of the replicas restarted by the operator,
+	// the delay doubles with every consecutive restart. If unset, the pods are recreated right away.
+	// Not supported by MPIJob.
+	// +optional
+	RestartBackoff *RestartBackoff `json:"restartBackoff,omitempty"`
+}
+
+// RestartBackoff describes the exponential backoff of the restarts of the pods of a replica type.
+// The pods failed while a restart is delayed are recreated with it.
+type RestartBackoff struct {
+	// BaseSeconds is the delay of the first restart.
+	// Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BaseSeconds *int32 `json:"baseSeconds,omitempty"`
+
+	// MaxSeconds is the maximum delay of a restart.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSeconds *int32 `json:"maxSeconds,omitempty"`
+
+	// ResetAfterSeconds is the time the restarted pods have to run without failure for the
+	// delay to be reset to BaseSeconds.
+	// Defaults to 600.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ResetAfterSeconds *int32 `json:"resetAfterSeconds,omitempty"`
+}
+
+const (
+	// DefaultRestartBackoffBaseSeconds is the default of RestartBackoff.BaseSeconds.
+	DefaultRestartBackoffBaseSeconds = 10
+	// DefaultRestartBackoffMaxSeconds is the default of RestartBackoff.MaxSeconds.
+	DefaultRestartBackoffMaxSeconds = 300
+	// DefaultRestartBackoffResetAfterSeconds is the default of RestartBackoff.ResetAfterSeconds.
+	DefaultRestartBackoffResetAfterSeconds = 600
+)
+
+// PodFailurePolicy describes how failed pods influence the job, it is modeled
+// after the pod failure policy of batch/v1 Jobs.
+type PodFailurePolicy struct {
+	// Rules is the ordered list of rules, evaluated until the first match.
+	// +listType=atomic
+	Rules []PodFailurePolicyRule `json:"rules"`
+}
+
+// PodFailurePolicyAction specifies how a pod failure is handled.
+// +kubebuilder:validation:Enum=FailJob;RestartPod;Ignore;Count
+type PodFailurePolicyAction string
+
+const (
+	// PodFailurePolicyActionFailJob marks the job as failed right away.
+	PodFailurePolicyActionFailJob PodFailurePolicyAction = "FailJob"
+	// PodFailurePolicyActionRestartPod restarts the pod regardless of the RestartPolicy,
+	// the failure counts towards the BackoffLimit.
+	PodFailurePolicyActionRestartPod PodFailurePolicyAction = "RestartPod"
+	// PodFailurePolicyActionIgnore restarts the pod, the failure doesn't count
+	// towards the BackoffLimit.
+	PodFailurePolicyActionIgnore PodFailurePolicyAction = "Ignore"
+	// PodFailurePolicyActionCount handles the failure according to the RestartPolicy.
+	PodFailurePolicyActionCount PodFailurePolicyAction = "Count"
+)
+
+// PodFailurePolicyOnExitCodesOperator is the relationship between the exit code of a
+// container and the values of a rule.
+// +kubebuilder:validation:Enum=In;NotIn
+type PodFailurePolicyOnExitCodesOperator string
+
+const (
+	PodFailurePolicyOnExitCodesOpIn    PodFailurePolicyOnExitCodesOperator = "In"
+	PodFailurePolicyOnExitCodesOpNotIn PodFailurePolicyOnExitCodesOperator = "NotIn"
+)
+
+// PodFailurePolicyRule describes a rule of the PodFailurePolicy. Exactly one of
+// OnExitCodes and OnPodConditions must be set.
+type PodFailurePolicyRule struct {
+	// Action to take when the rule matches.
+	Action PodFailurePolicyAction `json:"action"`
+
+	// OnExitCodes matches the exit codes of the terminated containers.
+	// +optional
+	OnExitCodes *PodFailurePolicyOnExitCodesRequirement `json:"onExitCodes,omitempty"`
+
+	// OnPodConditions matches the conditions of the pod, e.g. DisruptionTarget
+	// for pods which have been evicted or preempted. The rule matches if any of
+	// the patterns matches.
+	// +optional
+	// +listType=atomic
+	OnPodConditions []PodFailurePolicyOnPodConditionsPattern `json:"onPodConditions,omitempty"`
+}
+
+// PodFailurePolicyOnExitCodesRequirement matches the exit codes of the terminated containers.
+type PodFailurePolicyOnExitCodesRequirement struct {
+	// ContainerName restricts the check to the container with the given name.
+	// If unset, the rule matches if any container of the pod matches.
+	// +optional
+	ContainerName *string `json:"containerName,omitempty"`
+
+	// Operator is the relationship between the exit code and the values.
+	Operator PodFailurePolicyOnExitCodesOperator `json:"operator"`
+
+	// Values are the exit codes to check. With the operator NotIn, containers
+	// which exited with 0 are not considered.
+	// +listType=set
+	Values []int32 `json:"values"`
+}
+
+// PodFailurePolicyOnPodConditionsPattern matches a condition of the pod.
+type PodFailurePolicyOnPodConditionsPattern struct {
+	// Type is the type of the pod condition, e.g. DisruptionTarget.
+	Type v1.PodConditionType `json:"type"`
+
+	// Status of the pod condition, defaults to True.
+	// +optional
+	Status v1.ConditionStatus `json:"status,omitempty"`
 }
 
 // JobCondition describes the state of the job at a certain point.
@@ -147,6 +418,15 @@ const (
 	// JobSuspended means the job has been suspended.
 	JobSuspended JobConditionType = "Suspended"
 
+	// JobCheckpointing means the pods of the job are about to be deleted and the job
+	// waits for the replica with the master role to complete a checkpoint.
+	// It is only set for jobs with GracefulTermination.
+	JobCheckpointing JobConditionType = "Checkpointing"
+
+	// JobWaiting means the job waits for the jobs of RunPolicy.DependsOn,
+	// no pods are created until its dependencies reach their condition.
+	JobWaiting JobConditionType = "Waiting"
+
 	// JobFailed means one or more sub-resources (e.g. services/pods) of this job
 	// reached phase failed with no restarting.
 	// The training has failed its execution.
@@ -221,8 +501,222 @@ type RunPolicy struct {
 	// +kubebuilder:default:=false
 	// +optional
 	Suspend *bool `json:"suspend,omitempty"`
+
+	// GracefulTermination gives the training code the chance to write a checkpoint before
+	// the pods are deleted, when the job is suspended or fails on ActiveDeadlineSeconds
+	// or BackoffLimit.
+	// +optional
+	GracefulTermination *GracefulTermination `json:"gracefulTermination,omitempty"`
+
+	// SuccessPolicy defines when the job is marked as succeeded. If unset, the default
+	// rules of the framework apply. Replicas which are still running once the job
+	// succeeded are cleaned up according to CleanPodPolicy.
+	// +optional
+	SuccessPolicy *JobSuccessPolicy `json:"successPolicy,omitempty"`
+
+	// StartupPolicy orders the startup of the replicas of the job. The pods of a replica type
+	// are only created once the replicas it depends on are Running or Ready. With gang scheduling,
+	// the replicas waiting for other replicas aren't part of the minimum members of the PodGroup.
+	// +optional
+	StartupPolicy *StartupPolicy `json:"startupPolicy,omitempty"`
+
+	// TopologyPolicy co-locates or spreads the replicas of the job across the topology
+	// domains of the nodes, e.g. zones, racks or NVLink domains.
+	// +optional
+	TopologyPolicy *TopologyPolicy `json:"topologyPolicy,omitempty"`
+
+	// RuntimeRef references the TrainingRuntime or ClusterTrainingRuntime the replica templates and
+	// the run policy of the job are merged with. The runtime is resolved once, before the pods of
+	// the job are created, later updates of the runtime don't affect the job.
+	// +optional
+	RuntimeRef *RuntimeRef `json:"runtimeRef,omitempty"`
+
+	// DependsOn lists the jobs of the namespace which must reach a condition before the pods of the
+	// job are created. The job has a Waiting condition until then, and it fails once a dependency
+	// is finished without reaching its condition. Dependency cycles are rejected.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	DependsOn []JobDependency `json:"dependsOn,omitempty"`
+
+	// RestartScope is the scope of the restarts of the replicas, either Pod or Job. With Job, every
+	// replica is deleted and recreated whenever a replica is restarted, so that the surviving ranks
+	// don't hang in a collective. Each restart of the job counts towards BackoffLimit.
+	// Defaults to Pod.
+	// +optional
+	RestartScope *RestartScope `json:"restartScope,omitempty"`
+}
+
+// RestartScope is the scope of the restarts of the replicas of a job.
+// +kubebuilder:validation:Enum=Pod;Job
+type RestartScope string
+
+const (
+	// RestartScopePod restarts the failed pods only.
+	RestartScopePod RestartScope = "Pod"
+	// RestartScopeJob restarts all the replicas of the job together. The pods are created with the
+	// restart policy Never, RestartGenerationLabel and RestartGenerationEnvVar, and the PodGroup of
+	// the job is recreated. The failures ignored by the PodFailurePolicy restart the failed pod only.
+	// For MPIJob, the failures of the launcher are retried by the launcher Job.
+	RestartScopeJob RestartScope = "Job"
+)
+
+// JobDependency references a job of the namespace the job waits for.
+type JobDependency struct {
+	// Kind is the kind of the job, e.g. PyTorchJob.
+	// +kubebuilder:validation:Enum=TFJob;PyTorchJob;MXJob;XGBoostJob;MPIJob;PaddleJob;JAXJob
+	Kind string `json:"kind"`
+
+	// Name is the name of the job.
+	Name string `json:"name"`
+
+	// Condition is the condition of the job to wait for, one of Running, Succeeded or Failed.
+	// Defaults to Succeeded.
+	// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+	// +kubebuilder:default:=Succeeded
+	// +optional
+	Condition JobConditionType `json:"condition,omitempty"`
 }
 
+// TopologyPolicyMode is the placement of the replicas across the topology domains.
+// +kubebuilder:validation:Enum=Required;Preferred;Spread
+type TopologyPolicyMode string
+
+const (
+	// TopologyPolicyModeRequired schedules all the replicas in the same topology domain.
+	TopologyPolicyModeRequired TopologyPolicyMode = "Required"
+	// TopologyPolicyModePreferred schedules the replicas in the same topology domain when possible.
+	TopologyPolicyModePreferred TopologyPolicyMode = "Preferred"
+	// TopologyPolicyModeSpread spreads the replicas evenly across the topology domains.
+	TopologyPolicyModeSpread TopologyPolicyMode = "Spread"
+)
+
+// TopologyPolicy places the replicas of a job according to a node label. The pods get a pod
+// affinity or a topology spread constraint on the pods of the job with the same label value.
+type TopologyPolicy struct {
+	// TopologyKey is the node label whose values are the topology domains,
+	// e.g. topology.kubernetes.io/zone.
+	TopologyKey string `json:"topologyKey"`
+
+	// Mode is one of Required, Preferred and Spread.
+	Mode TopologyPolicyMode `json:"mode"`
+
+	// ReplicaTypes are the replica types placed by the policy. Defaults to all replica types of the job.
+	// +optional
+	// +listType=set
+	ReplicaTypes []ReplicaType `json:"replicaTypes,omitempty"`
+}
+
+// StartupPolicy declares the startup dependencies between the replica types of a job.
+type StartupPolicy struct {
+	// Dependencies of the replica types. The replica types without dependencies start right away.
+	// +listType=map
+	// +listMapKey=replicaType
+	Dependencies []ReplicaStartupDependency `json:"dependencies"`
+}
+
+// ReplicaStartupCondition is the state the replicas must reach before their dependent replicas start.
+// +kubebuilder:validation:Enum=Running;Ready
+type ReplicaStartupCondition string
+
+const (
+	// ReplicaStartupConditionRunning waits for the pods of the replicas to be running.
+	ReplicaStartupConditionRunning ReplicaStartupCondition = "Running"
+	// ReplicaStartupConditionReady waits for the pods of the replicas to be ready, e.g. for
+	// the readiness probe of the master to pass once it is listening.
+	ReplicaStartupConditionReady ReplicaStartupCondition = "Ready"
+)
+
+// ReplicaStartupDependency delays the startup of a replica type until the replica types it
+// depends on reached the condition. The replicas which already succeeded satisfy any condition.
+type ReplicaStartupDependency struct {
+	// ReplicaType is the replica type whose pods are delayed.
+	ReplicaType ReplicaType `json:"replicaType"`
+
+	// DependsOn are the replica types whose replicas must all reach the condition first.
+	// +listType=set
+	DependsOn []ReplicaType `json:"dependsOn"`
+
+	// Condition is either Running or Ready. Defaults to Running.
+	// +optional
+	Condition ReplicaStartupCondition `json:"condition,omitempty"`
+}
+
+// JobSuccessPolicyMode is the mode of a JobSuccessPolicy.
+// +kubebuilder:validation:Enum=Leader;AllReplicas;MinSucceeded
+type JobSuccessPolicyMode string
+
+const (
+	// JobSuccessPolicyModeLeader marks the job as succeeded once the replica with the
+	// master role succeeded, e.g. the PyTorch master or the TF chief.
+	JobSuccessPolicyModeLeader JobSuccessPolicyMode = "Leader"
+	// JobSuccessPolicyModeAllReplicas marks the job as succeeded once all replicas of
+	// ReplicaTypes succeeded.
+	JobSuccessPolicyModeAllReplicas JobSuccessPolicyMode = "AllReplicas"
+	// JobSuccessPolicyModeMinSucceeded marks the job as succeeded once at least MinSucceeded
+	// replicas of ReplicaType succeeded.
+	JobSuccessPolicyModeMinSucceeded JobSuccessPolicyMode = "MinSucceeded"
+)
+
+// JobSuccessPolicy defines when a job is marked as succeeded, the same way for all frameworks.
+type JobSuccessPolicy struct {
+	// Mode is one of Leader, AllReplicas and MinSucceeded.
+	Mode JobSuccessPolicyMode `json:"mode"`
+
+	// ReplicaTypes are the replica types whose replicas must all succeed in the AllReplicas
+	// mode. Defaults to all replica types of the job.
+	// +optional
+	// +listType=set
+	ReplicaTypes []ReplicaType `json:"replicaTypes,omitempty"`
+
+	// ReplicaType is the replica type counted in the MinSucceeded mode.
+	// +optional
+	ReplicaType *ReplicaType `json:"replicaType,omitempty"`
+
+	// MinSucceeded is the number of replicas of ReplicaType which must succeed in the
+	// MinSucceeded mode.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinSucceeded *int32 `json:"minSucceeded,omitempty"`
+}
+
+// GracefulTermination configures the checkpoint window before the pods of a job are deleted.
+// The pods of the job are annotated with CheckpointRequestedAnnotation, which the training code
+// can watch through the downward API, and the running pods are sent the Signal if any. The pods
+// are deleted once the replica with the master role reports the checkpoint as complete, with
+// either CheckpointCompleteAnnotation or the PodCheckpointComplete condition, or once the timeout
+// expires.
+type GracefulTermination struct {
+	// TimeoutSeconds is the maximum time to wait for the checkpoint before the pods are deleted.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// Signal is sent to the main process of the default container of the running pods when the
+	// checkpoint is requested. It is sent with the kill command of the container, through the
+	// exec of the pod, so the image must have a kill binary and the training code must run as
+	// the process 1 of the container, otherwise the signal is lost. Defaults to no signal, the
+	// training code watches the annotation instead.
+	// +kubebuilder:validation:Enum=SIGHUP;SIGINT;SIGTERM;SIGUSR1;SIGUSR2
+	// +optional
+	Signal *CheckpointSignal `json:"signal,omitempty"`
+}
+
+// CheckpointSignal is the signal requesting a checkpoint from the training code.
+type CheckpointSignal string
+
+const (
+	CheckpointSignalHUP  CheckpointSignal = "SIGHUP"
+	CheckpointSignalINT  CheckpointSignal = "SIGINT"
+	CheckpointSignalTERM CheckpointSignal = "SIGTERM"
+	CheckpointSignalUSR1 CheckpointSignal = "SIGUSR1"
+	CheckpointSignalUSR2 CheckpointSignal = "SIGUSR2"
+)
+
+// DefaultGracefulTerminationTimeoutSeconds is the default of GracefulTermination.TimeoutSeconds.
+const DefaultGracefulTerminationTimeoutSeconds = 300
+
 // SchedulingPolicy encapsulates various scheduling policies of the distributed training
 // job, for example `minAvailable` for gang-scheduling.
 type SchedulingPolicy struct {
@@ -17,6 +17,9 @@ func getDefaultContainerIndex(spec *corev1.PodSpec, defaultContainerName string)
 }
 
 func hasDefaultPort(spec *corev1.PodSpec, containerIndex int, defaultPortName string) bool {
+	if containerIndex >= len(spec.Containers) {
+		return false
+	}
 	for _, port := range spec.Containers[containerIndex].Ports {
 		if port.Name == defaultPortName {
 			return true
@@ -26,6 +29,10 @@ func hasDefaultPort(spec *corev1.PodSpec, containerIndex int, defaultPortName st
 }
 
 func setDefaultPort(spec *corev1.PodSpec, defaultPortName string, defaultPort int32, defaultContainerIndex int) {
+	// Nothing to default when no container is defined, the job is rejected by validation.
+	if defaultContainerIndex >= len(spec.Containers) {
+		return
+	}
 	spec.Containers[defaultContainerIndex].Ports = append(spec.Containers[defaultContainerIndex].Ports,
 		corev1.ContainerPort{
 			Name:          defaultPortName,
@@ -61,3 +68,12 @@ func setTypeNameToCamelCase(replicaSpecs map[ReplicaType]*ReplicaSpec, typ Repli
 func CleanPodPolicyPointer(cleanPodPolicy CleanPodPolicy) *CleanPodPolicy {
 	return &cleanPodPolicy
 }
+
+// SetDefaultQueuedJobSuspended suspends a job which is submitted to a queue with the QueueNameLabel,
+// so that its pods are only created once the queueing system admitted and resumed the job.
+// It must only be called on creation, since resuming the job is up to the queueing system.
+func SetDefaultQueuedJobSuspended(labels map[string]string, runPolicy *RunPolicy) {
+	if labels[QueueNameLabel] != "" {
+		runPolicy.Suspend = ptr.To(true)
+	}
+}
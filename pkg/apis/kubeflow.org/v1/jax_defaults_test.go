@@ -0,0 +1,182 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func expectedJAXJob(cleanPodPolicy CleanPodPolicy, restartPolicy RestartPolicy, replicas int32, portName string, port int32) *JAXJob {
+	var ports []corev1.ContainerPort
+
+	// port not set
+	if portName != "" {
+		ports = append(ports,
+			corev1.ContainerPort{
+				Name:          portName,
+				ContainerPort: port,
+			},
+		)
+	}
+
+	// port set with custom name
+	if portName != JAXJobDefaultPortName {
+		ports = append(ports,
+			corev1.ContainerPort{
+				Name:          JAXJobDefaultPortName,
+				ContainerPort: JAXJobDefaultPort,
+			},
+		)
+	}
+
+	return &JAXJob{
+		Spec: JAXJobSpec{
+			RunPolicy: RunPolicy{
+				CleanPodPolicy: &cleanPodPolicy,
+			},
+			JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+				JAXJobReplicaTypeWorker: {
+					Replicas:      ptr.To[int32](replicas),
+					RestartPolicy: restartPolicy,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  JAXJobDefaultContainerName,
+									Image: testImage,
+									Ports: ports,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSetDefaults_JAXJob(t *testing.T) {
+	testCases := map[string]struct {
+		original *JAXJob
+		expected *JAXJob
+	}{
+		"set spec with minimum setting": {
+			original: &JAXJob{
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						JAXJobReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  JAXJobDefaultContainerName,
+											Image: testImage,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: expectedJAXJob(CleanPodPolicyNone, JAXJobDefaultRestartPolicy, 1, JAXJobDefaultPortName, JAXJobDefaultPort),
+		},
+		"set spec with restart policy and replicas": {
+			original: &JAXJob{
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						JAXJobReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](3),
+							RestartPolicy: RestartPolicyOnFailure,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  JAXJobDefaultContainerName,
+											Image: testImage,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: expectedJAXJob(CleanPodPolicyNone, RestartPolicyOnFailure, 3, JAXJobDefaultPortName, JAXJobDefaultPort),
+		},
+		"set spec with custom port": {
+			original: &JAXJob{
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						JAXJobReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  JAXJobDefaultContainerName,
+											Image: testImage,
+											Ports: []corev1.ContainerPort{
+												{
+													Name:          JAXJobDefaultPortName,
+													ContainerPort: 1234,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: expectedJAXJob(CleanPodPolicyNone, JAXJobDefaultRestartPolicy, 1, JAXJobDefaultPortName, 1234),
+		},
+		"set spec with lowercase replica type and cleanpod policy": {
+			original: &JAXJob{
+				Spec: JAXJobSpec{
+					RunPolicy: RunPolicy{
+						CleanPodPolicy: CleanPodPolicyPointer(CleanPodPolicyAll),
+					},
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						"worker": {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  JAXJobDefaultContainerName,
+											Image: testImage,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: expectedJAXJob(CleanPodPolicyAll, JAXJobDefaultRestartPolicy, 1, JAXJobDefaultPortName, JAXJobDefaultPort),
+		},
+	}
+
+	for name, tc := range testCases {
+		SetDefaults_JAXJob(tc.original)
+		if !reflect.DeepEqual(tc.original, tc.expected) {
+			t.Errorf("%s: Want\n%v; Got\n %v", name, pformat(tc.expected), pformat(tc.original))
+		}
+	}
+}
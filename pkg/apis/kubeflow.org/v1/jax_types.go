@@ -0,0 +1,89 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// JAXJobDefaultPortName is name of the port used by the JAX coordinator.
+	JAXJobDefaultPortName = "jaxjob-port"
+	// JAXJobDefaultContainerName is the name of the JAXJob container.
+	JAXJobDefaultContainerName = "jax"
+	// JAXJobDefaultPort is default value of the port.
+	JAXJobDefaultPort = 6666
+	// JAXJobDefaultRestartPolicy is default RestartPolicy for JAXReplicaSpecs.
+	JAXJobDefaultRestartPolicy = RestartPolicyNever
+	// JAXJobKind is the kind name.
+	JAXJobKind = "JAXJob"
+	// JAXJobPlural is the JAXJobPlural for JAXJob.
+	JAXJobPlural = "jaxjobs"
+	// JAXJobSingular is the singular for JAXJob.
+	JAXJobSingular = "jaxjob"
+	// JAXJobFrameworkName is the name of the ML Framework
+	JAXJobFrameworkName = "jax"
+	// JAXJobReplicaTypeWorker is the type for worker replicas.
+	// Worker 0 runs the JAX coordinator service.
+	JAXJobReplicaTypeWorker ReplicaType = "Worker"
+)
+
+// JAXJobSpec defines the desired state of JAXJob
+type JAXJobSpec struct {
+	// RunPolicy encapsulates various runtime policies of the distributed training
+	// job, for example how to clean up resources and how long the job can stay
+	// active.
+	//+kubebuilder:validation:Optional
+	RunPolicy RunPolicy `json:"runPolicy"`
+
+	// A map of JAXReplicaType (type) to ReplicaSpec (value). Specifies the JAX cluster configuration.
+	// For example,
+	//   {
+	//     "Worker": JAXReplicaSpec,
+	//   }
+	JAXReplicaSpecs map[ReplicaType]*ReplicaSpec `json:"jaxReplicaSpecs"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.conditions[-1:].type`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JAXJob is the Schema for the jaxjobs API
+// +k8s:openapi-gen=true
+type JAXJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JAXJobSpec `json:"spec,omitempty"`
+	Status JobStatus  `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JAXJobList contains a list of JAXJob
+type JAXJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JAXJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&JAXJob{}, &JAXJobList{})
+	SchemeBuilder.SchemeBuilder.Register(addJAXJobDefaultingFuncs)
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+)
+
+func ValidateV1JAXJob(jaxJob *JAXJob) error {
+	if errors := apimachineryvalidation.NameIsDNS1035Label(jaxJob.ObjectMeta.Name, false); errors != nil {
+		return fmt.Errorf("JAXJob name is invalid: %v", errors)
+	}
+	if err := validateJAXReplicaSpecs(jaxJob.Spec.JAXReplicaSpecs); err != nil {
+		return err
+	}
+	if err := validateRunPolicy(&jaxJob.Spec.RunPolicy, jaxJob.Spec.JAXReplicaSpecs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateJAXReplicaSpecs(specs map[ReplicaType]*ReplicaSpec) error {
+	if specs == nil {
+		return fmt.Errorf("JAXJobSpec is not valid")
+	}
+	for rType, value := range specs {
+		if value == nil || len(value.Template.Spec.Containers) == 0 {
+			return fmt.Errorf("JAXJobSpec is not valid: containers definition expected in %v", rType)
+		}
+		if err := validatePodFailurePolicy(rType, value.PodFailurePolicy); err != nil {
+			return err
+		}
+		if err := validateRestartBackoff(rType, value.RestartBackoff); err != nil {
+			return err
+		}
+		// Make sure the replica type is valid.
+		if rType != JAXJobReplicaTypeWorker {
+			return fmt.Errorf("JAXReplicaType is %v but must be %v", rType, JAXJobReplicaTypeWorker)
+		}
+
+		// Make sure the image is defined in the container.
+		defaultContainerPresent := false
+		for _, container := range value.Template.Spec.Containers {
+			if container.Image == "" {
+				return fmt.Errorf("JAXReplicaType is not valid: Image is undefined in the container of %v", rType)
+			}
+			if container.Name == JAXJobDefaultContainerName {
+				defaultContainerPresent = true
+			}
+		}
+		// Make sure there has at least one container named "jax".
+		if !defaultContainerPresent {
+			return fmt.Errorf("JAXReplicaType is not valid: There is no container named %s in %v", JAXJobDefaultContainerName, rType)
+		}
+	}
+	if _, ok := specs[JAXJobReplicaTypeWorker]; !ok {
+		return fmt.Errorf("JAXReplicaType is not valid: Worker ReplicaSpec must be present")
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateV1JAXJob(t *testing.T) {
+	validJAXReplicaSpecs := map[ReplicaType]*ReplicaSpec{
+		JAXJobReplicaTypeWorker: {
+			Replicas:      ptr.To[int32](2),
+			RestartPolicy: RestartPolicyOnFailure,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:            "jax",
+						Image:           "docker.io/kubeflow/jaxjob-simple:latest",
+						Command:         []string{"python", "train.py"},
+						ImagePullPolicy: corev1.PullAlways,
+						Ports: []corev1.ContainerPort{{
+							Name:          "jaxjob-port",
+							ContainerPort: 6666,
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		jaxJob  *JAXJob
+		wantErr bool
+	}{
+		"valid JAXJob": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: validJAXReplicaSpecs,
+				},
+			},
+			wantErr: false,
+		},
+		"JAXJob name does not meet DNS1035": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "0-test",
+				},
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: validJAXReplicaSpecs,
+				},
+			},
+			wantErr: true,
+		},
+		"no replicaSpecs": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+			},
+			wantErr: true,
+		},
+		"empty containers": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						JAXJobReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"image is empty": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						JAXJobReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Name:  "jax",
+										Image: "",
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"jaxJob default container name doesn't present": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						JAXJobReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Name:  "",
+										Image: "docker.io/kubeflow/jaxjob-simple:latest",
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"replicaType is not Worker": {
+			jaxJob: &JAXJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: JAXJobSpec{
+					JAXReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						PyTorchJobReplicaTypeMaster: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Name:  "jax",
+										Image: "docker.io/kubeflow/jaxjob-simple:latest",
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateV1JAXJob(tc.jaxJob)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("ValidateV1JAXJob() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ package v1
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addMPIJobDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -29,6 +30,15 @@ func SetDefaults_MPIJob(mpiJob *MPIJob) {
 		mpiJob.Spec.RunPolicy.CleanPodPolicy = CleanPodPolicyPointer(CleanPodPolicyNone)
 	}
 
+	// Set default launcher mode
+	if mpiJob.Spec.LauncherMode == nil {
+		mode := MPIJobLauncherModeKubectlExec
+		mpiJob.Spec.LauncherMode = &mode
+	}
+	if *mpiJob.Spec.LauncherMode == MPIJobLauncherModeSSH && mpiJob.Spec.SSHAuthMountPath == "" {
+		mpiJob.Spec.SSHAuthMountPath = MPIJobDefaultSSHAuthMountPath
+	}
+
 	// Set default replicas
 	setDefaultReplicas(mpiJob.Spec.MPIReplicaSpecs[MPIJobReplicaTypeLauncher], 1)
 	setDefaultReplicas(mpiJob.Spec.MPIReplicaSpecs[MPIJobReplicaTypeWorker], 0)
@@ -36,4 +46,26 @@ func SetDefaults_MPIJob(mpiJob *MPIJob) {
 	// Set default restartPolicy
 	setDefaultRestartPolicy(mpiJob.Spec.MPIReplicaSpecs[MPIJobReplicaTypeLauncher], MPIJobDefaultRestartPolicy)
 	setDefaultRestartPolicy(mpiJob.Spec.MPIReplicaSpecs[MPIJobReplicaTypeWorker], MPIJobDefaultRestartPolicy)
+
+	// Set default elastic policy
+	setMPIJobElasticPolicy(mpiJob)
+}
+
+// setMPIJobElasticPolicy sets the unset bound of the elastic policy to the other one,
+// or both bounds to the worker replicas.
+func setMPIJobElasticPolicy(mpiJob *MPIJob) {
+	policy := mpiJob.Spec.ElasticPolicy
+	if policy == nil {
+		return
+	}
+	if policy.MinReplicas == nil && policy.MaxReplicas == nil {
+		if workerSpec := mpiJob.Spec.MPIReplicaSpecs[MPIJobReplicaTypeWorker]; workerSpec != nil && workerSpec.Replicas != nil {
+			policy.MinReplicas = ptr.To(*workerSpec.Replicas)
+			policy.MaxReplicas = ptr.To(*workerSpec.Replicas)
+		}
+	} else if policy.MinReplicas == nil {
+		policy.MinReplicas = ptr.To(*policy.MaxReplicas)
+	} else if policy.MaxReplicas == nil {
+		policy.MaxReplicas = ptr.To(*policy.MinReplicas)
+	}
 }
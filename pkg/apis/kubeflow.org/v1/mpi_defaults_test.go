@@ -15,6 +15,7 @@ func expectedMPIJob(cleanPodPolicy CleanPodPolicy, restartPolicy RestartPolicy)
 			RunPolicy: RunPolicy{
 				CleanPodPolicy: &cleanPodPolicy,
 			},
+			LauncherMode: ptr.To(MPIJobLauncherModeKubectlExec),
 			MPIReplicaSpecs: map[ReplicaType]*ReplicaSpec{
 				MPIJobReplicaTypeLauncher: {
 					Replicas:      ptr.To[int32](1),
@@ -161,6 +162,51 @@ func TestSetDefaults_MPIJob(t *testing.T) {
 			},
 			expected: expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy),
 		},
+		"set default ssh auth mount path": {
+			original: func() *MPIJob {
+				job := expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy)
+				job.Spec.LauncherMode = ptr.To(MPIJobLauncherModeSSH)
+				return job
+			}(),
+			expected: func() *MPIJob {
+				job := expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy)
+				job.Spec.LauncherMode = ptr.To(MPIJobLauncherModeSSH)
+				job.Spec.SSHAuthMountPath = MPIJobDefaultSSHAuthMountPath
+				return job
+			}(),
+		},
+		"set default elastic policy to the worker replicas": {
+			original: func() *MPIJob {
+				job := expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy)
+				job.Spec.MPIReplicaSpecs[MPIJobReplicaTypeWorker].Replicas = ptr.To[int32](4)
+				job.Spec.ElasticPolicy = &MPIJobElasticPolicy{}
+				return job
+			}(),
+			expected: func() *MPIJob {
+				job := expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy)
+				job.Spec.MPIReplicaSpecs[MPIJobReplicaTypeWorker].Replicas = ptr.To[int32](4)
+				job.Spec.ElasticPolicy = &MPIJobElasticPolicy{
+					MinReplicas: ptr.To[int32](4),
+					MaxReplicas: ptr.To[int32](4),
+				}
+				return job
+			}(),
+		},
+		"set default elastic policy max replicas": {
+			original: func() *MPIJob {
+				job := expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy)
+				job.Spec.ElasticPolicy = &MPIJobElasticPolicy{MinReplicas: ptr.To[int32](2)}
+				return job
+			}(),
+			expected: func() *MPIJob {
+				job := expectedMPIJob(CleanPodPolicyNone, MPIJobDefaultRestartPolicy)
+				job.Spec.ElasticPolicy = &MPIJobElasticPolicy{
+					MinReplicas: ptr.To[int32](2),
+					MaxReplicas: ptr.To[int32](2),
+				}
+				return job
+			}(),
+		},
 	}
 	for name, tc := range testCases {
 		SetDefaults_MPIJob(tc.original)
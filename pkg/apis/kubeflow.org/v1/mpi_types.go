@@ -15,6 +15,7 @@
 package v1
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -38,6 +39,21 @@ const (
 	MPIJobReplicaTypeLauncher ReplicaType = "Launcher"
 	// MPIJobReplicaTypeWorker is the type for worker replicas.
 	MPIJobReplicaTypeWorker ReplicaType = "Worker"
+	// MPIJobDefaultSSHAuthMountPath is the default directory the SSH keys are mounted in with the SSH launcher mode.
+	MPIJobDefaultSSHAuthMountPath = "/root/.ssh"
+)
+
+// MPIJobLauncherMode is the way the launcher starts the MPI processes on the workers.
+// +kubebuilder:validation:Enum=KubectlExec;SSH
+type MPIJobLauncherMode string
+
+const (
+	// MPIJobLauncherModeKubectlExec starts the processes with kubectl exec. The kubectl binary is delivered to the
+	// launcher by an init container and the launcher is given a Role allowing to exec into the workers.
+	MPIJobLauncherModeKubectlExec MPIJobLauncherMode = "KubectlExec"
+	// MPIJobLauncherModeSSH starts the processes over SSH with a keypair generated for the job.
+	// The workers must run an SSH server, no Role or ServiceAccount is created for the launcher.
+	MPIJobLauncherModeSSH MPIJobLauncherMode = "SSH"
 )
 
 // +genclient
@@ -47,6 +63,7 @@ const (
 // +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[-1:].type`,name="State",type=string
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.mpiReplicaSpecs.Worker.replicas,statuspath=.status.replicaStatuses.Worker.active,selectorpath=.status.replicaStatuses.Worker.selector
 
 type MPIJob struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -74,12 +91,47 @@ type MPIJobSpec struct {
 	// executes the MPI code.
 	MainContainer string `json:"mainContainer,omitempty"`
 
+	// LauncherMode specifies the way the launcher starts the MPI processes on the workers.
+	// Defaults to KubectlExec.
+	// +optional
+	LauncherMode *MPIJobLauncherMode `json:"launcherMode,omitempty"`
+
+	// SSHAuthMountPath is the directory the SSH keys of the job are mounted in, in the launcher
+	// and the workers, with the SSH launcher mode.
+	// Defaults to /root/.ssh.
+	// +optional
+	SSHAuthMountPath string `json:"sshAuthMountPath,omitempty"`
+
+	// ElasticPolicy makes the MPIJob elastic, the workers can be scaled between the
+	// min and max replicas while the job is running, for example with Horovod Elastic.
+	// +optional
+	ElasticPolicy *MPIJobElasticPolicy `json:"elasticPolicy,omitempty"`
+
 	// `RunPolicy` encapsulates various runtime policies of the distributed training
 	// job, for example how to clean up resources and how long the job can stay
 	// active.
 	RunPolicy RunPolicy `json:"runPolicy,omitempty"`
 }
 
+// MPIJobElasticPolicy is the elastic policy of an MPIJob. The launcher discovers the workers
+// added or removed while the job is running with the discover_hosts.sh script.
+type MPIJobElasticPolicy struct {
+	// MinReplicas is the lower limit for the number of workers to which the job can scale down.
+	// Defaults to the max replicas, or to the worker replicas if neither is set.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of workers to which the job can scale up.
+	// Defaults to the min replicas, or to the worker replicas if neither is set.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Metrics contains the specifications which are used by the HorizontalPodAutoscaler
+	// to calculate the desired number of workers.
+	// If not set, the HPA will not be created.
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resource:path=mpijobs
 // +kubebuilder:object:root=true
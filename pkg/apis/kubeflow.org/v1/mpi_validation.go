@@ -27,6 +27,12 @@ func ValidateV1MpiJobSpec(c *MPIJobSpec) error {
 		if value == nil || len(value.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("MPIReplicaSpecs is not valid: containers definition expected in %v", rType)
 		}
+		if value.PodFailurePolicy != nil {
+			return fmt.Errorf("MPIReplicaSpecs is not valid: podFailurePolicy is not supported in %v", rType)
+		}
+		if value.RestartBackoff != nil {
+			return fmt.Errorf("MPIReplicaSpecs is not valid: restartBackoff is not supported in %v", rType)
+		}
 		// Make sure the replica type is valid.
 		validReplicaTypes := []ReplicaType{MPIJobReplicaTypeLauncher, MPIJobReplicaTypeWorker}
 
@@ -64,6 +70,51 @@ func ValidateV1MpiJobSpec(c *MPIJobSpec) error {
 	if !launcherExists {
 		return fmt.Errorf("MPIReplicaSpec is not valid: Master ReplicaSpec must be present")
 	}
+	if c.LauncherMode != nil && *c.LauncherMode != MPIJobLauncherModeKubectlExec && *c.LauncherMode != MPIJobLauncherModeSSH {
+		return fmt.Errorf("MPIJobSpec is not valid: launcherMode must be one of %v", []MPIJobLauncherMode{MPIJobLauncherModeKubectlExec, MPIJobLauncherModeSSH})
+	}
+	if len(c.SSHAuthMountPath) > 0 && (c.LauncherMode == nil || *c.LauncherMode != MPIJobLauncherModeSSH) {
+		return fmt.Errorf("MPIJobSpec is not valid: sshAuthMountPath is only supported with the SSH launcherMode")
+	}
+	if err := validateMPIJobElasticPolicy(c.ElasticPolicy, c.MPIReplicaSpecs[MPIJobReplicaTypeWorker]); err != nil {
+		return err
+	}
+	if err := validateRunPolicy(&c.RunPolicy, c.MPIReplicaSpecs); err != nil {
+		return err
+	}
+	if c.RunPolicy.StartupPolicy != nil {
+		// The workers must start first since mpirun connects to them from the launcher.
+		for _, dependency := range c.RunPolicy.StartupPolicy.Dependencies {
+			if dependency.ReplicaType != MPIJobReplicaTypeLauncher {
+				return fmt.Errorf("MPIJobSpec is not valid: only the startup of the launcher can be delayed")
+			}
+		}
+	}
 	return nil
 
 }
+
+func validateMPIJobElasticPolicy(policy *MPIJobElasticPolicy, workerSpec *ReplicaSpec) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MinReplicas != nil && *policy.MinReplicas < 1 {
+		return fmt.Errorf("MPIJobSpec is not valid: elasticPolicy.minReplicas must be at least 1")
+	}
+	if policy.MaxReplicas != nil && *policy.MaxReplicas < 1 {
+		return fmt.Errorf("MPIJobSpec is not valid: elasticPolicy.maxReplicas must be at least 1")
+	}
+	if policy.MinReplicas != nil && policy.MaxReplicas != nil && *policy.MinReplicas > *policy.MaxReplicas {
+		return fmt.Errorf("MPIJobSpec is not valid: elasticPolicy.minReplicas must not be greater than elasticPolicy.maxReplicas")
+	}
+	if workerSpec == nil || workerSpec.Replicas == nil {
+		return nil
+	}
+	if policy.MinReplicas != nil && *workerSpec.Replicas < *policy.MinReplicas {
+		return fmt.Errorf("MPIJobSpec is not valid: the worker replicas must not be less than elasticPolicy.minReplicas")
+	}
+	if policy.MaxReplicas != nil && *workerSpec.Replicas > *policy.MaxReplicas {
+		return fmt.Errorf("MPIJobSpec is not valid: the worker replicas must not be greater than elasticPolicy.maxReplicas")
+	}
+	return nil
+}
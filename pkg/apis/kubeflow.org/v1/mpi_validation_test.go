@@ -85,6 +85,113 @@ func TestValidateV1MpiJobSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			MPIReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+				MPIJobReplicaTypeLauncher: &ReplicaSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								corev1.Container{
+									Name:  "mpi",
+									Image: "mpioperator/mpi-pi",
+								},
+							},
+						},
+					},
+				},
+			},
+			LauncherMode: ptr.To(MPIJobLauncherMode("Rsh")),
+		},
+		{
+			MPIReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+				MPIJobReplicaTypeLauncher: &ReplicaSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								corev1.Container{
+									Name:  "mpi",
+									Image: "mpioperator/mpi-pi",
+								},
+							},
+						},
+					},
+				},
+			},
+			LauncherMode:     ptr.To(MPIJobLauncherModeKubectlExec),
+			SSHAuthMountPath: "/home/mpiuser/.ssh",
+		},
+		{
+			MPIReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+				MPIJobReplicaTypeLauncher: &ReplicaSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								corev1.Container{
+									Name:  "mpi",
+									Image: "mpioperator/mpi-pi",
+								},
+							},
+						},
+					},
+				},
+			},
+			ElasticPolicy: &MPIJobElasticPolicy{
+				MinReplicas: ptr.To[int32](4),
+				MaxReplicas: ptr.To[int32](2),
+			},
+		},
+		{
+			MPIReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+				MPIJobReplicaTypeLauncher: &ReplicaSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								corev1.Container{
+									Name:  "mpi",
+									Image: "mpioperator/mpi-pi",
+								},
+							},
+						},
+					},
+				},
+			},
+			ElasticPolicy: &MPIJobElasticPolicy{
+				MinReplicas: ptr.To[int32](0),
+			},
+		},
+		{
+			MPIReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+				MPIJobReplicaTypeLauncher: &ReplicaSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								corev1.Container{
+									Name:  "mpi",
+									Image: "mpioperator/mpi-pi",
+								},
+							},
+						},
+					},
+				},
+				MPIJobReplicaTypeWorker: &ReplicaSpec{
+					Replicas: ptr.To[int32](5),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								corev1.Container{
+									Name:  "mpi",
+									Image: "mpioperator/mpi-pi",
+								},
+							},
+						},
+					},
+				},
+			},
+			ElasticPolicy: &MPIJobElasticPolicy{
+				MinReplicas: ptr.To[int32](1),
+				MaxReplicas: ptr.To[int32](4),
+			},
+		},
 	}
 	for _, c := range testCases {
 		err := ValidateV1MpiJobSpec(&c)
@@ -54,6 +54,9 @@ func SetDefaults_MXJob(mxjob *MXJob) {
 	setMXNetTypeNamesToCamelCase(mxjob)
 
 	for _, spec := range mxjob.Spec.MXReplicaSpecs {
+		if spec == nil {
+			continue
+		}
 		// Set default replicas to 1
 		setDefaultReplicas(spec, 1)
 		// Set default default restartPolicy
@@ -29,6 +29,9 @@ func ValidateV1MXJob(mxJob *MXJob) error {
 	if err := validateMXReplicaSpecs(mxJob.Spec.MXReplicaSpecs); err != nil {
 		return err
 	}
+	if err := validateRunPolicy(&mxJob.Spec.RunPolicy, mxJob.Spec.MXReplicaSpecs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -46,6 +49,12 @@ func validateMXReplicaSpecs(specs map[ReplicaType]*ReplicaSpec) error {
 		if value == nil || len(value.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("MXJobSpec is not valid")
 		}
+		if err := validatePodFailurePolicy(rType, value.PodFailurePolicy); err != nil {
+			return err
+		}
+		if err := validateRestartBackoff(rType, value.RestartBackoff); err != nil {
+			return err
+		}
 		if IsScheduler(rType) {
 			foundScheduler++
 		}
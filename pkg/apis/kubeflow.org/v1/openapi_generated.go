@@ -28,34 +28,156 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ElasticPolicy":       schema_pkg_apis_kubefloworg_v1_ElasticPolicy(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobCondition":        schema_pkg_apis_kubefloworg_v1_JobCondition(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus":           schema_pkg_apis_kubefloworg_v1_JobStatus(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJob":              schema_pkg_apis_kubefloworg_v1_MPIJob(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobList":          schema_pkg_apis_kubefloworg_v1_MPIJobList(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobSpec":          schema_pkg_apis_kubefloworg_v1_MPIJobSpec(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJob":               schema_pkg_apis_kubefloworg_v1_MXJob(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobList":           schema_pkg_apis_kubefloworg_v1_MXJobList(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobSpec":           schema_pkg_apis_kubefloworg_v1_MXJobSpec(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobStatus":         schema_pkg_apis_kubefloworg_v1_MXJobStatus(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleElasticPolicy": schema_pkg_apis_kubefloworg_v1_PaddleElasticPolicy(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJob":           schema_pkg_apis_kubefloworg_v1_PaddleJob(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobList":       schema_pkg_apis_kubefloworg_v1_PaddleJobList(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobSpec":       schema_pkg_apis_kubefloworg_v1_PaddleJobSpec(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJob":          schema_pkg_apis_kubefloworg_v1_PyTorchJob(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobList":      schema_pkg_apis_kubefloworg_v1_PyTorchJobList(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobSpec":      schema_pkg_apis_kubefloworg_v1_PyTorchJobSpec(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVConf":            schema_pkg_apis_kubefloworg_v1_RDZVConf(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec":         schema_pkg_apis_kubefloworg_v1_ReplicaSpec(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStatus":       schema_pkg_apis_kubefloworg_v1_ReplicaStatus(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy":           schema_pkg_apis_kubefloworg_v1_RunPolicy(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.SchedulingPolicy":    schema_pkg_apis_kubefloworg_v1_SchedulingPolicy(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJob":               schema_pkg_apis_kubefloworg_v1_TFJob(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobList":           schema_pkg_apis_kubefloworg_v1_TFJobList(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobSpec":           schema_pkg_apis_kubefloworg_v1_TFJobSpec(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.XGBoostJob":          schema_pkg_apis_kubefloworg_v1_XGBoostJob(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.XGBoostJobList":      schema_pkg_apis_kubefloworg_v1_XGBoostJobList(ref),
-		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.XGBoostJobSpec":      schema_pkg_apis_kubefloworg_v1_XGBoostJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ClusterTrainingRuntime":                 schema_pkg_apis_kubefloworg_v1_ClusterTrainingRuntime(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ClusterTrainingRuntimeList":             schema_pkg_apis_kubefloworg_v1_ClusterTrainingRuntimeList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ElasticPolicy":                          schema_pkg_apis_kubefloworg_v1_ElasticPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.FailureDetails":                         schema_pkg_apis_kubefloworg_v1_FailureDetails(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.GracefulTermination":                    schema_pkg_apis_kubefloworg_v1_GracefulTermination(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJob":                                 schema_pkg_apis_kubefloworg_v1_JAXJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJobList":                             schema_pkg_apis_kubefloworg_v1_JAXJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJobSpec":                             schema_pkg_apis_kubefloworg_v1_JAXJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobCondition":                           schema_pkg_apis_kubefloworg_v1_JobCondition(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobDependency":                          schema_pkg_apis_kubefloworg_v1_JobDependency(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus":                              schema_pkg_apis_kubefloworg_v1_JobStatus(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobSuccessPolicy":                       schema_pkg_apis_kubefloworg_v1_JobSuccessPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJob":                                 schema_pkg_apis_kubefloworg_v1_MPIJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobElasticPolicy":                    schema_pkg_apis_kubefloworg_v1_MPIJobElasticPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobList":                             schema_pkg_apis_kubefloworg_v1_MPIJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobSpec":                             schema_pkg_apis_kubefloworg_v1_MPIJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJob":                                  schema_pkg_apis_kubefloworg_v1_MXJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobList":                              schema_pkg_apis_kubefloworg_v1_MXJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobSpec":                              schema_pkg_apis_kubefloworg_v1_MXJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobStatus":                            schema_pkg_apis_kubefloworg_v1_MXJobStatus(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleElasticPolicy":                    schema_pkg_apis_kubefloworg_v1_PaddleElasticPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJob":                              schema_pkg_apis_kubefloworg_v1_PaddleJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobList":                          schema_pkg_apis_kubefloworg_v1_PaddleJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobSpec":                          schema_pkg_apis_kubefloworg_v1_PaddleJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicy":                       schema_pkg_apis_kubefloworg_v1_PodFailurePolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyOnExitCodesRequirement": schema_pkg_apis_kubefloworg_v1_PodFailurePolicyOnExitCodesRequirement(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyOnPodConditionsPattern": schema_pkg_apis_kubefloworg_v1_PodFailurePolicyOnPodConditionsPattern(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyRule":                   schema_pkg_apis_kubefloworg_v1_PodFailurePolicyRule(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJob":                             schema_pkg_apis_kubefloworg_v1_PyTorchJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobList":                         schema_pkg_apis_kubefloworg_v1_PyTorchJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobSpec":                         schema_pkg_apis_kubefloworg_v1_PyTorchJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVConf":                               schema_pkg_apis_kubefloworg_v1_RDZVConf(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVStore":                              schema_pkg_apis_kubefloworg_v1_RDZVStore(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaAdmission":                       schema_pkg_apis_kubefloworg_v1_ReplicaAdmission(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaIndexStatus":                     schema_pkg_apis_kubefloworg_v1_ReplicaIndexStatus(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec":                            schema_pkg_apis_kubefloworg_v1_ReplicaSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStartupDependency":               schema_pkg_apis_kubefloworg_v1_ReplicaStartupDependency(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStatus":                          schema_pkg_apis_kubefloworg_v1_ReplicaStatus(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RestartBackoff":                         schema_pkg_apis_kubefloworg_v1_RestartBackoff(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy":                              schema_pkg_apis_kubefloworg_v1_RunPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RuntimeRef":                             schema_pkg_apis_kubefloworg_v1_RuntimeRef(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.SchedulingPolicy":                       schema_pkg_apis_kubefloworg_v1_SchedulingPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.StartupPolicy":                          schema_pkg_apis_kubefloworg_v1_StartupPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJob":                                  schema_pkg_apis_kubefloworg_v1_TFJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobList":                              schema_pkg_apis_kubefloworg_v1_TFJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobSpec":                              schema_pkg_apis_kubefloworg_v1_TFJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TopologyPolicy":                         schema_pkg_apis_kubefloworg_v1_TopologyPolicy(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJob":                        schema_pkg_apis_kubefloworg_v1_TrainingCronJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobList":                    schema_pkg_apis_kubefloworg_v1_TrainingCronJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobSpec":                    schema_pkg_apis_kubefloworg_v1_TrainingCronJobSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobStatus":                  schema_pkg_apis_kubefloworg_v1_TrainingCronJobStatus(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntime":                        schema_pkg_apis_kubefloworg_v1_TrainingRuntime(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntimeList":                    schema_pkg_apis_kubefloworg_v1_TrainingRuntimeList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntimeSpec":                    schema_pkg_apis_kubefloworg_v1_TrainingRuntimeSpec(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.XGBoostJob":                             schema_pkg_apis_kubefloworg_v1_XGBoostJob(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.XGBoostJobList":                         schema_pkg_apis_kubefloworg_v1_XGBoostJobList(ref),
+		"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.XGBoostJobSpec":                         schema_pkg_apis_kubefloworg_v1_XGBoostJobSpec(ref),
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ClusterTrainingRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTrainingRuntime represents a template of the replicas and the run policy of jobs, which can be referenced from the jobs of any namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the template of the jobs.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntimeSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntimeSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ClusterTrainingRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTrainingRuntimeList is a list of ClusterTrainingRuntimes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ClusterTrainingRuntimes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ClusterTrainingRuntime"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ClusterTrainingRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
@@ -151,65 +273,76 @@ func schema_pkg_apis_kubefloworg_v1_ElasticPolicy(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"rdzvStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RDZVStore runs the rendezvous backend in a pod owned by the PyTorchJob, a C10d TCP store or a single-member etcd depending on the RDZVBackend, and points the rendezvous endpoint to its Service. Unlike the default endpoint on the first worker, the store outlives the rescheduling and the scale-down of the workers. The store is deleted when the job is suspended, and with the pods of the job once it finishes. It can't be set together with RDZVHost or Standalone.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVStore"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVConf", "k8s.io/api/autoscaling/v2.MetricSpec"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVConf", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RDZVStore", "k8s.io/api/autoscaling/v2.MetricSpec"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_JobCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_FailureDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JobCondition describes the state of the job at a certain point.",
+				Description: "FailureDetails describes the first failing pod of a failed job.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"replicaType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of job condition.",
-							Default:     "",
+							Description: "ReplicaType is the replica type of the failing pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "PodName is the name of the failing pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"containerName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "ContainerName is the name of the container of the pod which terminated with an error.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"exitCode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "ExitCode is the exit code of the container.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is why the container terminated or the pod failed, e.g. OOMKilled, Error, Evicted or DeadlineExceeded.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time this condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Message is the tail of the termination message of the container, or the message of the pod.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"failureTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
+							Description: "FailureTime is when the container terminated.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
@@ -217,72 +350,39 @@ func schema_pkg_apis_kubefloworg_v1_JobCondition(ref common.ReferenceCallback) c
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_GracefulTermination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JobStatus represents the current observed state of the training Job.",
+				Description: "GracefulTermination configures the checkpoint window before the pods of a job are deleted. The pods of the job are annotated with CheckpointRequestedAnnotation, which the training code can watch through the downward API, and the running pods are sent the Signal if any. The pods are deleted once the replica with the master role reports the checkpoint as complete, with either CheckpointCompleteAnnotation or the PodCheckpointComplete condition, or once the timeout expires.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Conditions is an array of current observed job conditions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobCondition"),
-									},
-								},
-							},
-						},
-					},
-					"replicaStatuses": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ReplicaStatuses is map of ReplicaType and ReplicaStatus, specifies the status of each replica.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStatus"),
-									},
-								},
-							},
-						},
-					},
-					"startTime": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents time when the job was acknowledged by the job controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"completionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Represents time when the job was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "TimeoutSeconds is the maximum time to wait for the checkpoint before the pods are deleted. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"lastReconcileTime": {
+					"signal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents last time when the job was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Signal is sent to the main process of the default container of the running pods when the checkpoint is requested. It is sent with the kill command of the container, through the exec of the pod, so the image must have a kill binary and the training code must run as the process 1 of the container, otherwise the signal is lost. Defaults to no signal, the training code watches the annotation instead.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobCondition", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MPIJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JAXJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "JAXJob is the Schema for the jaxjobs API",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -307,7 +407,7 @@ func schema_pkg_apis_kubefloworg_v1_MPIJob(ref common.ReferenceCallback) common.
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobSpec"),
+							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJobSpec"),
 						},
 					},
 					"status": {
@@ -320,15 +420,16 @@ func schema_pkg_apis_kubefloworg_v1_MPIJob(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJobSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MPIJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JAXJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "JAXJobList contains a list of JAXJob",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -357,7 +458,7 @@ func schema_pkg_apis_kubefloworg_v1_MPIJobList(ref common.ReferenceCallback) com
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJob"),
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJob"),
 									},
 								},
 							},
@@ -368,33 +469,27 @@ func schema_pkg_apis_kubefloworg_v1_MPIJobList(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JAXJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MPIJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JAXJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "JAXJobSpec defines the desired state of JAXJob",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"slotsPerWorker": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the number of slots per worker used in hostfile. Defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"cleanPodPolicy": {
+					"runPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanPodPolicy defines the policy that whether to kill pods after the job completes. Defaults to None.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
 						},
 					},
-					"mpiReplicaSpecs": {
+					"jaxReplicaSpecs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "`MPIReplicaSpecs` contains maps from `MPIReplicaType` to `ReplicaSpec` that specify the MPI replicas to run.",
+							Description: "A map of JAXReplicaType (type) to ReplicaSpec (value). Specifies the JAX cluster configuration. For example,\n  {\n    \"Worker\": JAXReplicaSpec,\n  }",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -406,22 +501,8 @@ func schema_pkg_apis_kubefloworg_v1_MPIJobSpec(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"mainContainer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MainContainer specifies name of the main container which executes the MPI code.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"runPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "`RunPolicy` encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
-						},
-					},
 				},
-				Required: []string{"mpiReplicaSpecs"},
+				Required: []string{"runPolicy", "jaxReplicaSpecs"},
 			},
 		},
 		Dependencies: []string{
@@ -429,213 +510,236 @@ func schema_pkg_apis_kubefloworg_v1_MPIJobSpec(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MXJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JobCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MXJob is the Schema for the mxjobs API",
+				Description: "JobCondition describes the state of the job at a certain point.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type of job condition.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobSpec"),
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
+							Description: "The last time this condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MXJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JobDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MXJobList contains a list of MXJob",
+				Description: "JobDependency references a job of the namespace the job waits for.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Kind is the kind of the job, e.g. PyTorchJob.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the name of the job.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"condition": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJob"),
-									},
-								},
-							},
+							Description: "Condition is the condition of the job to wait for, one of Running, Succeeded or Failed. Defaults to Succeeded.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"kind", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MXJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MXJobSpec defines the desired state of MXJob",
+				Description: "JobStatus represents the current observed state of the training Job.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"runPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
-						},
-					},
-					"jobMode": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobMode specify the kind of MXjob to do. Different mode may have different MXReplicaSpecs request",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Conditions is an array of current observed job conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobCondition"),
+									},
+								},
+							},
 						},
 					},
-					"mxReplicaSpecs": {
+					"replicaStatuses": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MXReplicaSpecs is map of ReplicaType and ReplicaSpec specifies the MX replicas to run. For example,\n  {\n    \"Scheduler\": ReplicaSpec,\n    \"Server\": ReplicaSpec,\n    \"Worker\": ReplicaSpec,\n  }",
+							Description: "ReplicaStatuses is map of ReplicaType and ReplicaStatus, specifies the status of each replica.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec"),
+										Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStatus"),
 									},
 								},
 							},
 						},
 					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the job was acknowledged by the job controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the job was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents last time when the job was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failureDetails": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureDetails describes the first pod failure which caused the job to fail. It is set once the job has a Failed condition.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.FailureDetails"),
+						},
+					},
+					"restartGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartGeneration is the number of times all the replicas of the job were restarted together with RestartScope Job.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"runPolicy", "jobMode", "mxReplicaSpecs"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.FailureDetails", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobCondition", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_MXJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_JobSuccessPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MXJobStatus defines the observed state of MXJob",
+				Description: "JobSuccessPolicy defines when a job is marked as succeeded, the same way for all frameworks.",
 				Type:        []string{"object"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_kubefloworg_v1_PaddleElasticPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "minReplicas is the lower limit for the number of replicas to which the training job can scale down.  It defaults to null.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxReplicas": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than MinReplicas, defaults to null.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Mode is one of Leader, AllReplicas and MinSucceeded.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxRestarts": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxRestarts is the limit for restart times of pods in elastic mode.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+					"replicaTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
 						},
-					},
-					"metrics": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metrics contains the specifications which are used to calculate the desired replica count (the maximum replica count across all metrics will be used).  The desired replica count is calculated with multiplying the ratio between the target value and the current value by the current number of pods. Ergo, metrics used must decrease as the pod count is increased, and vice-versa.  See the individual metric source types for more information about how each type of metric must respond. If not set, the HPA will not be created.",
+							Description: "ReplicaTypes are the replica types whose replicas must all succeed in the AllReplicas mode. Defaults to all replica types of the job.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/autoscaling/v2.MetricSpec"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"replicaType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaType is the replica type counted in the MinSucceeded mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minSucceeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinSucceeded is the number of replicas of ReplicaType which must succeed in the MinSucceeded mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"mode"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/autoscaling/v2.MetricSpec"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_PaddleJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_MPIJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PaddleJob Represents a PaddleJob resource.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -659,32 +763,72 @@ func schema_pkg_apis_kubefloworg_v1_PaddleJob(ref common.ReferenceCallback) comm
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the desired state of the PaddleJob.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the PaddleJob. Read-only (modified by the system).",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_PaddleJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_MPIJobElasticPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PaddleJobList is a list of PaddleJobs.",
+				Description: "MPIJobElasticPolicy is the elastic policy of an MPIJob. The launcher discovers the workers added or removed while the job is running with the discover_hosts.sh script.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the lower limit for the number of workers to which the job can scale down. Defaults to the max replicas, or to the worker replicas if neither is set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the upper limit for the number of workers to which the job can scale up. Defaults to the min replicas, or to the worker replicas if neither is set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics contains the specifications which are used by the HorizontalPodAutoscaler to calculate the desired number of workers. If not set, the HPA will not be created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/autoscaling/v2.MetricSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/autoscaling/v2.MetricSpec"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_MPIJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -702,20 +846,18 @@ func schema_pkg_apis_kubefloworg_v1_PaddleJobList(ref common.ReferenceCallback)
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of PaddleJobs.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJob"),
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJob"),
 									},
 								},
 							},
@@ -726,33 +868,33 @@ func schema_pkg_apis_kubefloworg_v1_PaddleJobList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_PaddleJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_MPIJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PaddleJobSpec is a desired state description of the PaddleJob.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"runPolicy": {
+					"slotsPerWorker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
+							Description: "Specifies the number of slots per worker used in hostfile. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"elasticPolicy": {
+					"cleanPodPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ElasticPolicy holds the elastic policy for paddle job.",
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleElasticPolicy"),
+							Description: "CleanPodPolicy defines the policy that whether to kill pods after the job completes. Defaults to None.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"paddleReplicaSpecs": {
+					"mpiReplicaSpecs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A map of PaddleReplicaType (type) to ReplicaSpec (value). Specifies the Paddle cluster configuration. For example,\n  {\n    \"Master\": PaddleReplicaSpec,\n    \"Worker\": PaddleReplicaSpec,\n  }",
+							Description: "`MPIReplicaSpecs` contains maps from `MPIReplicaType` to `ReplicaSpec` that specify the MPI replicas to run.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -764,52 +906,1377 @@ func schema_pkg_apis_kubefloworg_v1_PaddleJobSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
-				},
-				Required: []string{"runPolicy", "paddleReplicaSpecs"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleElasticPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
-	}
-}
-
-func schema_pkg_apis_kubefloworg_v1_PyTorchJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PyTorchJob Represents a PyTorchJob resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"mainContainer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "MainContainer specifies name of the main container which executes the MPI code.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"launcherMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "LauncherMode specifies the way the launcher starts the MPI processes on the workers. Defaults to KubectlExec.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"sshAuthMountPath": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "SSHAuthMountPath is the directory the SSH keys of the job are mounted in, in the launcher and the workers, with the SSH launcher mode. Defaults to /root/.ssh.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"elasticPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the desired state of the PyTorchJob.",
+							Description: "ElasticPolicy makes the MPIJob elastic, the workers can be scaled between the min and max replicas while the job is running, for example with Horovod Elastic.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobElasticPolicy"),
+						},
+					},
+					"runPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "`RunPolicy` encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
+						},
+					},
+				},
+				Required: []string{"mpiReplicaSpecs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MPIJobElasticPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_MXJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MXJob is the Schema for the mxjobs API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_MXJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MXJobList contains a list of MXJob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJob"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.MXJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_MXJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MXJobSpec defines the desired state of MXJob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
+						},
+					},
+					"jobMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobMode specify the kind of MXjob to do. Different mode may have different MXReplicaSpecs request",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mxReplicaSpecs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MXReplicaSpecs is map of ReplicaType and ReplicaSpec specifies the MX replicas to run. For example,\n  {\n    \"Scheduler\": ReplicaSpec,\n    \"Server\": ReplicaSpec,\n    \"Worker\": ReplicaSpec,\n  }",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"runPolicy", "jobMode", "mxReplicaSpecs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_MXJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MXJobStatus defines the observed state of MXJob",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PaddleElasticPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "minReplicas is the lower limit for the number of replicas to which the training job can scale down.  It defaults to null.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than MinReplicas, defaults to null.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRestarts is the limit for restart times of pods in elastic mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics contains the specifications which are used to calculate the desired replica count (the maximum replica count across all metrics will be used).  The desired replica count is calculated with multiplying the ratio between the target value and the current value by the current number of pods. Ergo, metrics used must decrease as the pod count is increased, and vice-versa.  See the individual metric source types for more information about how each type of metric must respond. If not set, the HPA will not be created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/autoscaling/v2.MetricSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/autoscaling/v2.MetricSpec"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PaddleJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PaddleJob Represents a PaddleJob resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired state of the PaddleJob.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the PaddleJob. Read-only (modified by the system).",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PaddleJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PaddleJobList is a list of PaddleJobs.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of PaddleJobs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJob"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PaddleJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PaddleJobSpec is a desired state description of the PaddleJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
+						},
+					},
+					"elasticPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ElasticPolicy holds the elastic policy for paddle job.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleElasticPolicy"),
+						},
+					},
+					"paddleReplicaSpecs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A map of PaddleReplicaType (type) to ReplicaSpec (value). Specifies the Paddle cluster configuration. For example,\n  {\n    \"Master\": PaddleReplicaSpec,\n    \"Worker\": PaddleReplicaSpec,\n  }",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"runPolicy", "paddleReplicaSpecs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PaddleElasticPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PodFailurePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodFailurePolicy describes how failed pods influence the job, it is modeled after the pod failure policy of batch/v1 Jobs.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules is the ordered list of rules, evaluated until the first match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"rules"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyRule"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PodFailurePolicyOnExitCodesRequirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodFailurePolicyOnExitCodesRequirement matches the exit codes of the terminated containers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"containerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerName restricts the check to the container with the given name. If unset, the rule matches if any container of the pod matches.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operator is the relationship between the exit code and the values.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the exit codes to check. With the operator NotIn, containers which exited with 0 are not considered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"operator", "values"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PodFailurePolicyOnPodConditionsPattern(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodFailurePolicyOnPodConditionsPattern matches a condition of the pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the pod condition, e.g. DisruptionTarget.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the pod condition, defaults to True.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PodFailurePolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodFailurePolicyRule describes a rule of the PodFailurePolicy. Exactly one of OnExitCodes and OnPodConditions must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action to take when the rule matches.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"onExitCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnExitCodes matches the exit codes of the terminated containers.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyOnExitCodesRequirement"),
+						},
+					},
+					"onPodConditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OnPodConditions matches the conditions of the pod, e.g. DisruptionTarget for pods which have been evicted or preempted. The rule matches if any of the patterns matches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyOnPodConditionsPattern"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"action"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyOnExitCodesRequirement", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicyOnPodConditionsPattern"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PyTorchJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PyTorchJob Represents a PyTorchJob resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired state of the PyTorchJob.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the PyTorchJob. Read-only (modified by the system).",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PyTorchJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PyTorchJobList is a list of PyTorchJobs.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of PyTorchJobs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJob"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_PyTorchJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PyTorchJobSpec is a desired state description of the PyTorchJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
+						},
+					},
+					"elasticPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ElasticPolicy"),
+						},
+					},
+					"pytorchReplicaSpecs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A map of PyTorchReplicaType (type) to ReplicaSpec (value). Specifies the PyTorch cluster configuration. For example,\n  {\n    \"Master\": PyTorchReplicaSpec,\n    \"Worker\": PyTorchReplicaSpec,\n  }",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec"),
+									},
+								},
+							},
+						},
+					},
+					"nprocPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of workers per node; supported values: [auto, cpu, gpu, int]. For more, https://github.com/pytorch/pytorch/blob/26f7f470df64d90e092081e39507e4ac751f55d6/torch/distributed/run.py#L629-L658. Defaults to auto.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"runPolicy", "pytorchReplicaSpecs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ElasticPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_RDZVConf(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_RDZVStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDZVStore is a rendezvous backend managed by the PyTorchJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image of the rendezvous store. For the C10d backend, it defaults to the image of the pytorch container of the workers, and it must have PyTorch installed. For the etcd backends, it defaults to an etcd image serving the v2 API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources of the rendezvous store.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ReplicaAdmission(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaAdmission holds the scheduling constraints a queueing system assigned to the replicas of a replica type on admission, e.g. to place them on the nodes of a resource flavor. They are added to the pods of the replicas, the templates in the ReplicaSpecs are not changed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is merged into the node selector of the pods, it takes precedence on conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations are added to the tolerations of the pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ReplicaIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaIndexStatus represents the current observed state of the pod of a replica index.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The index of the replica.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the pod of the index.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The phase of the pod of the index.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the node the pod of the index is scheduled to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times the pod of the index was restarted by the operator since the job was created.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"containerRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times the containers of the current pod of the index were restarted by the kubelet.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastExitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The exit code of the container of the pod of the index which terminated last.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"index"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaSpec is a description of the replica",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the object that describes the pod that will be created for this replica. RestartPolicy in PodTemplateSpec will be overide by RestartPolicy in ReplicaSpec",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.PodTemplateSpec"),
+						},
+					},
+					"restartPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Restart policy for all replicas within the job. One of Always, OnFailure, Never and ExitCode. Default to Never.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podFailurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodFailurePolicy specifies how failed pods of the replicas are handled. If set, the pods are created with the restart policy Never and each failed pod is matched against the rules, the first matching rule takes effect. Failed pods which match no rule are handled according to RestartPolicy. Not supported by MPIJob.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicy"),
+						},
+					},
+					"restartBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartBackoff delays the recreation of the pods of the replicas restarted by the operator, the delay doubles with every consecutive restart. If unset, the pods are recreated right away. Not supported by MPIJob.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RestartBackoff"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PodFailurePolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RestartBackoff", "k8s.io/api/core/v1.PodTemplateSpec"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ReplicaStartupDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaStartupDependency delays the startup of a replica type until the replica types it depends on reached the condition. The replicas which already succeeded satisfy any condition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicaType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaType is the replica type whose pods are delayed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dependsOn": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn are the replica types whose replicas must all reach the condition first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Condition is either Running or Ready. Defaults to Running.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"replicaType", "dependsOn"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_ReplicaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaStatus represents the current observed state of the replica.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of actively running pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of pods which reached phase Succeeded.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of pods which reached phase Failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of running pods which have a Ready condition.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"terminating": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of pods which are being deleted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The total number of times the pods were restarted by the operator since the job was created.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"countedRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of Restarts which count towards the BackoffLimit of the job, i.e. all the restarts but those of the failures ignored by the PodFailurePolicy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of consecutive restarts delayed by the RestartBackoff of the replica type.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextRestartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time the pods restarted with the RestartBackoff of the replica type are recreated at.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: Use Selector instead",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty Selector matches all objects. A null Selector matches no objects.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"indexes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"index",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The observed state of the pod of each index, ordered by index. Only the first MaxReplicaIndexStatuses indexes are reported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaIndexStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_RestartBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RestartBackoff describes the exponential backoff of the restarts of the pods of a replica type. The pods failed while a restart is delayed are recreated with it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"baseSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaseSeconds is the delay of the first restart. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSeconds is the maximum delay of a restart. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resetAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResetAfterSeconds is the time the restarted pods have to run without failure for the delay to be reset to BaseSeconds. Defaults to 600.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_RunPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cleanPodPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanPodPolicy defines the policy to kill pods after the job completes. Default to None.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the TTL to clean up jobs. It may take extra ReconcilePeriod seconds for the cleanup, since reconcile gets called periodically. Default to infinite.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the duration in seconds relative to the startTime that the job may be active before the system tries to terminate it; value must be positive integer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional number of retries before marking this job failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"schedulingPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.SchedulingPolicy"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "suspend specifies whether the Job controller should create Pods or not. If a Job is created with suspend set to true, no Pods are created by the Job controller. If a Job is suspended after creation (i.e. the flag goes from false to true), the Job controller will delete all active Pods and PodGroups associated with this Job. Users must design their workload to gracefully handle this. Suspending a Job will reset the StartTime field of the Job.\n\nDefaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"gracefulTermination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracefulTermination gives the training code the chance to write a checkpoint before the pods are deleted, when the job is suspended or fails on ActiveDeadlineSeconds or BackoffLimit.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.GracefulTermination"),
+						},
+					},
+					"successPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessPolicy defines when the job is marked as succeeded. If unset, the default rules of the framework apply. Replicas which are still running once the job succeeded are cleaned up according to CleanPodPolicy.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobSuccessPolicy"),
+						},
+					},
+					"startupPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupPolicy orders the startup of the replicas of the job. The pods of a replica type are only created once the replicas it depends on are Running or Ready. With gang scheduling, the replicas waiting for other replicas aren't part of the minimum members of the PodGroup.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.StartupPolicy"),
+						},
+					},
+					"topologyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyPolicy co-locates or spreads the replicas of the job across the topology domains of the nodes, e.g. zones, racks or NVLink domains.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TopologyPolicy"),
+						},
+					},
+					"runtimeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeRef references the TrainingRuntime or ClusterTrainingRuntime the replica templates and the run policy of the job are merged with. The runtime is resolved once, before the pods of the job are created, later updates of the runtime don't affect the job.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RuntimeRef"),
+						},
+					},
+					"dependsOn": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists the jobs of the namespace which must reach a condition before the pods of the job are created. The job has a Waiting condition until then, and it fails once a dependency is finished without reaching its condition. Dependency cycles are rejected.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobDependency"),
+									},
+								},
+							},
+						},
+					},
+					"restartScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartScope is the scope of the restarts of the replicas, either Pod or Job. With Job, every replica is deleted and recreated whenever a replica is restarted, so that the surviving ranks don't hang in a collective. Each restart of the job counts towards BackoffLimit. Defaults to Pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.GracefulTermination", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobDependency", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobSuccessPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RuntimeRef", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.SchedulingPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.StartupPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TopologyPolicy"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_RuntimeRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeRef references the training runtime a job is created from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the runtime.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the runtime, a TrainingRuntime in the namespace of the job or a ClusterTrainingRuntime. Defaults to ClusterTrainingRuntime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_SchedulingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulingPolicy encapsulates various scheduling policies of the distributed training job, for example `minAvailable` for gang-scheduling.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"minResources": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"priorityClass": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"scheduleTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_StartupPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StartupPolicy declares the startup dependencies between the replica types of a job.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dependencies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"replicaType",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies of the replica types. The replica types without dependencies start right away.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStartupDependency"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"dependencies"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaStartupDependency"},
+	}
+}
+
+func schema_pkg_apis_kubefloworg_v1_TFJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TFJob represents a TFJob resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired state of the TFJob.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobSpec"),
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the PyTorchJob. Read-only (modified by the system).",
+							Description: "Most recently observed status of the TFJob. Populated by the system. Read-only.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
 						},
@@ -818,15 +2285,15 @@ func schema_pkg_apis_kubefloworg_v1_PyTorchJob(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_PyTorchJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TFJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PyTorchJobList is a list of PyTorchJobs.",
+				Description: "TFJobList is a list of TFJobs.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -852,13 +2319,13 @@ func schema_pkg_apis_kubefloworg_v1_PyTorchJobList(ref common.ReferenceCallback)
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of PyTorchJobs.",
+							Description: "List of TFJobs.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJob"),
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJob"),
 									},
 								},
 							},
@@ -869,15 +2336,15 @@ func schema_pkg_apis_kubefloworg_v1_PyTorchJobList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.PyTorchJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_PyTorchJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TFJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PyTorchJobSpec is a desired state description of the PyTorchJob.",
+				Description: "TFJobSpec is a desired state description of the TFJob.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"runPolicy": {
@@ -887,14 +2354,16 @@ func schema_pkg_apis_kubefloworg_v1_PyTorchJobSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
 						},
 					},
-					"elasticPolicy": {
+					"successPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ElasticPolicy"),
+							Description: "SuccessPolicy defines the policy to mark the TFJob as succeeded. Default to \"\", using the default rules.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"pytorchReplicaSpecs": {
+					"tfReplicaSpecs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A map of PyTorchReplicaType (type) to ReplicaSpec (value). Specifies the PyTorch cluster configuration. For example,\n  {\n    \"Master\": PyTorchReplicaSpec,\n    \"Worker\": PyTorchReplicaSpec,\n  }",
+							Description: "A map of TFReplicaType (type) to ReplicaSpec (value). Specifies the TF cluster configuration. For example,\n  {\n    \"PS\": ReplicaSpec,\n    \"Worker\": ReplicaSpec,\n  }",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -906,244 +2375,295 @@ func schema_pkg_apis_kubefloworg_v1_PyTorchJobSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"nprocPerNode": {
+					"enableDynamicWorker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Number of workers per node; supported values: [auto, cpu, gpu, int]. For more, https://github.com/pytorch/pytorch/blob/26f7f470df64d90e092081e39507e4ac751f55d6/torch/distributed/run.py#L629-L658. Defaults to auto.",
-							Type:        []string{"string"},
+							Description: "A switch to enable dynamic worker",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"runPolicy", "pytorchReplicaSpecs"},
+				Required: []string{"runPolicy", "tfReplicaSpecs"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ElasticPolicy", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.ReplicaSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_RDZVConf(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TopologyPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TopologyPolicy places the replicas of a job according to a node label. The pods get a pod affinity or a topology spread constraint on the pods of the job with the same label value.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"topologyKey": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "TopologyKey is the node label whose values are the topology domains, e.g. topology.kubernetes.io/zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"value": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Mode is one of Required, Preferred and Spread.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicaTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaTypes are the replica types placed by the policy. Defaults to all replica types of the job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"topologyKey", "mode"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_ReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingCronJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReplicaSpec is a description of the replica",
+				Description: "TrainingCronJob represents a job of any of the kinds of kubeflow.org/v1 created on a cron schedule.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"template": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template is the object that describes the pod that will be created for this replica. RestartPolicy in PodTemplateSpec will be overide by RestartPolicy in ReplicaSpec",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the schedule and the template of the jobs.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.PodTemplateSpec"),
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobSpec"),
 						},
 					},
-					"restartPolicy": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Restart policy for all replicas within the job. One of Always, OnFailure, Never and ExitCode. Default to Never.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Most recently observed status of the TrainingCronJob. Read-only (modified by the system).",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.PodTemplateSpec"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobSpec", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJobStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_ReplicaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingCronJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReplicaStatus represents the current observed state of the replica.",
+				Description: "TrainingCronJobList is a list of TrainingCronJobs.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"active": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The number of actively running pods.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"succeeded": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of pods which reached phase Succeeded.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"failed": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of pods which reached phase Failed.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labelSelector": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: Use Selector instead",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Standard list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"selector": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty Selector matches all objects. A null Selector matches no objects.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "List of TrainingCronJobs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJob"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingCronJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_RunPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingCronJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+				Description: "TrainingCronJobSpec is a desired state description of the TrainingCronJob.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cleanPodPolicy": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanPodPolicy defines the policy to kill pods after the job completes. Default to None.",
+							Description: "Schedule is the schedule of the jobs in the cron format, e.g. \"0 2 * * *\", or one of the macros @yearly, @monthly, @weekly, @daily and @hourly.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"timeZone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished is the TTL to clean up jobs. It may take extra ReconcilePeriod seconds for the cleanup, since reconcile gets called periodically. Default to infinite.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "TimeZone is the name of the time zone of the schedule, e.g. \"Europe/Paris\". Defaults to the time zone of the training operator.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"activeDeadlineSeconds": {
+					"startingDeadlineSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the duration in seconds relative to the startTime that the job may be active before the system tries to terminate it; value must be positive integer.",
+							Description: "StartingDeadlineSeconds is the deadline in seconds to start a job once its scheduled time is missed, e.g. when the operator was down. The missed runs older than the deadline are skipped.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"backoffLimit": {
+					"concurrencyPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional number of retries before marking this job failed.",
+							Description: "ConcurrencyPolicy specifies how to treat the concurrent runs of the job. Defaults to Allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops the creation of the jobs, the active jobs are not affected. Set suspend in the runPolicy of the template to create the jobs suspended instead, e.g. to admit them through a queue. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"successfulJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessfulJobsHistoryLimit is the number of the succeeded jobs to keep. Defaults to 3.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"schedulingPolicy": {
+					"failedJobsHistoryLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling",
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.SchedulingPolicy"),
+							Description: "FailedJobsHistoryLimit is the number of the failed jobs to keep. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"suspend": {
+					"jobTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "suspend specifies whether the Job controller should create Pods or not. If a Job is created with suspend set to true, no Pods are created by the Job controller. If a Job is suspended after creation (i.e. the flag goes from false to true), the Job controller will delete all active Pods and PodGroups associated with this Job. Users must design their workload to gracefully handle this. Suspending a Job will reset the StartTime field of the Job.\n\nDefaults to false.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "JobTemplate is the job created at the scheduled times, a TFJob, PyTorchJob, MXJob, XGBoostJob, MPIJob, PaddleJob or JAXJob with its apiVersion, kind, metadata and spec. The jobs are named after the TrainingCronJob and their scheduled time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
 				},
+				Required: []string{"schedule", "jobTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.SchedulingPolicy"},
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_SchedulingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingCronJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SchedulingPolicy encapsulates various scheduling policies of the distributed training job, for example `minAvailable` for gang-scheduling.",
+				Description: "TrainingCronJobStatus represents the current state of a TrainingCronJob.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minAvailable": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"queue": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+					"active": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"minResources": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Active holds the references of the jobs which are not finished.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"priorityClass": {
+					"lastScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastScheduleTime is the last time a job was scheduled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"scheduleTimeoutSeconds": {
+					"lastSuccessfulTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "LastSuccessfulTime is the last time a job succeeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_TFJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TFJob represents a TFJob resource.",
+				Description: "TrainingRuntime represents a namespaced template of the replicas and the run policy of jobs.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1168,31 +2688,24 @@ func schema_pkg_apis_kubefloworg_v1_TFJob(ref common.ReferenceCallback) common.O
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the desired state of the TFJob.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the TFJob. Populated by the system. Read-only.",
+							Description: "Specification of the template of the jobs.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus"),
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntimeSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.JobStatus", "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntimeSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_TFJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TFJobList is a list of TFJobs.",
+				Description: "TrainingRuntimeList is a list of TrainingRuntimes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1218,13 +2731,13 @@ func schema_pkg_apis_kubefloworg_v1_TFJobList(ref common.ReferenceCallback) comm
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of TFJobs.",
+							Description: "List of TrainingRuntimes.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJob"),
+										Ref:     ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntime"),
 									},
 								},
 							},
@@ -1235,34 +2748,20 @@ func schema_pkg_apis_kubefloworg_v1_TFJobList(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TFJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.TrainingRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubefloworg_v1_TFJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubefloworg_v1_TrainingRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TFJobSpec is a desired state description of the TFJob.",
+				Description: "TrainingRuntimeSpec is the template of the jobs referencing the runtime. The job's own values take precedence over the ones of the runtime.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"runPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
-						},
-					},
-					"successPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SuccessPolicy defines the policy to mark the TFJob as succeeded. Default to \"\", using the default rules.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"tfReplicaSpecs": {
+					"replicaSpecs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A map of TFReplicaType (type) to ReplicaSpec (value). Specifies the TF cluster configuration. For example,\n  {\n    \"PS\": ReplicaSpec,\n    \"Worker\": ReplicaSpec,\n  }",
+							Description: "ReplicaSpecs are the templates of the replicas, keyed by replica type, e.g. Master or Worker. The pod template of a replica type of the job is merged into the template of the same replica type, containers, volumes and env variables are merged by name. Replica types of the runtime the job doesn't have are ignored.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -1274,15 +2773,13 @@ func schema_pkg_apis_kubefloworg_v1_TFJobSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"enableDynamicWorker": {
+					"runPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A switch to enable dynamic worker",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "RunPolicy holds the defaults of the run policy of the jobs, applied to the fields the job leaves unset. The runtimeRef of the RunPolicy is ignored, the jobs referencing a runtime with a dependsOn fail to resolve it.",
+							Ref:         ref("github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1.RunPolicy"),
 						},
 					},
 				},
-				Required: []string{"runPolicy", "tfReplicaSpecs"},
 			},
 		},
 		Dependencies: []string{
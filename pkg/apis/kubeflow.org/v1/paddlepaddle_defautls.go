@@ -41,8 +41,8 @@ func setPaddleElasticPolicy(paddleJob *PaddleJob) {
 			paddleJob.Spec.ElasticPolicy.MinReplicas = paddleJob.Spec.ElasticPolicy.MaxReplicas
 		} else if paddleJob.Spec.ElasticPolicy.MinReplicas != nil {
 			paddleJob.Spec.ElasticPolicy.MaxReplicas = paddleJob.Spec.ElasticPolicy.MinReplicas
-		} else {
-			workerReplicas := paddleJob.Spec.PaddleReplicaSpecs[PaddleJobReplicaTypeWorker].Replicas
+		} else if workerSpec, ok := paddleJob.Spec.PaddleReplicaSpecs[PaddleJobReplicaTypeWorker]; ok && workerSpec != nil {
+			workerReplicas := workerSpec.Replicas
 			// Set Min and Max to worker.spec.Replicas.
 			paddleJob.Spec.ElasticPolicy.MaxReplicas = workerReplicas
 			paddleJob.Spec.ElasticPolicy.MinReplicas = workerReplicas
@@ -72,6 +72,9 @@ func SetDefaults_PaddleJob(job *PaddleJob) {
 	setPaddleTypeNamesToCamelCase(job)
 
 	for _, spec := range job.Spec.PaddleReplicaSpecs {
+		if spec == nil {
+			continue
+		}
 		setDefaultReplicas(spec, 1)
 		setDefaultRestartPolicy(spec, PaddleJobDefaultRestartPolicy)
 		setPaddleDefaultPort(&spec.Template.Spec)
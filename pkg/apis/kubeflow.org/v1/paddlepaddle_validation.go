@@ -27,6 +27,9 @@ func ValidateV1PaddleJob(paddleJob *PaddleJob) error {
 	if err := validatePaddleReplicaSpecs(paddleJob.Spec.PaddleReplicaSpecs); err != nil {
 		return err
 	}
+	if err := validateRunPolicy(&paddleJob.Spec.RunPolicy, paddleJob.Spec.PaddleReplicaSpecs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -38,6 +41,12 @@ func validatePaddleReplicaSpecs(specs map[ReplicaType]*ReplicaSpec) error {
 		if value == nil || len(value.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("PaddleJobSpec is not valid: containers definition expected in %v", rType)
 		}
+		if err := validatePodFailurePolicy(rType, value.PodFailurePolicy); err != nil {
+			return err
+		}
+		if err := validateRestartBackoff(rType, value.RestartBackoff); err != nil {
+			return err
+		}
 		// Make sure the replica type is valid.
 		validReplicaTypes := []ReplicaType{PaddleJobReplicaTypeMaster, PaddleJobReplicaTypeWorker}
 
@@ -45,8 +45,8 @@ func setElasticPolicy(pytorchJob *PyTorchJob) {
 			pytorchJob.Spec.ElasticPolicy.MinReplicas = pytorchJob.Spec.ElasticPolicy.MaxReplicas
 		} else if pytorchJob.Spec.ElasticPolicy.MinReplicas != nil {
 			pytorchJob.Spec.ElasticPolicy.MaxReplicas = pytorchJob.Spec.ElasticPolicy.MinReplicas
-		} else {
-			workerReplicas := pytorchJob.Spec.PyTorchReplicaSpecs[PyTorchJobReplicaTypeWorker].Replicas
+		} else if workerSpec, ok := pytorchJob.Spec.PyTorchReplicaSpecs[PyTorchJobReplicaTypeWorker]; ok && workerSpec != nil {
+			workerReplicas := workerSpec.Replicas
 			// Set Min and Max to worker.spec.Replicas.
 			pytorchJob.Spec.ElasticPolicy.MaxReplicas = workerReplicas
 			pytorchJob.Spec.ElasticPolicy.MinReplicas = workerReplicas
@@ -84,6 +84,9 @@ func SetDefaults_PyTorchJob(job *PyTorchJob) {
 	setPyTorchTypeNamesToCamelCase(job)
 
 	for _, spec := range job.Spec.PyTorchReplicaSpecs {
+		if spec == nil {
+			continue
+		}
 		setDefaultReplicas(spec, 1)
 		setDefaultRestartPolicy(spec, PyTorchJobDefaultRestartPolicy)
 		setPyTorchDefaultPort(&spec.Template.Spec)
@@ -16,6 +16,7 @@ package v1
 
 import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -132,6 +133,27 @@ type ElasticPolicy struct {
 	// If not set, the HPA will not be created.
 	// +optional
 	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// RDZVStore runs the rendezvous backend in a pod owned by the PyTorchJob, a C10d TCP store
+	// or a single-member etcd depending on the RDZVBackend, and points the rendezvous endpoint
+	// to its Service. Unlike the default endpoint on the first worker, the store outlives the
+	// rescheduling and the scale-down of the workers. The store is deleted when the job is
+	// suspended, and with the pods of the job once it finishes.
+	// It can't be set together with RDZVHost or Standalone.
+	// +optional
+	RDZVStore *RDZVStore `json:"rdzvStore,omitempty"`
+}
+
+// RDZVStore is a rendezvous backend managed by the PyTorchJob.
+type RDZVStore struct {
+	// Image of the rendezvous store. For the C10d backend, it defaults to the image of the
+	// pytorch container of the workers, and it must have PyTorch installed. For the etcd
+	// backends, it defaults to an etcd image serving the v2 API.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Resources of the rendezvous store.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type RDZVConf struct {
@@ -27,9 +27,32 @@ func ValidateV1PyTorchJob(pytorchJob *PyTorchJob) error {
 	if err := validatePyTorchReplicaSpecs(pytorchJob.Spec.PyTorchReplicaSpecs); err != nil {
 		return err
 	}
+	if err := validateRunPolicy(&pytorchJob.Spec.RunPolicy, pytorchJob.Spec.PyTorchReplicaSpecs); err != nil {
+		return err
+	}
 	if err := validateNprocPerNode(pytorchJob); err != nil {
 		return err
 	}
+	if err := validateRDZVStore(pytorchJob.Spec.ElasticPolicy); err != nil {
+		return err
+	}
+	if pytorchJob.Spec.ElasticPolicy != nil && pytorchJob.Spec.RunPolicy.RestartScope != nil &&
+		*pytorchJob.Spec.RunPolicy.RestartScope == RestartScopeJob {
+		return fmt.Errorf(".spec.runPolicy.restartScope Job can't be set with .spec.elasticPolicy, the elastic agents restart the workers")
+	}
+	return nil
+}
+
+func validateRDZVStore(elasticPolicy *ElasticPolicy) error {
+	if elasticPolicy == nil || elasticPolicy.RDZVStore == nil {
+		return nil
+	}
+	if elasticPolicy.RDZVHost != nil {
+		return fmt.Errorf(".spec.elasticPolicy.rdzvStore can't be set with .spec.elasticPolicy.rdzvHost")
+	}
+	if elasticPolicy.Standalone != nil && *elasticPolicy.Standalone {
+		return fmt.Errorf(".spec.elasticPolicy.rdzvStore can't be set with .spec.elasticPolicy.standalone")
+	}
 	return nil
 }
 
@@ -48,6 +71,12 @@ func validatePyTorchReplicaSpecs(specs map[ReplicaType]*ReplicaSpec) error {
 		if value == nil || len(value.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("PyTorchJobSpec is not valid: containers definition expected in %v", rType)
 		}
+		if err := validatePodFailurePolicy(rType, value.PodFailurePolicy); err != nil {
+			return err
+		}
+		if err := validateRestartBackoff(rType, value.RestartBackoff); err != nil {
+			return err
+		}
 		// Make sure the replica type is valid.
 		validReplicaTypes := []ReplicaType{PyTorchJobReplicaTypeMaster, PyTorchJobReplicaTypeWorker}
 
@@ -209,6 +209,124 @@ func TestValidateV1PyTorchJob(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"Spec.ElasticPolicy.RDZVStore is set": {
+			pytorchJob: &PyTorchJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: PyTorchJobSpec{
+					ElasticPolicy: &ElasticPolicy{
+						RDZVBackend: ptr.To(BackendC10D),
+						RDZVStore:   &RDZVStore{},
+					},
+					PyTorchReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  "pytorch",
+											Image: "gcr.io/kubeflow-ci/pytorch-dist-mnist_test:1.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Spec.ElasticPolicy.RDZVStore and Spec.ElasticPolicy.RDZVHost are set": {
+			pytorchJob: &PyTorchJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: PyTorchJobSpec{
+					ElasticPolicy: &ElasticPolicy{
+						RDZVHost:  ptr.To("rdzv"),
+						RDZVStore: &RDZVStore{},
+					},
+					PyTorchReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  "pytorch",
+											Image: "gcr.io/kubeflow-ci/pytorch-dist-mnist_test:1.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"Spec.ElasticPolicy.RDZVStore and Spec.ElasticPolicy.Standalone are set": {
+			pytorchJob: &PyTorchJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: PyTorchJobSpec{
+					ElasticPolicy: &ElasticPolicy{
+						Standalone: ptr.To(true),
+						RDZVStore:  &RDZVStore{},
+					},
+					PyTorchReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  "pytorch",
+											Image: "gcr.io/kubeflow-ci/pytorch-dist-mnist_test:1.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"Spec.RunPolicy.RestartScope is Job with Spec.ElasticPolicy": {
+			pytorchJob: &PyTorchJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: PyTorchJobSpec{
+					RunPolicy: RunPolicy{
+						RestartScope: ptr.To(RestartScopeJob),
+					},
+					ElasticPolicy: &ElasticPolicy{
+						RDZVBackend: ptr.To(BackendC10D),
+					},
+					PyTorchReplicaSpecs: map[ReplicaType]*ReplicaSpec{
+						PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  "pytorch",
+											Image: "gcr.io/kubeflow-ci/pytorch-dist-mnist_test:1.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -62,6 +62,9 @@ func SetDefaults_TFJob(tfJob *TFJob) {
 	setTensorflowTypeNamesToCamelCase(tfJob)
 
 	for _, spec := range tfJob.Spec.TFReplicaSpecs {
+		if spec == nil {
+			continue
+		}
 		// Set default replicas to 1.
 		setDefaultReplicas(spec, 1)
 		// Set default restartPolicy
@@ -28,6 +28,12 @@ func ValidateV1TFJob(tfjob *TFJob) error {
 	if err := validateV1TFReplicaSpecs(tfjob.Spec.TFReplicaSpecs); err != nil {
 		return err
 	}
+	if err := validateRunPolicy(&tfjob.Spec.RunPolicy, tfjob.Spec.TFReplicaSpecs); err != nil {
+		return err
+	}
+	if tfjob.Spec.RunPolicy.SuccessPolicy != nil && tfjob.Spec.SuccessPolicy != nil && *tfjob.Spec.SuccessPolicy != SuccessPolicyDefault {
+		return fmt.Errorf("TFJobSpec is not valid: successPolicy and runPolicy.successPolicy are mutually exclusive")
+	}
 	return nil
 }
 
@@ -55,6 +61,12 @@ func validateV1TFReplicaSpecs(specs map[ReplicaType]*ReplicaSpec) error {
 		if value == nil || len(value.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("TFJobSpec is not valid: containers definition expected in %v", rType)
 		}
+		if err := validatePodFailurePolicy(rType, value.PodFailurePolicy); err != nil {
+			return err
+		}
+		if err := validateRestartBackoff(rType, value.RestartBackoff); err != nil {
+			return err
+		}
 		if IsChieforMaster(rType) {
 			foundChief++
 		}
@@ -0,0 +1,165 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// TrainingCronJobKind is the kind name.
+	TrainingCronJobKind = "TrainingCronJob"
+	// TrainingCronJobPlural is the plural for trainingCronJob.
+	TrainingCronJobPlural = "trainingcronjobs"
+	// TrainingCronJobSingular is the singular for trainingCronJob.
+	TrainingCronJobSingular = "trainingcronjob"
+
+	// CronJobScheduledTimestampAnnotation is set on the jobs created by a TrainingCronJob to the
+	// scheduled time of the job in RFC3339.
+	CronJobScheduledTimestampAnnotation = "training.kubeflow.org/cron-job-scheduled-timestamp"
+
+	// DefaultSuccessfulJobsHistoryLimit is the default number of the successful jobs kept by a TrainingCronJob.
+	DefaultSuccessfulJobsHistoryLimit int32 = 3
+	// DefaultFailedJobsHistoryLimit is the default number of the failed jobs kept by a TrainingCronJob.
+	DefaultFailedJobsHistoryLimit int32 = 1
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=trainingcronjob
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+//+kubebuilder:printcolumn:name="Suspend",type=boolean,JSONPath=`.spec.suspend`
+//+kubebuilder:printcolumn:name="Last Schedule",type=date,JSONPath=`.status.lastScheduleTime`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TrainingCronJob represents a job of any of the kinds of kubeflow.org/v1 created on a cron schedule.
+type TrainingCronJob struct {
+	// Standard Kubernetes type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the schedule and the template of the jobs.
+	Spec TrainingCronJobSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the TrainingCronJob.
+	// Read-only (modified by the system).
+	Status TrainingCronJobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=trainingcronjobs
+//+kubebuilder:object:root=true
+
+// TrainingCronJobList is a list of TrainingCronJobs.
+type TrainingCronJobList struct {
+	// Standard type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of TrainingCronJobs.
+	Items []TrainingCronJob `json:"items"`
+}
+
+// ConcurrencyPolicy describes how the job will be handled when the previous job is still active.
+// Only one of the following concurrent policies may be specified.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent allows the jobs to run concurrently.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+	// ForbidConcurrent skips the next run while the previous job is still active.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrent deletes the active jobs and replaces them with the new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
+// TrainingCronJobSpec is a desired state description of the TrainingCronJob.
+type TrainingCronJobSpec struct {
+	// Schedule is the schedule of the jobs in the cron format, e.g. "0 2 * * *",
+	// or one of the macros @yearly, @monthly, @weekly, @daily and @hourly.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// TimeZone is the name of the time zone of the schedule, e.g. "Europe/Paris".
+	// Defaults to the time zone of the training operator.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// StartingDeadlineSeconds is the deadline in seconds to start a job once its scheduled time is missed,
+	// e.g. when the operator was down. The missed runs older than the deadline are skipped.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat the concurrent runs of the job.
+	// Defaults to Allow.
+	// +kubebuilder:default:=Allow
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend stops the creation of the jobs, the active jobs are not affected.
+	// Set suspend in the runPolicy of the template to create the jobs suspended instead,
+	// e.g. to admit them through a queue.
+	// Defaults to false.
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// SuccessfulJobsHistoryLimit is the number of the succeeded jobs to keep.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit is the number of the failed jobs to keep.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+
+	// JobTemplate is the job created at the scheduled times, a TFJob, PyTorchJob, MXJob, XGBoostJob,
+	// MPIJob, PaddleJob or JAXJob with its apiVersion, kind, metadata and spec.
+	// The jobs are named after the TrainingCronJob and their scheduled time.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:EmbeddedResource
+	JobTemplate runtime.RawExtension `json:"jobTemplate"`
+}
+
+// TrainingCronJobStatus represents the current state of a TrainingCronJob.
+type TrainingCronJobStatus struct {
+	// Active holds the references of the jobs which are not finished.
+	// +listType=atomic
+	// +optional
+	Active []corev1.ObjectReference `json:"active,omitempty"`
+
+	// LastScheduleTime is the last time a job was scheduled.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSuccessfulTime is the last time a job succeeded.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrainingCronJob{}, &TrainingCronJobList{})
+}
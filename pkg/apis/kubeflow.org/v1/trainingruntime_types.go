@@ -0,0 +1,131 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TrainingRuntimeKind is the kind name.
+	TrainingRuntimeKind = "TrainingRuntime"
+	// ClusterTrainingRuntimeKind is the kind name.
+	ClusterTrainingRuntimeKind = "ClusterTrainingRuntime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=trainingruntime
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TrainingRuntime represents a namespaced template of the replicas and the run policy of jobs.
+type TrainingRuntime struct {
+	// Standard Kubernetes type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the template of the jobs.
+	Spec TrainingRuntimeSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=trainingruntimes
+//+kubebuilder:object:root=true
+
+// TrainingRuntimeList is a list of TrainingRuntimes.
+type TrainingRuntimeList struct {
+	// Standard type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of TrainingRuntimes.
+	Items []TrainingRuntime `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=clustertrainingruntime
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterTrainingRuntime represents a template of the replicas and the run policy of jobs,
+// which can be referenced from the jobs of any namespace.
+type ClusterTrainingRuntime struct {
+	// Standard Kubernetes type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the template of the jobs.
+	Spec TrainingRuntimeSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=clustertrainingruntimes
+//+kubebuilder:object:root=true
+
+// ClusterTrainingRuntimeList is a list of ClusterTrainingRuntimes.
+type ClusterTrainingRuntimeList struct {
+	// Standard type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of ClusterTrainingRuntimes.
+	Items []ClusterTrainingRuntime `json:"items"`
+}
+
+// TrainingRuntimeSpec is the template of the jobs referencing the runtime. The job's own
+// values take precedence over the ones of the runtime.
+type TrainingRuntimeSpec struct {
+	// ReplicaSpecs are the templates of the replicas, keyed by replica type, e.g. Master or Worker.
+	// The pod template of a replica type of the job is merged into the template of the same
+	// replica type, containers, volumes and env variables are merged by name.
+	// Replica types of the runtime the job doesn't have are ignored.
+	// +optional
+	ReplicaSpecs map[ReplicaType]*ReplicaSpec `json:"replicaSpecs,omitempty"`
+
+	// RunPolicy holds the defaults of the run policy of the jobs, applied to the
+	// fields the job leaves unset. The runtimeRef of the RunPolicy is ignored, the jobs
+	// referencing a runtime with a dependsOn fail to resolve it.
+	// +optional
+	RunPolicy *RunPolicy `json:"runPolicy,omitempty"`
+}
+
+// RuntimeRef references the training runtime a job is created from.
+type RuntimeRef struct {
+	// Name is the name of the runtime.
+	Name string `json:"name"`
+
+	// Kind is the kind of the runtime, a TrainingRuntime in the namespace of the job
+	// or a ClusterTrainingRuntime.
+	// Defaults to ClusterTrainingRuntime.
+	// +kubebuilder:validation:Enum=TrainingRuntime;ClusterTrainingRuntime
+	// +kubebuilder:default:=ClusterTrainingRuntime
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrainingRuntime{}, &TrainingRuntimeList{})
+	SchemeBuilder.Register(&ClusterTrainingRuntime{}, &ClusterTrainingRuntimeList{})
+}
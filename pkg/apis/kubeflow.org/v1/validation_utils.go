@@ -0,0 +1,317 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ValidateV1ReplicaSpecsUpdate makes sure that the set of replica types is not changed
+// once the job has been created.
+func ValidateV1ReplicaSpecsUpdate(oldSpecs, newSpecs map[ReplicaType]*ReplicaSpec) error {
+	oldTypes := sortedReplicaTypes(oldSpecs)
+	newTypes := sortedReplicaTypes(newSpecs)
+	if len(oldTypes) != len(newTypes) {
+		return fmt.Errorf("replica types are immutable: got %v, expected %v", newTypes, oldTypes)
+	}
+	for i := range oldTypes {
+		if oldTypes[i] != newTypes[i] {
+			return fmt.Errorf("replica types are immutable: got %v, expected %v", newTypes, oldTypes)
+		}
+	}
+	return nil
+}
+
+func sortedReplicaTypes(specs map[ReplicaType]*ReplicaSpec) []ReplicaType {
+	types := make([]ReplicaType, 0, len(specs))
+	for rType := range specs {
+		types = append(types, rType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
+}
+
+// validatePodFailurePolicy makes sure that every rule of the PodFailurePolicy of a replica
+// matches either on exit codes or on pod conditions and has a known action.
+func validatePodFailurePolicy(rType ReplicaType, policy *PodFailurePolicy) error {
+	if policy == nil {
+		return nil
+	}
+	for i, rule := range policy.Rules {
+		switch rule.Action {
+		case PodFailurePolicyActionFailJob, PodFailurePolicyActionRestartPod, PodFailurePolicyActionIgnore, PodFailurePolicyActionCount:
+		default:
+			return fmt.Errorf("podFailurePolicy of %v is not valid: unknown action %q in rule %d", rType, rule.Action, i)
+		}
+		if (rule.OnExitCodes == nil) == (len(rule.OnPodConditions) == 0) {
+			return fmt.Errorf("podFailurePolicy of %v is not valid: rule %d must specify exactly one of onExitCodes and onPodConditions", rType, i)
+		}
+		if rule.OnExitCodes != nil {
+			if err := validateOnExitCodes(rule.OnExitCodes); err != nil {
+				return fmt.Errorf("podFailurePolicy of %v is not valid: rule %d: %v", rType, i, err)
+			}
+		}
+		for _, pattern := range rule.OnPodConditions {
+			if pattern.Type == "" {
+				return fmt.Errorf("podFailurePolicy of %v is not valid: rule %d: pod condition type is undefined", rType, i)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRestartBackoff makes sure that the RestartBackoff of a replica doesn't delay the first
+// restart more than MaxSeconds.
+func validateRestartBackoff(rType ReplicaType, backoff *RestartBackoff) error {
+	if backoff == nil {
+		return nil
+	}
+	base, maxSeconds := int32(DefaultRestartBackoffBaseSeconds), int32(DefaultRestartBackoffMaxSeconds)
+	if backoff.BaseSeconds != nil {
+		base = *backoff.BaseSeconds
+	}
+	if backoff.MaxSeconds != nil {
+		maxSeconds = *backoff.MaxSeconds
+	}
+	switch {
+	case base < 1 || maxSeconds < 1:
+		return fmt.Errorf("restartBackoff of %v is not valid: baseSeconds and maxSeconds must be positive", rType)
+	case base > maxSeconds:
+		return fmt.Errorf("restartBackoff of %v is not valid: baseSeconds %d is greater than maxSeconds %d", rType, base, maxSeconds)
+	case backoff.ResetAfterSeconds != nil && *backoff.ResetAfterSeconds < 0:
+		return fmt.Errorf("restartBackoff of %v is not valid: resetAfterSeconds must not be negative", rType)
+	}
+	return nil
+}
+
+func validateOnExitCodes(requirement *PodFailurePolicyOnExitCodesRequirement) error {
+	if requirement.Operator != PodFailurePolicyOnExitCodesOpIn && requirement.Operator != PodFailurePolicyOnExitCodesOpNotIn {
+		return fmt.Errorf("unknown operator %q", requirement.Operator)
+	}
+	if len(requirement.Values) == 0 {
+		return fmt.Errorf("exit code values are undefined")
+	}
+	for _, value := range requirement.Values {
+		if value == 0 && requirement.Operator == PodFailurePolicyOnExitCodesOpIn {
+			return fmt.Errorf("exit code 0 is not a failure")
+		}
+	}
+	return nil
+}
+
+// validateRunPolicy validates the fields of the RunPolicy shared by all the job kinds.
+func validateRunPolicy(runPolicy *RunPolicy, specs map[ReplicaType]*ReplicaSpec) error {
+	if err := validateSuccessPolicy(runPolicy, specs); err != nil {
+		return err
+	}
+	if err := validateStartupPolicy(runPolicy, specs); err != nil {
+		return err
+	}
+	if err := validateTopologyPolicy(runPolicy, specs); err != nil {
+		return err
+	}
+	if err := validateGracefulTermination(runPolicy); err != nil {
+		return err
+	}
+	if err := validateRuntimeRef(runPolicy); err != nil {
+		return err
+	}
+	return validateDependsOn(runPolicy)
+}
+
+// validateSuccessPolicy makes sure that the RunPolicy.SuccessPolicy refers to the replica types of the job.
+func validateSuccessPolicy(runPolicy *RunPolicy, specs map[ReplicaType]*ReplicaSpec) error {
+	policy := runPolicy.SuccessPolicy
+	if policy == nil {
+		return nil
+	}
+	switch policy.Mode {
+	case JobSuccessPolicyModeLeader:
+	case JobSuccessPolicyModeAllReplicas:
+		for _, rType := range policy.ReplicaTypes {
+			if _, ok := specs[rType]; !ok {
+				return fmt.Errorf("successPolicy is not valid: unknown replica type %v", rType)
+			}
+		}
+	case JobSuccessPolicyModeMinSucceeded:
+		if policy.ReplicaType == nil || policy.MinSucceeded == nil {
+			return fmt.Errorf("successPolicy is not valid: replicaType and minSucceeded are required in the MinSucceeded mode")
+		}
+		if _, ok := specs[*policy.ReplicaType]; !ok {
+			return fmt.Errorf("successPolicy is not valid: unknown replica type %v", *policy.ReplicaType)
+		}
+		if *policy.MinSucceeded < 1 {
+			return fmt.Errorf("successPolicy is not valid: minSucceeded must be at least 1")
+		}
+	default:
+		return fmt.Errorf("successPolicy is not valid: unknown mode %q", policy.Mode)
+	}
+	return nil
+}
+
+// validateStartupPolicy makes sure that the RunPolicy.StartupPolicy refers to the replica types of
+// the job and that the startup dependencies don't form a cycle.
+func validateStartupPolicy(runPolicy *RunPolicy, specs map[ReplicaType]*ReplicaSpec) error {
+	policy := runPolicy.StartupPolicy
+	if policy == nil {
+		return nil
+	}
+	dependencies := make(map[ReplicaType][]ReplicaType, len(policy.Dependencies))
+	for _, dependency := range policy.Dependencies {
+		if _, ok := specs[dependency.ReplicaType]; !ok {
+			return fmt.Errorf("startupPolicy is not valid: unknown replica type %v", dependency.ReplicaType)
+		}
+		if _, ok := dependencies[dependency.ReplicaType]; ok {
+			return fmt.Errorf("startupPolicy is not valid: duplicate dependencies of %v", dependency.ReplicaType)
+		}
+		switch dependency.Condition {
+		case "", ReplicaStartupConditionRunning, ReplicaStartupConditionReady:
+		default:
+			return fmt.Errorf("startupPolicy is not valid: unknown condition %q of %v", dependency.Condition, dependency.ReplicaType)
+		}
+		for _, rType := range dependency.DependsOn {
+			if _, ok := specs[rType]; !ok {
+				return fmt.Errorf("startupPolicy is not valid: %v depends on unknown replica type %v", dependency.ReplicaType, rType)
+			}
+		}
+		dependencies[dependency.ReplicaType] = dependency.DependsOn
+	}
+
+	// The replica types are visited in depth-first order, a replica type reached again while
+	// it is being visited closes a cycle.
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[ReplicaType]int, len(dependencies))
+	var visit func(rType ReplicaType) error
+	visit = func(rType ReplicaType) error {
+		switch state[rType] {
+		case visiting:
+			return fmt.Errorf("startupPolicy is not valid: %v depends on itself", rType)
+		case visited:
+			return nil
+		}
+		state[rType] = visiting
+		for _, dependsOn := range dependencies[rType] {
+			if err := visit(dependsOn); err != nil {
+				return err
+			}
+		}
+		state[rType] = visited
+		return nil
+	}
+	for _, rType := range sortedReplicaTypes(specs) {
+		if err := visit(rType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTopologyPolicy makes sure that the RunPolicy.TopologyPolicy has a topology key,
+// a known mode and refers to the replica types of the job.
+func validateTopologyPolicy(runPolicy *RunPolicy, specs map[ReplicaType]*ReplicaSpec) error {
+	policy := runPolicy.TopologyPolicy
+	if policy == nil {
+		return nil
+	}
+	if len(policy.TopologyKey) == 0 {
+		return fmt.Errorf("topologyPolicy is not valid: topologyKey is required")
+	}
+	if errs := validation.IsQualifiedName(policy.TopologyKey); len(errs) != 0 {
+		return fmt.Errorf("topologyPolicy is not valid: topologyKey %q: %v", policy.TopologyKey, errs)
+	}
+	switch policy.Mode {
+	case TopologyPolicyModeRequired, TopologyPolicyModePreferred, TopologyPolicyModeSpread:
+	default:
+		return fmt.Errorf("topologyPolicy is not valid: unknown mode %q", policy.Mode)
+	}
+	for _, rType := range policy.ReplicaTypes {
+		if _, ok := specs[rType]; !ok {
+			return fmt.Errorf("topologyPolicy is not valid: unknown replica type %v", rType)
+		}
+	}
+	return nil
+}
+
+// validateGracefulTermination makes sure that the RunPolicy.GracefulTermination has a positive
+// timeout and a known signal.
+func validateGracefulTermination(runPolicy *RunPolicy) error {
+	policy := runPolicy.GracefulTermination
+	if policy == nil {
+		return nil
+	}
+	if policy.TimeoutSeconds != nil && *policy.TimeoutSeconds < 1 {
+		return fmt.Errorf("gracefulTermination is not valid: timeoutSeconds must be at least 1")
+	}
+	if policy.Signal == nil {
+		return nil
+	}
+	switch *policy.Signal {
+	case CheckpointSignalHUP, CheckpointSignalINT, CheckpointSignalTERM, CheckpointSignalUSR1, CheckpointSignalUSR2:
+	default:
+		return fmt.Errorf("gracefulTermination is not valid: unknown signal %q", *policy.Signal)
+	}
+	return nil
+}
+
+// validateRuntimeRef makes sure that the RunPolicy.RuntimeRef names a runtime of a known kind.
+func validateRuntimeRef(runPolicy *RunPolicy) error {
+	ref := runPolicy.RuntimeRef
+	if ref == nil {
+		return nil
+	}
+	if len(ref.Name) == 0 {
+		return fmt.Errorf("runtimeRef is not valid: name is required")
+	}
+	switch ref.Kind {
+	case "", TrainingRuntimeKind, ClusterTrainingRuntimeKind:
+	default:
+		return fmt.Errorf("runtimeRef is not valid: unknown kind %q", ref.Kind)
+	}
+	return nil
+}
+
+func validateDependsOn(runPolicy *RunPolicy) error {
+	seen := make(map[string]bool, len(runPolicy.DependsOn))
+	for _, dependency := range runPolicy.DependsOn {
+		if len(dependency.Name) == 0 {
+			return fmt.Errorf("dependsOn is not valid: name is required")
+		}
+		switch dependency.Kind {
+		case TFJobKind, PyTorchJobKind, MXJobKind, XGBoostJobKind, MPIJobKind, PaddleJobKind, JAXJobKind:
+		default:
+			return fmt.Errorf("dependsOn is not valid: unknown kind %q of %s", dependency.Kind, dependency.Name)
+		}
+		switch dependency.Condition {
+		case "", JobRunning, JobSucceeded, JobFailed:
+		default:
+			return fmt.Errorf("dependsOn is not valid: unsupported condition %q of %s/%s",
+				dependency.Condition, dependency.Kind, dependency.Name)
+		}
+		key := dependency.Kind + "/" + dependency.Name
+		if seen[key] {
+			return fmt.Errorf("dependsOn is not valid: %s is listed more than once", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
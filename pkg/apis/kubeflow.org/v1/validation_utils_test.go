@@ -0,0 +1,486 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateV1ReplicaSpecsUpdate(t *testing.T) {
+	oldSpecs := map[ReplicaType]*ReplicaSpec{
+		PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+		PyTorchJobReplicaTypeWorker: {Replicas: ptr.To[int32](1)},
+	}
+
+	testCases := map[string]struct {
+		newSpecs map[ReplicaType]*ReplicaSpec
+		wantErr  bool
+	}{
+		"replicas are changed": {
+			newSpecs: map[ReplicaType]*ReplicaSpec{
+				PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+				PyTorchJobReplicaTypeWorker: {Replicas: ptr.To[int32](3)},
+			},
+			wantErr: false,
+		},
+		"replica type is removed": {
+			newSpecs: map[ReplicaType]*ReplicaSpec{
+				PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+			},
+			wantErr: true,
+		},
+		"replica type is added": {
+			newSpecs: map[ReplicaType]*ReplicaSpec{
+				PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+				PyTorchJobReplicaTypeWorker: {Replicas: ptr.To[int32](1)},
+				TFJobReplicaTypePS:          {Replicas: ptr.To[int32](1)},
+			},
+			wantErr: true,
+		},
+		"replica type is replaced": {
+			newSpecs: map[ReplicaType]*ReplicaSpec{
+				PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+				TFJobReplicaTypePS:          {Replicas: ptr.To[int32](1)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateV1ReplicaSpecsUpdate(oldSpecs, tc.newSpecs)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("ValidateV1ReplicaSpecsUpdate() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePodFailurePolicy(t *testing.T) {
+	onExitCodes := func(operator PodFailurePolicyOnExitCodesOperator, values ...int32) *PodFailurePolicyOnExitCodesRequirement {
+		return &PodFailurePolicyOnExitCodesRequirement{Operator: operator, Values: values}
+	}
+	onDisruption := []PodFailurePolicyOnPodConditionsPattern{{Type: corev1.DisruptionTarget}}
+
+	testCases := map[string]struct {
+		rule    PodFailurePolicyRule
+		wantErr bool
+	}{
+		"valid exit codes rule": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionRestartPod, OnExitCodes: onExitCodes(PodFailurePolicyOnExitCodesOpIn, 137)},
+			wantErr: false,
+		},
+		"valid pod conditions rule": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionIgnore, OnPodConditions: onDisruption},
+			wantErr: false,
+		},
+		"unknown action": {
+			rule:    PodFailurePolicyRule{Action: "Retry", OnPodConditions: onDisruption},
+			wantErr: true,
+		},
+		"no requirement": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionFailJob},
+			wantErr: true,
+		},
+		"both requirements": {
+			rule: PodFailurePolicyRule{
+				Action:          PodFailurePolicyActionFailJob,
+				OnExitCodes:     onExitCodes(PodFailurePolicyOnExitCodesOpIn, 1),
+				OnPodConditions: onDisruption,
+			},
+			wantErr: true,
+		},
+		"unknown operator": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionFailJob, OnExitCodes: onExitCodes("Equals", 1)},
+			wantErr: true,
+		},
+		"no exit codes": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionFailJob, OnExitCodes: onExitCodes(PodFailurePolicyOnExitCodesOpIn)},
+			wantErr: true,
+		},
+		"exit code 0": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionFailJob, OnExitCodes: onExitCodes(PodFailurePolicyOnExitCodesOpIn, 0)},
+			wantErr: true,
+		},
+		"no pod condition type": {
+			rule:    PodFailurePolicyRule{Action: PodFailurePolicyActionIgnore, OnPodConditions: []PodFailurePolicyOnPodConditionsPattern{{}}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			policy := &PodFailurePolicy{Rules: []PodFailurePolicyRule{tc.rule}}
+			got := validatePodFailurePolicy(PyTorchJobReplicaTypeWorker, policy)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validatePodFailurePolicy() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRestartBackoff(t *testing.T) {
+	testCases := map[string]struct {
+		backoff *RestartBackoff
+		wantErr bool
+	}{
+		"no backoff": {
+			backoff: nil,
+			wantErr: false,
+		},
+		"defaults": {
+			backoff: &RestartBackoff{},
+			wantErr: false,
+		},
+		"valid backoff": {
+			backoff: &RestartBackoff{BaseSeconds: ptr.To[int32](5), MaxSeconds: ptr.To[int32](60), ResetAfterSeconds: ptr.To[int32](0)},
+			wantErr: false,
+		},
+		"base greater than max": {
+			backoff: &RestartBackoff{BaseSeconds: ptr.To[int32](60), MaxSeconds: ptr.To[int32](5)},
+			wantErr: true,
+		},
+		"base greater than the default max": {
+			backoff: &RestartBackoff{BaseSeconds: ptr.To[int32](600)},
+			wantErr: true,
+		},
+		"zero base": {
+			backoff: &RestartBackoff{BaseSeconds: ptr.To[int32](0)},
+			wantErr: true,
+		},
+		"negative reset": {
+			backoff: &RestartBackoff{ResetAfterSeconds: ptr.To[int32](-1)},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRestartBackoff(PyTorchJobReplicaTypeWorker, tc.backoff)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateRestartBackoff() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSuccessPolicy(t *testing.T) {
+	specs := map[ReplicaType]*ReplicaSpec{
+		PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+		PyTorchJobReplicaTypeWorker: {Replicas: ptr.To[int32](4)},
+	}
+
+	testCases := map[string]struct {
+		policy  *JobSuccessPolicy
+		wantErr bool
+	}{
+		"no success policy": {
+			policy:  nil,
+			wantErr: false,
+		},
+		"leader": {
+			policy:  &JobSuccessPolicy{Mode: JobSuccessPolicyModeLeader},
+			wantErr: false,
+		},
+		"all replicas of the workers": {
+			policy:  &JobSuccessPolicy{Mode: JobSuccessPolicyModeAllReplicas, ReplicaTypes: []ReplicaType{PyTorchJobReplicaTypeWorker}},
+			wantErr: false,
+		},
+		"all replicas of an unknown type": {
+			policy:  &JobSuccessPolicy{Mode: JobSuccessPolicyModeAllReplicas, ReplicaTypes: []ReplicaType{TFJobReplicaTypePS}},
+			wantErr: true,
+		},
+		"min succeeded workers": {
+			policy:  &JobSuccessPolicy{Mode: JobSuccessPolicyModeMinSucceeded, ReplicaType: ptr.To(PyTorchJobReplicaTypeWorker), MinSucceeded: ptr.To[int32](2)},
+			wantErr: false,
+		},
+		"min succeeded without replica type": {
+			policy:  &JobSuccessPolicy{Mode: JobSuccessPolicyModeMinSucceeded, MinSucceeded: ptr.To[int32](2)},
+			wantErr: true,
+		},
+		"min succeeded is 0": {
+			policy:  &JobSuccessPolicy{Mode: JobSuccessPolicyModeMinSucceeded, ReplicaType: ptr.To(PyTorchJobReplicaTypeWorker), MinSucceeded: ptr.To[int32](0)},
+			wantErr: true,
+		},
+		"unknown mode": {
+			policy:  &JobSuccessPolicy{Mode: "AnyReplica"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateSuccessPolicy(&RunPolicy{SuccessPolicy: tc.policy}, specs)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateSuccessPolicy() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateStartupPolicy(t *testing.T) {
+	specs := map[ReplicaType]*ReplicaSpec{
+		TFJobReplicaTypeChief:  {Replicas: ptr.To[int32](1)},
+		TFJobReplicaTypePS:     {Replicas: ptr.To[int32](2)},
+		TFJobReplicaTypeWorker: {Replicas: ptr.To[int32](4)},
+	}
+
+	testCases := map[string]struct {
+		policy  *StartupPolicy
+		wantErr bool
+	}{
+		"no startup policy": {
+			policy:  nil,
+			wantErr: false,
+		},
+		"workers start after the chief and the parameter servers are ready": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypeChief, TFJobReplicaTypePS}, Condition: ReplicaStartupConditionReady},
+				{ReplicaType: TFJobReplicaTypeChief, DependsOn: []ReplicaType{TFJobReplicaTypePS}},
+			}},
+			wantErr: false,
+		},
+		"unknown replica type": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeEval, DependsOn: []ReplicaType{TFJobReplicaTypeChief}},
+			}},
+			wantErr: true,
+		},
+		"depends on an unknown replica type": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypeEval}},
+			}},
+			wantErr: true,
+		},
+		"duplicate dependencies": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypeChief}},
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypePS}},
+			}},
+			wantErr: true,
+		},
+		"unknown condition": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypeChief}, Condition: "Succeeded"},
+			}},
+			wantErr: true,
+		},
+		"depends on itself": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypeWorker}},
+			}},
+			wantErr: true,
+		},
+		"cycle": {
+			policy: &StartupPolicy{Dependencies: []ReplicaStartupDependency{
+				{ReplicaType: TFJobReplicaTypeWorker, DependsOn: []ReplicaType{TFJobReplicaTypeChief}},
+				{ReplicaType: TFJobReplicaTypeChief, DependsOn: []ReplicaType{TFJobReplicaTypePS}},
+				{ReplicaType: TFJobReplicaTypePS, DependsOn: []ReplicaType{TFJobReplicaTypeWorker}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateStartupPolicy(&RunPolicy{StartupPolicy: tc.policy}, specs)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateStartupPolicy() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTopologyPolicy(t *testing.T) {
+	specs := map[ReplicaType]*ReplicaSpec{
+		PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+		PyTorchJobReplicaTypeWorker: {Replicas: ptr.To[int32](4)},
+	}
+
+	testCases := map[string]struct {
+		policy  *TopologyPolicy
+		wantErr bool
+	}{
+		"no topology policy": {
+			policy:  nil,
+			wantErr: false,
+		},
+		"workers in the same zone": {
+			policy: &TopologyPolicy{
+				TopologyKey:  "topology.kubernetes.io/zone",
+				Mode:         TopologyPolicyModeRequired,
+				ReplicaTypes: []ReplicaType{PyTorchJobReplicaTypeWorker},
+			},
+			wantErr: false,
+		},
+		"spread across the racks": {
+			policy:  &TopologyPolicy{TopologyKey: "example.com/rack", Mode: TopologyPolicyModeSpread},
+			wantErr: false,
+		},
+		"no topology key": {
+			policy:  &TopologyPolicy{Mode: TopologyPolicyModePreferred},
+			wantErr: true,
+		},
+		"invalid topology key": {
+			policy:  &TopologyPolicy{TopologyKey: "example.com/rack/row", Mode: TopologyPolicyModePreferred},
+			wantErr: true,
+		},
+		"unknown mode": {
+			policy:  &TopologyPolicy{TopologyKey: "topology.kubernetes.io/zone", Mode: "Pack"},
+			wantErr: true,
+		},
+		"unknown replica type": {
+			policy: &TopologyPolicy{
+				TopologyKey:  "topology.kubernetes.io/zone",
+				Mode:         TopologyPolicyModeRequired,
+				ReplicaTypes: []ReplicaType{TFJobReplicaTypePS},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateTopologyPolicy(&RunPolicy{TopologyPolicy: tc.policy}, specs)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateTopologyPolicy() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGracefulTermination(t *testing.T) {
+	testCases := map[string]struct {
+		policy  *GracefulTermination
+		wantErr bool
+	}{
+		"no graceful termination": {
+			policy:  nil,
+			wantErr: false,
+		},
+		"annotation only": {
+			policy:  &GracefulTermination{TimeoutSeconds: ptr.To[int32](600)},
+			wantErr: false,
+		},
+		"checkpoint signal": {
+			policy:  &GracefulTermination{Signal: ptr.To(CheckpointSignalUSR1)},
+			wantErr: false,
+		},
+		"non-positive timeout": {
+			policy:  &GracefulTermination{TimeoutSeconds: ptr.To[int32](0)},
+			wantErr: true,
+		},
+		"unknown signal": {
+			policy:  &GracefulTermination{Signal: ptr.To[CheckpointSignal]("SIGKILL")},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateGracefulTermination(&RunPolicy{GracefulTermination: tc.policy})
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateGracefulTermination() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRuntimeRef(t *testing.T) {
+	testCases := map[string]struct {
+		ref     *RuntimeRef
+		wantErr bool
+	}{
+		"no runtime": {
+			ref:     nil,
+			wantErr: false,
+		},
+		"cluster runtime": {
+			ref:     &RuntimeRef{Name: "torch-distributed"},
+			wantErr: false,
+		},
+		"namespaced runtime": {
+			ref:     &RuntimeRef{Name: "torch-distributed", Kind: TrainingRuntimeKind},
+			wantErr: false,
+		},
+		"no name": {
+			ref:     &RuntimeRef{Kind: ClusterTrainingRuntimeKind},
+			wantErr: true,
+		},
+		"unknown kind": {
+			ref:     &RuntimeRef{Name: "torch-distributed", Kind: "PyTorchJob"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRuntimeRef(&RunPolicy{RuntimeRef: tc.ref})
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateRuntimeRef() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDependsOn(t *testing.T) {
+	testCases := map[string]struct {
+		dependsOn []JobDependency
+		wantErr   bool
+	}{
+		"no dependencies": {
+			dependsOn: nil,
+			wantErr:   false,
+		},
+		"valid dependencies": {
+			dependsOn: []JobDependency{
+				{Kind: PyTorchJobKind, Name: "preprocess"},
+				{Kind: TFJobKind, Name: "train", Condition: JobRunning},
+			},
+			wantErr: false,
+		},
+		"no name": {
+			dependsOn: []JobDependency{{Kind: PyTorchJobKind}},
+			wantErr:   true,
+		},
+		"unknown kind": {
+			dependsOn: []JobDependency{{Kind: "Job", Name: "preprocess"}},
+			wantErr:   true,
+		},
+		"unsupported condition": {
+			dependsOn: []JobDependency{{Kind: PyTorchJobKind, Name: "preprocess", Condition: JobSuspended}},
+			wantErr:   true,
+		},
+		"duplicate dependency": {
+			dependsOn: []JobDependency{
+				{Kind: PyTorchJobKind, Name: "preprocess"},
+				{Kind: PyTorchJobKind, Name: "preprocess", Condition: JobRunning},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateDependsOn(&RunPolicy{DependsOn: tc.dependsOn})
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("validateDependsOn() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
@@ -53,6 +53,9 @@ func SetDefaults_XGBoostJob(xgboostJob *XGBoostJob) {
 	setXGBoostJobTypeNamesToCamelCase(xgboostJob)
 
 	for _, spec := range xgboostJob.Spec.XGBReplicaSpecs {
+		if spec == nil {
+			continue
+		}
 		// Set default replicas to 1.
 		setDefaultReplicas(spec, 1)
 		// Set default restartPolicy
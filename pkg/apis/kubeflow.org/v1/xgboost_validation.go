@@ -27,6 +27,9 @@ func ValidateV1XGBoostJob(xgboostJob *XGBoostJob) error {
 	if err := validateXGBoostReplicaSpecs(xgboostJob.Spec.XGBReplicaSpecs); err != nil {
 		return err
 	}
+	if err := validateRunPolicy(&xgboostJob.Spec.RunPolicy, xgboostJob.Spec.XGBReplicaSpecs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -39,6 +42,12 @@ func validateXGBoostReplicaSpecs(specs map[ReplicaType]*ReplicaSpec) error {
 		if value == nil || len(value.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("XGBoostJobSpec is not valid: containers definition expected in %v", rType)
 		}
+		if err := validatePodFailurePolicy(rType, value.PodFailurePolicy); err != nil {
+			return err
+		}
+		if err := validateRestartBackoff(rType, value.RestartBackoff); err != nil {
+			return err
+		}
 		// Make sure the replica type is valid.
 		validReplicaTypes := []ReplicaType{XGBoostJobReplicaTypeMaster, XGBoostJobReplicaTypeWorker}
 
@@ -0,0 +1,262 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	caCertKey = "ca.crt"
+	certKey   = corev1.TLSCertKey
+	keyKey    = corev1.TLSPrivateKeyKey
+
+	certValidity = 10 * 365 * 24 * time.Hour
+	// certRenewBefore is how long before expiry the certificate is regenerated on startup.
+	certRenewBefore = 90 * 24 * time.Hour
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;create;update
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;list;update
+
+// Options describes where the webhook serving certificate lives.
+type Options struct {
+	// Namespace of the webhook service and the certificate secret.
+	Namespace string
+	// ServiceName is the name of the service fronting the webhook server.
+	ServiceName string
+	// SecretName is the name of the secret storing the certificate.
+	SecretName string
+	// CertDir is the directory the webhook server reads tls.crt and tls.key from.
+	CertDir string
+}
+
+// EnsureCerts makes sure a valid serving certificate for the webhook service is stored in the secret,
+// writes it to the certificate directory and injects the CA bundle into the webhook configurations
+// pointing at the service.
+func EnsureCerts(ctx context.Context, c client.Client, opts Options) error {
+	secret, err := ensureSecret(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+	if err := writeCerts(secret, opts.CertDir); err != nil {
+		return err
+	}
+	return injectCABundle(ctx, c, secret.Data[caCertKey], opts)
+}
+
+func ensureSecret(ctx context.Context, c client.Client, opts Options) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.SecretName}, secret)
+	if apierrors.IsNotFound(err) {
+		data, err := generateCerts(opts)
+		if err != nil {
+			return nil, err
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: opts.Namespace,
+				Name:      opts.SecretName,
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		err = c.Create(ctx, secret)
+		if apierrors.IsAlreadyExists(err) {
+			// Another replica created the secret in the meantime, use its certificate.
+			return ensureSecret(ctx, c, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create secret %s/%s: %v", opts.Namespace, opts.SecretName, err)
+		}
+		return secret, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %v", opts.Namespace, opts.SecretName, err)
+	}
+	if validCerts(secret.Data, opts) {
+		return secret, nil
+	}
+	data, err := generateCerts(opts)
+	if err != nil {
+		return nil, err
+	}
+	secret.Data = data
+	if err := c.Update(ctx, secret); err != nil {
+		return nil, fmt.Errorf("failed to update secret %s/%s: %v", opts.Namespace, opts.SecretName, err)
+	}
+	return secret, nil
+}
+
+// validCerts checks that the stored certificate is signed by the stored CA, is valid for the
+// service DNS name and does not expire soon.
+func validCerts(data map[string][]byte, opts Options) bool {
+	if len(data[caCertKey]) == 0 || len(data[certKey]) == 0 || len(data[keyKey]) == 0 {
+		return false
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data[caCertKey]) {
+		return false
+	}
+	block, _ := pem.Decode(data[certKey])
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		DNSName:     serviceDNSNames(opts)[2],
+		Roots:       pool,
+		CurrentTime: time.Now().Add(certRenewBefore),
+	})
+	return err == nil
+}
+
+func generateCerts(opts Options) (map[string][]byte, error) {
+	now := time.Now()
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate CA key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s-ca", opts.ServiceName)},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CA certificate: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serving key: %v", err)
+	}
+	dnsNames := serviceDNSNames(opts)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsNames[2]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create serving certificate: %v", err)
+	}
+
+	return map[string][]byte{
+		caCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		certKey:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyKey:    pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+	}, nil
+}
+
+func serviceDNSNames(opts Options) []string {
+	return []string{
+		opts.ServiceName,
+		fmt.Sprintf("%s.%s", opts.ServiceName, opts.Namespace),
+		fmt.Sprintf("%s.%s.svc", opts.ServiceName, opts.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", opts.ServiceName, opts.Namespace),
+	}
+}
+
+func writeCerts(secret *corev1.Secret, certDir string) error {
+	if err := os.MkdirAll(certDir, 0700); err != nil {
+		return fmt.Errorf("failed to create certificate directory %s: %v", certDir, err)
+	}
+	for _, key := range []string{certKey, keyKey} {
+		if err := os.WriteFile(filepath.Join(certDir, key), secret.Data[key], 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func injectCABundle(ctx context.Context, c client.Client, caBundle []byte, opts Options) error {
+	matches := func(cfg admissionregistrationv1.WebhookClientConfig) bool {
+		return cfg.Service != nil && cfg.Service.Name == opts.ServiceName && cfg.Service.Namespace == opts.Namespace
+	}
+
+	validatingList := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := c.List(ctx, validatingList); err != nil {
+		return fmt.Errorf("failed to list validating webhook configurations: %v", err)
+	}
+	for i := range validatingList.Items {
+		cfg := &validatingList.Items[i]
+		changed := false
+		for j := range cfg.Webhooks {
+			if matches(cfg.Webhooks[j].ClientConfig) && !bytes.Equal(cfg.Webhooks[j].ClientConfig.CABundle, caBundle) {
+				cfg.Webhooks[j].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := c.Update(ctx, cfg); err != nil {
+				return fmt.Errorf("failed to inject CA bundle into %s: %v", cfg.Name, err)
+			}
+		}
+	}
+
+	mutatingList := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := c.List(ctx, mutatingList); err != nil {
+		return fmt.Errorf("failed to list mutating webhook configurations: %v", err)
+	}
+	for i := range mutatingList.Items {
+		cfg := &mutatingList.Items[i]
+		changed := false
+		for j := range cfg.Webhooks {
+			if matches(cfg.Webhooks[j].ClientConfig) && !bytes.Equal(cfg.Webhooks[j].ClientConfig.CABundle, caBundle) {
+				cfg.Webhooks[j].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := c.Update(ctx, cfg); err != nil {
+				return fmt.Errorf("failed to inject CA bundle into %s: %v", cfg.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureCerts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{
+		Namespace:   "kubeflow",
+		ServiceName: "training-operator-webhook",
+		SecretName:  "training-operator-webhook-cert",
+		CertDir:     t.TempDir(),
+	}
+	serviceRef := &admissionregistrationv1.ServiceReference{Namespace: opts.Namespace, Name: opts.ServiceName}
+	otherRef := &admissionregistrationv1.ServiceReference{Namespace: opts.Namespace, Name: "other"}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "validating"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{Name: "validate-pytorchjob.kubeflow.org", ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: serviceRef}},
+				{Name: "validate-other.kubeflow.org", ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: otherRef}},
+			},
+		},
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "mutating"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{
+				{Name: "default-pytorchjob.kubeflow.org", ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: serviceRef}},
+			},
+		},
+	).Build()
+
+	ctx := context.Background()
+	if err := EnsureCerts(ctx, c, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.SecretName}, secret); err != nil {
+		t.Fatalf("secret was not created: %v", err)
+	}
+	if !validCerts(secret.Data, opts) {
+		t.Errorf("stored certificate is not valid for the webhook service")
+	}
+	for _, key := range []string{certKey, keyKey} {
+		content, err := os.ReadFile(filepath.Join(opts.CertDir, key))
+		if err != nil {
+			t.Fatalf("%s was not written: %v", key, err)
+		}
+		if !bytes.Equal(content, secret.Data[key]) {
+			t.Errorf("%s does not match the secret", key)
+		}
+	}
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "validating"}, validating); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(validating.Webhooks[0].ClientConfig.CABundle, secret.Data[caCertKey]) {
+		t.Errorf("CA bundle was not injected into the validating webhook")
+	}
+	if len(validating.Webhooks[1].ClientConfig.CABundle) != 0 {
+		t.Errorf("CA bundle was injected into a webhook served by another service")
+	}
+	mutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "mutating"}, mutating); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(mutating.Webhooks[0].ClientConfig.CABundle, secret.Data[caCertKey]) {
+		t.Errorf("CA bundle was not injected into the mutating webhook")
+	}
+
+	// A valid certificate is reused on restart.
+	if err := EnsureCerts(ctx, c, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reused := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.SecretName}, reused); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reused.Data[certKey], secret.Data[certKey]) {
+		t.Errorf("valid certificate was regenerated")
+	}
+}
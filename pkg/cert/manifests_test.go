@@ -0,0 +1,118 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cert
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// kustomizeBinary returns the kustomize binary of $KUSTOMIZE, bin/kustomize or the PATH.
+func kustomizeBinary(t *testing.T) string {
+	if path := os.Getenv("KUSTOMIZE"); len(path) != 0 {
+		return path
+	}
+	if path, err := filepath.Abs("../../bin/kustomize"); err == nil {
+		if _, err = os.Stat(path); err == nil {
+			return path
+		}
+	}
+	path, err := exec.LookPath("kustomize")
+	if err != nil {
+		t.Skip("kustomize isn't installed, run make kustomize")
+	}
+	return path
+}
+
+// The CA bundle is only injected into the webhooks of the webhook service, the API server rejects
+// the jobs of any webhook pointing at another service.
+func TestWebhookManifests(t *testing.T) {
+	kustomize := kustomizeBinary(t)
+	for _, dir := range []string{
+		"manifests/base/webhook",
+		"manifests/overlays/standalone",
+		"manifests/overlays/kubeflow",
+		"manifests/rhoai",
+	} {
+		t.Run(dir, func(t *testing.T) {
+			out, err := exec.Command(kustomize, "build", filepath.Join("../..", dir)).Output()
+			if err != nil {
+				t.Fatalf("kustomize build %s error = %v", dir, err)
+			}
+			services := make(map[string]bool)
+			var webhooks []admissionregistrationv1.WebhookClientConfig
+			var names []string
+			decoder := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(out)))
+			for {
+				doc, err := decoder.Read()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				var typeMeta metav1.TypeMeta
+				if err = yaml.Unmarshal(doc, &typeMeta); err != nil {
+					t.Fatal(err)
+				}
+				switch typeMeta.Kind {
+				case "Service":
+					var obj metav1.PartialObjectMetadata
+					if err = yaml.Unmarshal(doc, &obj); err != nil {
+						t.Fatal(err)
+					}
+					services[obj.Namespace+"/"+obj.Name] = true
+				case "MutatingWebhookConfiguration":
+					var obj admissionregistrationv1.MutatingWebhookConfiguration
+					if err = yaml.Unmarshal(doc, &obj); err != nil {
+						t.Fatal(err)
+					}
+					for _, webhook := range obj.Webhooks {
+						names = append(names, webhook.Name)
+						webhooks = append(webhooks, webhook.ClientConfig)
+					}
+				case "ValidatingWebhookConfiguration":
+					var obj admissionregistrationv1.ValidatingWebhookConfiguration
+					if err = yaml.Unmarshal(doc, &obj); err != nil {
+						t.Fatal(err)
+					}
+					for _, webhook := range obj.Webhooks {
+						names = append(names, webhook.Name)
+						webhooks = append(webhooks, webhook.ClientConfig)
+					}
+				}
+			}
+			if len(webhooks) == 0 {
+				t.Fatal("Expected webhooks")
+			}
+			for i, cfg := range webhooks {
+				if cfg.Service == nil || !services[cfg.Service.Namespace+"/"+cfg.Service.Name] {
+					t.Errorf("Unexpected service of webhook %s: %+v", names[i], cfg.Service)
+				}
+			}
+		})
+	}
+}
@@ -26,6 +26,14 @@ type FakeKubeflowV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeKubeflowV1) ClusterTrainingRuntimes() v1.ClusterTrainingRuntimeInterface {
+	return &FakeClusterTrainingRuntimes{c}
+}
+
+func (c *FakeKubeflowV1) JAXJobs(namespace string) v1.JAXJobInterface {
+	return &FakeJAXJobs{c, namespace}
+}
+
 func (c *FakeKubeflowV1) MPIJobs(namespace string) v1.MPIJobInterface {
 	return &FakeMPIJobs{c, namespace}
 }
@@ -46,6 +54,14 @@ func (c *FakeKubeflowV1) TFJobs(namespace string) v1.TFJobInterface {
 	return &FakeTFJobs{c, namespace}
 }
 
+func (c *FakeKubeflowV1) TrainingCronJobs(namespace string) v1.TrainingCronJobInterface {
+	return &FakeTrainingCronJobs{c, namespace}
+}
+
+func (c *FakeKubeflowV1) TrainingRuntimes(namespace string) v1.TrainingRuntimeInterface {
+	return &FakeTrainingRuntimes{c, namespace}
+}
+
 func (c *FakeKubeflowV1) XGBoostJobs(namespace string) v1.XGBoostJobInterface {
 	return &FakeXGBoostJobs{c, namespace}
 }
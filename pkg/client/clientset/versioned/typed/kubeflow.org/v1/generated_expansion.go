@@ -16,6 +16,10 @@
 
 package v1
 
+type ClusterTrainingRuntimeExpansion interface{}
+
+type JAXJobExpansion interface{}
+
 type MPIJobExpansion interface{}
 
 type MXJobExpansion interface{}
@@ -26,4 +30,8 @@ type PyTorchJobExpansion interface{}
 
 type TFJobExpansion interface{}
 
+type TrainingCronJobExpansion interface{}
+
+type TrainingRuntimeExpansion interface{}
+
 type XGBoostJobExpansion interface{}
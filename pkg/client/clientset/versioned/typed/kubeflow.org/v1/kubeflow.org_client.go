@@ -26,11 +26,15 @@ import (
 
 type KubeflowV1Interface interface {
 	RESTClient() rest.Interface
+	ClusterTrainingRuntimesGetter
+	JAXJobsGetter
 	MPIJobsGetter
 	MXJobsGetter
 	PaddleJobsGetter
 	PyTorchJobsGetter
 	TFJobsGetter
+	TrainingCronJobsGetter
+	TrainingRuntimesGetter
 	XGBoostJobsGetter
 }
 
@@ -39,6 +43,14 @@ type KubeflowV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *KubeflowV1Client) ClusterTrainingRuntimes() ClusterTrainingRuntimeInterface {
+	return newClusterTrainingRuntimes(c)
+}
+
+func (c *KubeflowV1Client) JAXJobs(namespace string) JAXJobInterface {
+	return newJAXJobs(c, namespace)
+}
+
 func (c *KubeflowV1Client) MPIJobs(namespace string) MPIJobInterface {
 	return newMPIJobs(c, namespace)
 }
@@ -59,6 +71,14 @@ func (c *KubeflowV1Client) TFJobs(namespace string) TFJobInterface {
 	return newTFJobs(c, namespace)
 }
 
+func (c *KubeflowV1Client) TrainingCronJobs(namespace string) TrainingCronJobInterface {
+	return newTrainingCronJobs(c, namespace)
+}
+
+func (c *KubeflowV1Client) TrainingRuntimes(namespace string) TrainingRuntimeInterface {
+	return newTrainingRuntimes(c, namespace)
+}
+
 func (c *KubeflowV1Client) XGBoostJobs(namespace string) XGBoostJobInterface {
 	return newXGBoostJobs(c, namespace)
 }
@@ -51,6 +51,10 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=kubeflow.org, Version=v1
+	case v1.SchemeGroupVersion.WithResource("clustertrainingruntimes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().ClusterTrainingRuntimes().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("jaxjobs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().JAXJobs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("mpijobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().MPIJobs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("mxjobs"):
@@ -61,6 +65,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().PyTorchJobs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tfjobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().TFJobs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("trainingcronjobs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().TrainingCronJobs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("trainingruntimes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().TrainingRuntimes().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("xgboostjobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().XGBoostJobs().Informer()}, nil
 
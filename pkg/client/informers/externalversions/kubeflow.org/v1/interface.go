@@ -22,6 +22,10 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterTrainingRuntimes returns a ClusterTrainingRuntimeInformer.
+	ClusterTrainingRuntimes() ClusterTrainingRuntimeInformer
+	// JAXJobs returns a JAXJobInformer.
+	JAXJobs() JAXJobInformer
 	// MPIJobs returns a MPIJobInformer.
 	MPIJobs() MPIJobInformer
 	// MXJobs returns a MXJobInformer.
@@ -32,6 +36,10 @@ type Interface interface {
 	PyTorchJobs() PyTorchJobInformer
 	// TFJobs returns a TFJobInformer.
 	TFJobs() TFJobInformer
+	// TrainingCronJobs returns a TrainingCronJobInformer.
+	TrainingCronJobs() TrainingCronJobInformer
+	// TrainingRuntimes returns a TrainingRuntimeInformer.
+	TrainingRuntimes() TrainingRuntimeInformer
 	// XGBoostJobs returns a XGBoostJobInformer.
 	XGBoostJobs() XGBoostJobInformer
 }
@@ -47,6 +55,16 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterTrainingRuntimes returns a ClusterTrainingRuntimeInformer.
+func (v *version) ClusterTrainingRuntimes() ClusterTrainingRuntimeInformer {
+	return &clusterTrainingRuntimeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// JAXJobs returns a JAXJobInformer.
+func (v *version) JAXJobs() JAXJobInformer {
+	return &jAXJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MPIJobs returns a MPIJobInformer.
 func (v *version) MPIJobs() MPIJobInformer {
 	return &mPIJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -72,6 +90,16 @@ func (v *version) TFJobs() TFJobInformer {
 	return &tFJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TrainingCronJobs returns a TrainingCronJobInformer.
+func (v *version) TrainingCronJobs() TrainingCronJobInformer {
+	return &trainingCronJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TrainingRuntimes returns a TrainingRuntimeInformer.
+func (v *version) TrainingRuntimes() TrainingRuntimeInformer {
+	return &trainingRuntimeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // XGBoostJobs returns a XGBoostJobInformer.
 func (v *version) XGBoostJobs() XGBoostJobInformer {
 	return &xGBoostJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
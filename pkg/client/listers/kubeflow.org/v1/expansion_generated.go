@@ -16,6 +16,18 @@
 
 package v1
 
+// ClusterTrainingRuntimeListerExpansion allows custom methods to be added to
+// ClusterTrainingRuntimeLister.
+type ClusterTrainingRuntimeListerExpansion interface{}
+
+// JAXJobListerExpansion allows custom methods to be added to
+// JAXJobLister.
+type JAXJobListerExpansion interface{}
+
+// JAXJobNamespaceListerExpansion allows custom methods to be added to
+// JAXJobNamespaceLister.
+type JAXJobNamespaceListerExpansion interface{}
+
 // MPIJobListerExpansion allows custom methods to be added to
 // MPIJobLister.
 type MPIJobListerExpansion interface{}
@@ -56,6 +68,22 @@ type TFJobListerExpansion interface{}
 // TFJobNamespaceLister.
 type TFJobNamespaceListerExpansion interface{}
 
+// TrainingCronJobListerExpansion allows custom methods to be added to
+// TrainingCronJobLister.
+type TrainingCronJobListerExpansion interface{}
+
+// TrainingCronJobNamespaceListerExpansion allows custom methods to be added to
+// TrainingCronJobNamespaceLister.
+type TrainingCronJobNamespaceListerExpansion interface{}
+
+// TrainingRuntimeListerExpansion allows custom methods to be added to
+// TrainingRuntimeLister.
+type TrainingRuntimeListerExpansion interface{}
+
+// TrainingRuntimeNamespaceListerExpansion allows custom methods to be added to
+// TrainingRuntimeNamespaceLister.
+type TrainingRuntimeNamespaceListerExpansion interface{}
+
 // XGBoostJobListerExpansion allows custom methods to be added to
 // XGBoostJobLister.
 type XGBoostJobListerExpansion interface{}
@@ -92,3 +92,18 @@ type ControllerInterface interface {
 	// GetFrameworkName returns framework name (e.g., tensorflow).
 	GetFrameworkName() string
 }
+
+// RunPolicyInterface is optionally implemented by the controllers using the common ReconcilePods, so that
+// the pods are created according to the RunPolicy of the job, e.g. its StartupPolicy or TopologyPolicy.
+type RunPolicyInterface interface {
+	// GetRunPolicyForJob returns the RunPolicy of the job.
+	GetRunPolicyForJob(job interface{}) *apiv1.RunPolicy
+}
+
+// CheckpointPodsInterface is optionally implemented by the controllers whose pods with the master role
+// aren't returned by GetPodsForJob, e.g. the launcher pods owned by the launcher Job of an MPIJob, so
+// that the checkpoint of a job with GracefulTermination is requested from them as well.
+type CheckpointPodsInterface interface {
+	// GetCheckpointPodsForJob returns the pods of the job which aren't returned by GetPodsForJob.
+	GetCheckpointPodsForJob(job interface{}) ([]*v1.Pod, error)
+}
@@ -15,6 +15,9 @@
 package common
 
 import (
+	"sync"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -59,13 +62,73 @@ var (
 	)
 )
 
+// Define all the prometheus histograms and gauges for the job lifecycle
+var (
+	jobsTimeToRunning = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "training_operator_jobs_time_to_running_seconds",
+			Help:    "Time from the job creation until the job is running for the first time",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+		},
+		[]string{"job_namespace", "framework"},
+	)
+	jobsTimeToCompletion = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "training_operator_jobs_time_to_completion_seconds",
+			Help:    "Time from the job creation until the job is succeeded or failed",
+			Buckets: prometheus.ExponentialBuckets(10, 2, 16),
+		},
+		[]string{"job_namespace", "framework", "condition"},
+	)
+	podGroupsQueueingTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "training_operator_podgroups_queueing_seconds",
+			Help:    "Time from the PodGroup creation until the gang scheduler admits it",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+		},
+		[]string{"job_namespace", "framework"},
+	)
+	jobsActive = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "training_operator_jobs_active",
+			Help: "Number of jobs which are not finished yet, by their latest condition",
+		},
+		[]string{"job_namespace", "framework", "condition"},
+	)
+)
+
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(jobsCreatedCount,
 		jobsDeletedCount,
 		jobsSuccessfulCount,
 		jobsFailedCount,
-		jobsRestartedCount)
+		jobsRestartedCount,
+		jobsTimeToRunning,
+		jobsTimeToCompletion,
+		podGroupsQueueingTime,
+		jobsActive)
+}
+
+// activeJob is the label set an active job is counted under in jobsActive.
+type activeJob struct {
+	namespace string
+	framework string
+	condition string
+	// podGroupAdmitted is true once the queueing time of the job's PodGroup was observed.
+	podGroupAdmitted bool
+}
+
+// activeJobs holds the active jobs by their framework and key, so that the gauge can be moved from
+// the previous condition of a job to its current one. The jobs of different kinds may have the same key.
+var activeJobs = struct {
+	sync.Mutex
+	jobs map[string]*activeJob
+}{jobs: map[string]*activeJob{}}
+
+// activeJobKey returns the key of a job of the framework in activeJobs.
+func activeJobKey(jobKey, framework string) string {
+	return framework + "/" + jobKey
 }
 
 func CreatedJobsCounterInc(job_namespace, framework string) {
@@ -87,3 +150,66 @@ func FailedJobsCounterInc(job_namespace, framework string) {
 func RestartedJobsCounterInc(job_namespace, framework string) {
 	jobsRestartedCount.WithLabelValues(job_namespace, framework).Inc()
 }
+
+func TimeToRunningObserve(job_namespace, framework string, duration time.Duration) {
+	jobsTimeToRunning.WithLabelValues(job_namespace, framework).Observe(duration.Seconds())
+}
+
+func TimeToCompletionObserve(job_namespace, framework, condition string, duration time.Duration) {
+	jobsTimeToCompletion.WithLabelValues(job_namespace, framework, condition).Observe(duration.Seconds())
+}
+
+// PodGroupQueueingTimeObserve observes the queueing time of the PodGroup of an active job,
+// only the first admission of the PodGroup is observed.
+func PodGroupQueueingTimeObserve(jobKey, job_namespace, framework string, duration time.Duration) {
+	activeJobs.Lock()
+	defer activeJobs.Unlock()
+	key := activeJobKey(jobKey, framework)
+	job, ok := activeJobs.jobs[key]
+	if !ok {
+		job = &activeJob{namespace: job_namespace, framework: framework}
+		activeJobs.jobs[key] = job
+	}
+	if job.podGroupAdmitted {
+		return
+	}
+	job.podGroupAdmitted = true
+	podGroupsQueueingTime.WithLabelValues(job_namespace, framework).Observe(duration.Seconds())
+}
+
+// ActiveJobsGaugeSet counts the job under the given condition in the active jobs gauge.
+func ActiveJobsGaugeSet(jobKey, job_namespace, framework, condition string) {
+	activeJobs.Lock()
+	defer activeJobs.Unlock()
+	key := activeJobKey(jobKey, framework)
+	job, ok := activeJobs.jobs[key]
+	if !ok {
+		job = &activeJob{namespace: job_namespace, framework: framework}
+		activeJobs.jobs[key] = job
+	}
+	if job.condition == condition {
+		return
+	}
+	if job.condition != "" {
+		jobsActive.WithLabelValues(job.namespace, job.framework, job.condition).Dec()
+	}
+	job.condition = condition
+	if condition != "" {
+		jobsActive.WithLabelValues(job_namespace, framework, condition).Inc()
+	}
+}
+
+// ActiveJobsGaugeDelete stops counting the job in the active jobs gauge, once it is finished or deleted.
+func ActiveJobsGaugeDelete(jobKey, framework string) {
+	activeJobs.Lock()
+	defer activeJobs.Unlock()
+	key := activeJobKey(jobKey, framework)
+	job, ok := activeJobs.jobs[key]
+	if !ok {
+		return
+	}
+	if job.condition != "" {
+		jobsActive.WithLabelValues(job.namespace, job.framework, job.condition).Dec()
+	}
+	delete(activeJobs.jobs, key)
+}
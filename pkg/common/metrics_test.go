@@ -0,0 +1,107 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestActiveJobsGauge(t *testing.T) {
+	activeJobsCount := func(condition string) float64 {
+		return testutil.ToFloat64(jobsActive.WithLabelValues("test", "pytorch", condition))
+	}
+
+	ActiveJobsGaugeSet("test/job-a", "test", "pytorch", "Created")
+	ActiveJobsGaugeSet("test/job-b", "test", "pytorch", "Created")
+	if got := activeJobsCount("Created"); got != 2 {
+		t.Errorf("expected 2 created jobs, got %v", got)
+	}
+
+	// Reconciling a job without a condition change keeps the gauge as is.
+	ActiveJobsGaugeSet("test/job-a", "test", "pytorch", "Running")
+	ActiveJobsGaugeSet("test/job-a", "test", "pytorch", "Running")
+	if got := activeJobsCount("Created"); got != 1 {
+		t.Errorf("expected 1 created job, got %v", got)
+	}
+	if got := activeJobsCount("Running"); got != 1 {
+		t.Errorf("expected 1 running job, got %v", got)
+	}
+
+	ActiveJobsGaugeDelete("test/job-a", "pytorch")
+	ActiveJobsGaugeDelete("test/job-b", "pytorch")
+	ActiveJobsGaugeDelete("test/job-unknown", "pytorch")
+	if got := activeJobsCount("Created"); got != 0 {
+		t.Errorf("expected no created jobs, got %v", got)
+	}
+	if got := activeJobsCount("Running"); got != 0 {
+		t.Errorf("expected no running jobs, got %v", got)
+	}
+}
+
+// The jobs of different kinds with the same namespace and name are counted separately.
+func TestActiveJobsGaugeSameName(t *testing.T) {
+	activeJobsCount := func(framework, condition string) float64 {
+		return testutil.ToFloat64(jobsActive.WithLabelValues("same-name", framework, condition))
+	}
+
+	ActiveJobsGaugeSet("same-name/job", "same-name", "tensorflow", "Running")
+	ActiveJobsGaugeSet("same-name/job", "same-name", "pytorch", "Created")
+	if got := activeJobsCount("tensorflow", "Running"); got != 1 {
+		t.Errorf("expected 1 running TFJob, got %v", got)
+	}
+	if got := activeJobsCount("pytorch", "Created"); got != 1 {
+		t.Errorf("expected 1 created PyTorchJob, got %v", got)
+	}
+
+	// Deleting the PyTorchJob keeps counting the TFJob.
+	ActiveJobsGaugeDelete("same-name/job", "pytorch")
+	if got := activeJobsCount("pytorch", "Created"); got != 0 {
+		t.Errorf("expected no created PyTorchJob, got %v", got)
+	}
+	if got := activeJobsCount("tensorflow", "Running"); got != 1 {
+		t.Errorf("expected 1 running TFJob, got %v", got)
+	}
+
+	ActiveJobsGaugeDelete("same-name/job", "tensorflow")
+	if got := activeJobsCount("tensorflow", "Running"); got != 0 {
+		t.Errorf("expected no running TFJob, got %v", got)
+	}
+}
+
+func TestPodGroupQueueingTimeObserve(t *testing.T) {
+	defer ActiveJobsGaugeDelete("test/job", "tensorflow")
+
+	PodGroupQueueingTimeObserve("test/job", "test", "tensorflow", time.Second)
+	if job := activeJobs.jobs[activeJobKey("test/job", "tensorflow")]; job == nil || !job.podGroupAdmitted {
+		t.Fatalf("expected the PodGroup admission of the job to be remembered")
+	}
+	if got := testutil.CollectAndCount(podGroupsQueueingTime); got != 1 {
+		t.Errorf("expected a single histogram, got %d", got)
+	}
+
+	// The job keeps its PodGroup admission when it moves to another condition.
+	ActiveJobsGaugeSet("test/job", "test", "tensorflow", "Running")
+	if job := activeJobs.jobs[activeJobKey("test/job", "tensorflow")]; !job.podGroupAdmitted {
+		t.Errorf("expected the PodGroup admission of the job to be kept")
+	}
+
+	ActiveJobsGaugeDelete("test/job", "tensorflow")
+	if _, ok := activeJobs.jobs[activeJobKey("test/job", "tensorflow")]; ok {
+		t.Errorf("expected the job to be forgotten once deleted")
+	}
+}
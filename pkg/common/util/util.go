@@ -22,7 +22,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
 	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	trainutil "github.com/kubeflow/training-operator/pkg/util/train"
 )
 
 type ObjectFilterFunction func(obj metav1.Object) bool
@@ -84,3 +86,42 @@ func DurationUntilExpireTime(runPolicy *kubeflowv1.RunPolicy, jobStatus kubeflow
 		return expireTime.Sub(currentTime), nil
 	}
 }
+
+// DurationUntilActiveDeadline returns the duration until the job exceeds its ActiveDeadlineSeconds.
+// It returns -1 if the job has no active deadline, is suspended or has already finished.
+func DurationUntilActiveDeadline(runPolicy *kubeflowv1.RunPolicy, jobStatus kubeflowv1.JobStatus) time.Duration {
+	if runPolicy.ActiveDeadlineSeconds == nil || trainutil.IsJobSuspended(runPolicy) || commonutil.IsFinished(jobStatus) {
+		return -1
+	}
+	deadline := time.Duration(*runPolicy.ActiveDeadlineSeconds) * time.Second
+	if jobStatus.StartTime == nil {
+		// The job is started by the current reconciliation.
+		return deadline
+	}
+	remaining := jobStatus.StartTime.Add(deadline).Sub(time.Now())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// DurationUntilRequeue returns the duration until the job must be reconciled again without any event, i.e. to
+// clean it up after its TTL, to delete its pods after the checkpoint timeout, to recreate the pods restarted
+// with a RestartBackoff or to fail it once it exceeds ActiveDeadlineSeconds. It returns -1 if there is none.
+func DurationUntilRequeue(runPolicy *kubeflowv1.RunPolicy, replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
+	jobStatus kubeflowv1.JobStatus) (time.Duration, error) {
+	requeue, err := DurationUntilExpireTime(runPolicy, jobStatus)
+	if err != nil {
+		return -1, err
+	}
+	for _, t := range []time.Duration{
+		common.DurationUntilCheckpointDeadline(runPolicy, jobStatus),
+		common.DurationUntilNextRestart(replicas, jobStatus),
+		DurationUntilActiveDeadline(runPolicy, jobStatus),
+	} {
+		if t >= 0 && (requeue < 0 || t < requeue) {
+			requeue = t
+		}
+	}
+	return requeue, nil
+}
@@ -116,9 +116,146 @@ func TestDurationUntilExpireTime(t *testing.T) {
 	}
 }
 
+func TestDurationUntilActiveDeadline(t *testing.T) {
+	tests := []struct {
+		name      string
+		runPolicy *kubeflowv1.RunPolicy
+		jobStatus kubeflowv1.JobStatus
+		want      time.Duration
+	}{
+		{
+			name:      "job without active deadline",
+			runPolicy: &kubeflowv1.RunPolicy{},
+			jobStatus: kubeflowv1.JobStatus{
+				Conditions: []kubeflowv1.JobCondition{newJobCondition(kubeflowv1.JobRunning)},
+				StartTime:  &metav1.Time{Time: time.Now()},
+			},
+			want: -1,
+		},
+		{
+			name: "job which is not started yet",
+			runPolicy: &kubeflowv1.RunPolicy{
+				ActiveDeadlineSeconds: ptr.To[int64](5),
+			},
+			jobStatus: kubeflowv1.JobStatus{},
+			want:      5 * time.Second,
+		},
+		{
+			name: "running job with remaining time 1s",
+			runPolicy: &kubeflowv1.RunPolicy{
+				ActiveDeadlineSeconds: ptr.To[int64](5),
+			},
+			jobStatus: kubeflowv1.JobStatus{
+				Conditions: []kubeflowv1.JobCondition{newJobCondition(kubeflowv1.JobRunning)},
+				StartTime:  &metav1.Time{Time: time.Now().Add(-4 * time.Second)},
+			},
+			want: time.Second,
+		},
+		{
+			name: "running job past active deadline",
+			runPolicy: &kubeflowv1.RunPolicy{
+				ActiveDeadlineSeconds: ptr.To[int64](5),
+			},
+			jobStatus: kubeflowv1.JobStatus{
+				Conditions: []kubeflowv1.JobCondition{newJobCondition(kubeflowv1.JobRunning)},
+				StartTime:  &metav1.Time{Time: time.Now().Add(-6 * time.Second)},
+			},
+			want: 0,
+		},
+		{
+			name: "suspended job",
+			runPolicy: &kubeflowv1.RunPolicy{
+				ActiveDeadlineSeconds: ptr.To[int64](5),
+				Suspend:               ptr.To(true),
+			},
+			jobStatus: kubeflowv1.JobStatus{},
+			want:      -1,
+		},
+		{
+			name: "succeeded job",
+			runPolicy: &kubeflowv1.RunPolicy{
+				ActiveDeadlineSeconds: ptr.To[int64](5),
+			},
+			jobStatus: kubeflowv1.JobStatus{
+				Conditions: []kubeflowv1.JobCondition{newJobCondition(kubeflowv1.JobSucceeded)},
+				StartTime:  &metav1.Time{Time: time.Now().Add(-6 * time.Second)},
+			},
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DurationUntilActiveDeadline(tt.runPolicy, tt.jobStatus)
+			if tt.want <= 0 && got != tt.want {
+				t.Errorf("DurationUntilActiveDeadline() got = %v, want %v", got, tt.want)
+			}
+			// Allow some slack for the time passed since the start time was set.
+			if tt.want > 0 && (got > tt.want || got < tt.want-time.Second) {
+				t.Errorf("DurationUntilActiveDeadline() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func newJobCondition(t kubeflowv1.JobConditionType) kubeflowv1.JobCondition {
 	return kubeflowv1.JobCondition{
 		Type:   t,
 		Status: corev1.ConditionTrue,
 	}
 }
+
+func TestDurationUntilRequeue(t *testing.T) {
+	replicas := map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+		"Worker": {RestartBackoff: &kubeflowv1.RestartBackoff{}},
+	}
+	running := kubeflowv1.JobStatus{
+		Conditions: []kubeflowv1.JobCondition{newJobCondition(kubeflowv1.JobRunning)},
+		StartTime:  &metav1.Time{Time: time.Now()},
+		ReplicaStatuses: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus{
+			"Worker": {NextRestartTime: &metav1.Time{Time: time.Now().Add(10 * time.Second)}},
+		},
+	}
+	tests := []struct {
+		name      string
+		runPolicy *kubeflowv1.RunPolicy
+		jobStatus kubeflowv1.JobStatus
+		want      time.Duration
+	}{
+		{
+			name:      "running job without deadline",
+			runPolicy: &kubeflowv1.RunPolicy{},
+			jobStatus: kubeflowv1.JobStatus{
+				Conditions: []kubeflowv1.JobCondition{newJobCondition(kubeflowv1.JobRunning)},
+			},
+			want: -1,
+		},
+		{
+			name:      "restart delayed by a RestartBackoff",
+			runPolicy: &kubeflowv1.RunPolicy{},
+			jobStatus: running,
+			want:      10 * time.Second,
+		},
+		{
+			name: "active deadline before the delayed restart",
+			runPolicy: &kubeflowv1.RunPolicy{
+				ActiveDeadlineSeconds: ptr.To[int64](5),
+			},
+			jobStatus: running,
+			want:      5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DurationUntilRequeue(tt.runPolicy, replicas, tt.jobStatus)
+			if err != nil {
+				t.Fatalf("DurationUntilRequeue() error = %v", err)
+			}
+			if tt.want < 0 && got != tt.want {
+				t.Errorf("DurationUntilRequeue() got = %v, want %v", got, tt.want)
+			}
+			if tt.want >= 0 && (got > tt.want || got < tt.want-time.Second) {
+				t.Errorf("DurationUntilRequeue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
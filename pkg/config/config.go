@@ -20,6 +20,9 @@ var Config struct {
 	PyTorchInitContainerImage        string
 	MPIKubectlDeliveryImage          string
 	PyTorchInitContainerMaxTries     int
+	// PyTorchInitContainerDisabled disables the init container which waits for the DNS
+	// record of the master, the RunPolicy.StartupPolicy orders the startup of the replicas instead.
+	PyTorchInitContainerDisabled bool
 }
 
 const (
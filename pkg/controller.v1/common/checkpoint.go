@@ -0,0 +1,205 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	"github.com/kubeflow/training-operator/pkg/util/k8sutil"
+)
+
+// WaitForCheckpoint requests a checkpoint from the pods of a job with GracefulTermination before they
+// are deleted. It returns true once the pods can be deleted, i.e. when the replica with the master role
+// reported the checkpoint as complete, when the timeout expired or when there is no master to wait for.
+// While waiting, the job has a JobCheckpointing condition, whose last transition time is the time of
+// the checkpoint request.
+func (jc *JobController) WaitForCheckpoint(runtimeObject runtime.Object, runPolicy *apiv1.RunPolicy,
+	jobStatus *apiv1.JobStatus, pods []*corev1.Pod) (bool, error) {
+	if runPolicy.GracefulTermination == nil {
+		return true, nil
+	}
+	jobKind := jc.Controller.GetAPIGroupVersionKind().Kind
+	metaObject, ok := runtimeObject.(metav1.Object)
+	if !ok {
+		return false, fmt.Errorf("job is not of type metav1.Object")
+	}
+
+	if c, ok := jc.Controller.(trainingoperatorcommon.CheckpointPodsInterface); ok {
+		checkpointPods, err := c.GetCheckpointPodsForJob(runtimeObject)
+		if err != nil {
+			return false, err
+		}
+		pods = append(pods[:len(pods):len(pods)], checkpointPods...)
+	}
+	activePods := k8sutil.FilterActivePods(pods)
+	master := getRunningMasterPod(activePods)
+	checkpointing := getCheckpointingCondition(*jobStatus)
+
+	if checkpointing == nil {
+		// Nothing can be checkpointed without a running master.
+		if master == nil {
+			return true, nil
+		}
+		now := metav1.Now()
+		if err := jc.requestCheckpoint(activePods, now); err != nil {
+			return false, err
+		}
+		jc.signalCheckpoint(runtimeObject, runPolicy, activePods)
+		msg := fmt.Sprintf("%s %s is waiting up to %ds for the checkpoint of pod %s before its pods are deleted.",
+			jobKind, metaObject.GetName(), gracefulTerminationTimeoutSeconds(runPolicy), master.Name)
+		jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobCheckpointingReason), msg)
+		commonutil.UpdateJobConditions(jobStatus, apiv1.JobCheckpointing, corev1.ConditionTrue,
+			commonutil.NewReason(jobKind, commonutil.JobCheckpointingReason), msg)
+		return false, nil
+	}
+
+	switch {
+	case master == nil:
+		// The master pod was deleted or evicted, there is no checkpoint to wait for anymore.
+		msg := fmt.Sprintf("%s %s lost the checkpoint, its pod with the master role is gone.", jobKind, metaObject.GetName())
+		jc.Recorder.Event(runtimeObject, corev1.EventTypeWarning, commonutil.NewReason(jobKind, commonutil.JobCheckpointLostReason), msg)
+		commonutil.UpdateJobConditions(jobStatus, apiv1.JobCheckpointing, corev1.ConditionFalse,
+			commonutil.NewReason(jobKind, commonutil.JobCheckpointLostReason), msg)
+		return true, nil
+	case isCheckpointComplete(master):
+		msg := fmt.Sprintf("%s %s completed the checkpoint.", jobKind, metaObject.GetName())
+		jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobCheckpointCompleteReason), msg)
+		commonutil.UpdateJobConditions(jobStatus, apiv1.JobCheckpointing, corev1.ConditionFalse,
+			commonutil.NewReason(jobKind, commonutil.JobCheckpointCompleteReason), msg)
+		return true, nil
+	case DurationUntilCheckpointDeadline(runPolicy, *jobStatus) == 0:
+		msg := fmt.Sprintf("%s %s did not complete the checkpoint within %ds.",
+			jobKind, metaObject.GetName(), gracefulTerminationTimeoutSeconds(runPolicy))
+		jc.Recorder.Event(runtimeObject, corev1.EventTypeWarning, commonutil.NewReason(jobKind, commonutil.JobCheckpointTimeoutReason), msg)
+		commonutil.UpdateJobConditions(jobStatus, apiv1.JobCheckpointing, corev1.ConditionFalse,
+			commonutil.NewReason(jobKind, commonutil.JobCheckpointTimeoutReason), msg)
+		return true, nil
+	}
+	return false, nil
+}
+
+// DurationUntilCheckpointDeadline returns the time left until the checkpoint timeout of a job which
+// waits for a checkpoint expires, or -1 if the job doesn't wait for a checkpoint.
+func DurationUntilCheckpointDeadline(runPolicy *apiv1.RunPolicy, jobStatus apiv1.JobStatus) time.Duration {
+	if runPolicy.GracefulTermination == nil {
+		return -1
+	}
+	checkpointing := getCheckpointingCondition(jobStatus)
+	if checkpointing == nil {
+		return -1
+	}
+	timeout := time.Duration(gracefulTerminationTimeoutSeconds(runPolicy)) * time.Second
+	remaining := checkpointing.LastTransitionTime.Add(timeout).Sub(time.Now())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// requestCheckpoint annotates the pods with the time of the checkpoint request.
+func (jc *JobController) requestCheckpoint(pods []*corev1.Pod, now metav1.Time) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				apiv1.CheckpointRequestedAnnotation: now.UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if err := jc.PodControl.PatchPod(pod.Namespace, pod.Name, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signalCheckpoint sends the checkpoint signal to the default container of the running pods. The pods are
+// signaled in parallel, each exec is bounded by SignalPodTimeout, so that a slow pod doesn't delay the
+// others. The pods failing to be signaled are only reported, the training code can still watch the annotation.
+func (jc *JobController) signalCheckpoint(runtimeObject runtime.Object, runPolicy *apiv1.RunPolicy, pods []*corev1.Pod) {
+	if runPolicy.GracefulTermination.Signal == nil {
+		return
+	}
+	jobKind := jc.Controller.GetAPIGroupVersionKind().Kind
+	signal := string(*runPolicy.GracefulTermination.Signal)
+	container := jc.Controller.GetDefaultContainerName()
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		wg.Add(1)
+		go func(pod *corev1.Pod) {
+			defer wg.Done()
+			if err := jc.PodControl.SignalPod(pod.Namespace, pod.Name, container, signal); err != nil {
+				jc.Recorder.Eventf(runtimeObject, corev1.EventTypeWarning,
+					commonutil.NewReason(jobKind, commonutil.JobCheckpointSignalFailedReason), "Error sending %s: %v", signal, err)
+			}
+		}(pod)
+	}
+	wg.Wait()
+}
+
+func gracefulTerminationTimeoutSeconds(runPolicy *apiv1.RunPolicy) int32 {
+	if timeout := runPolicy.GracefulTermination.TimeoutSeconds; timeout != nil {
+		return *timeout
+	}
+	return apiv1.DefaultGracefulTerminationTimeoutSeconds
+}
+
+// getCheckpointingCondition returns the JobCheckpointing condition if the job is waiting for a checkpoint.
+func getCheckpointingCondition(jobStatus apiv1.JobStatus) *apiv1.JobCondition {
+	for i := range jobStatus.Conditions {
+		if jobStatus.Conditions[i].Type == apiv1.JobCheckpointing && jobStatus.Conditions[i].Status == corev1.ConditionTrue {
+			return &jobStatus.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func getRunningMasterPod(pods []*corev1.Pod) *corev1.Pod {
+	for _, pod := range pods {
+		if pod.Labels[apiv1.JobRoleLabel] == "master" && pod.Status.Phase == corev1.PodRunning {
+			return pod
+		}
+	}
+	return nil
+}
+
+// isCheckpointComplete checks whether the pod reported the checkpoint as complete.
+func isCheckpointComplete(pod *corev1.Pod) bool {
+	if pod.Annotations[apiv1.CheckpointCompleteAnnotation] == "true" {
+		return true
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == apiv1.PodCheckpointComplete && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+type testController struct {
+	trainingoperatorcommon.ControllerInterface
+}
+
+func (testController) GetAPIGroupVersionKind() schema.GroupVersionKind {
+	return testjobv1.SchemeGroupVersionKind
+}
+
+func (testController) GetDefaultContainerName() string {
+	return "test-container"
+}
+
+func (testController) GetFrameworkName() string {
+	return "test"
+}
+
+func TestWaitForCheckpoint(T *testing.T) {
+	newMasterPod := func(annotations map[string]string) *corev1.Pod {
+		pod := newPod("master", corev1.PodRunning)
+		pod.Labels[apiv1.JobRoleLabel] = "master"
+		pod.Annotations = annotations
+		return pod
+	}
+	checkpointing := func(since time.Duration) []apiv1.JobCondition {
+		return []apiv1.JobCondition{{
+			Type:               apiv1.JobCheckpointing,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-since)),
+		}}
+	}
+
+	cases := map[string]struct {
+		gracefulTermination *apiv1.GracefulTermination
+		conditions          []apiv1.JobCondition
+		pods                []*corev1.Pod
+		wantDone            bool
+		wantPatches         int
+		wantSignals         []string
+		wantCheckpointing   corev1.ConditionStatus
+		wantReason          string
+	}{
+		"no graceful termination": {
+			pods:     []*corev1.Pod{newMasterPod(nil)},
+			wantDone: true,
+		},
+		"no running master": {
+			gracefulTermination: &apiv1.GracefulTermination{},
+			pods:                []*corev1.Pod{newPod("worker", corev1.PodRunning)},
+			wantDone:            true,
+		},
+		"checkpoint is requested": {
+			gracefulTermination: &apiv1.GracefulTermination{},
+			pods:                []*corev1.Pod{newMasterPod(nil), newPod("worker", corev1.PodRunning)},
+			wantDone:            false,
+			wantPatches:         2,
+			wantCheckpointing:   corev1.ConditionTrue,
+		},
+		"checkpoint signal is sent": {
+			gracefulTermination: &apiv1.GracefulTermination{Signal: ptr.To(apiv1.CheckpointSignalUSR1)},
+			pods:                []*corev1.Pod{newMasterPod(nil), newPod("worker", corev1.PodRunning), newPod("pending", corev1.PodPending)},
+			wantDone:            false,
+			wantPatches:         3,
+			wantSignals:         []string{"master/test-container:SIGUSR1", "worker/test-container:SIGUSR1"},
+			wantCheckpointing:   corev1.ConditionTrue,
+		},
+		"checkpoint is in progress": {
+			gracefulTermination: &apiv1.GracefulTermination{Signal: ptr.To(apiv1.CheckpointSignalUSR1)},
+			conditions:          checkpointing(time.Minute),
+			pods:                []*corev1.Pod{newMasterPod(nil)},
+			wantDone:            false,
+			wantCheckpointing:   corev1.ConditionTrue,
+		},
+		"checkpoint is complete": {
+			gracefulTermination: &apiv1.GracefulTermination{},
+			conditions:          checkpointing(time.Minute),
+			pods:                []*corev1.Pod{newMasterPod(map[string]string{apiv1.CheckpointCompleteAnnotation: "true"})},
+			wantDone:            true,
+			wantCheckpointing:   corev1.ConditionFalse,
+			wantReason:          commonutil.NewReason(testjobv1.Kind, commonutil.JobCheckpointCompleteReason),
+		},
+		"master is gone during the checkpoint": {
+			gracefulTermination: &apiv1.GracefulTermination{},
+			conditions:          checkpointing(time.Minute),
+			pods:                []*corev1.Pod{newPod("worker", corev1.PodRunning)},
+			wantDone:            true,
+			wantCheckpointing:   corev1.ConditionFalse,
+			wantReason:          commonutil.NewReason(testjobv1.Kind, commonutil.JobCheckpointLostReason),
+		},
+		"checkpoint timed out": {
+			gracefulTermination: &apiv1.GracefulTermination{TimeoutSeconds: ptr.To[int32](30)},
+			conditions:          checkpointing(time.Minute),
+			pods:                []*corev1.Pod{newMasterPod(nil)},
+			wantDone:            true,
+			wantCheckpointing:   corev1.ConditionFalse,
+			wantReason:          commonutil.NewReason(testjobv1.Kind, commonutil.JobCheckpointTimeoutReason),
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			podControl := &control.FakePodControl{}
+			jobController := JobController{
+				Controller: testController{},
+				PodControl: podControl,
+				Recorder:   record.NewFakeRecorder(10),
+			}
+			runPolicy := &apiv1.RunPolicy{GracefulTermination: tc.gracefulTermination}
+			jobStatus := &apiv1.JobStatus{Conditions: tc.conditions}
+
+			done, err := jobController.WaitForCheckpoint(&testjobv1.TestJob{}, runPolicy, jobStatus, tc.pods)
+			if err != nil {
+				t.Fatalf("WaitForCheckpoint() error = %v", err)
+			}
+			if done != tc.wantDone {
+				t.Errorf("Unexpected done: \nwant: %v\ngot: %v\n", tc.wantDone, done)
+			}
+			if len(podControl.Patches) != tc.wantPatches {
+				t.Errorf("Unexpected pod patches: \nwant: %v\ngot: %v\n", tc.wantPatches, len(podControl.Patches))
+			}
+			if diff := cmp.Diff(tc.wantSignals, podControl.Signals, cmpopts.SortSlices(func(a, b string) bool { return a < b })); len(diff) != 0 {
+				t.Errorf("Unexpected pod signals (-want,+got):\n%s", diff)
+			}
+			var gotCheckpointing corev1.ConditionStatus
+			var gotReason string
+			for _, condition := range jobStatus.Conditions {
+				if condition.Type == apiv1.JobCheckpointing {
+					gotCheckpointing = condition.Status
+					gotReason = condition.Reason
+				}
+			}
+			if gotCheckpointing != tc.wantCheckpointing {
+				t.Errorf("Unexpected checkpointing condition: \nwant: %v\ngot: %v\n", tc.wantCheckpointing, gotCheckpointing)
+			}
+			if len(tc.wantReason) != 0 && gotReason != tc.wantReason {
+				t.Errorf("Unexpected checkpointing reason: \nwant: %v\ngot: %v\n", tc.wantReason, gotReason)
+			}
+		})
+	}
+}
+
+func TestDurationUntilCheckpointDeadline(T *testing.T) {
+	runPolicy := &apiv1.RunPolicy{
+		GracefulTermination: &apiv1.GracefulTermination{TimeoutSeconds: ptr.To[int32](60)},
+	}
+	jobStatus := apiv1.JobStatus{}
+	if got := DurationUntilCheckpointDeadline(runPolicy, jobStatus); got != -1 {
+		T.Errorf("Unexpected duration without checkpoint: \nwant: -1\ngot: %v\n", got)
+	}
+
+	jobStatus.Conditions = []apiv1.JobCondition{{
+		Type:               apiv1.JobCheckpointing,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-30 * time.Second)),
+	}}
+	if got := DurationUntilCheckpointDeadline(runPolicy, jobStatus); got <= 0 || got > 30*time.Second {
+		T.Errorf("Unexpected duration during checkpoint: %v", got)
+	}
+
+	jobStatus.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+	if got := DurationUntilCheckpointDeadline(runPolicy, jobStatus); got != 0 {
+		T.Errorf("Unexpected duration after timeout: \nwant: 0\ngot: %v\n", got)
+	}
+}
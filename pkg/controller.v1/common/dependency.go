@@ -0,0 +1,192 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// waitForDependencies checks the jobs of RunPolicy.DependsOn before the pods of the job are created.
+// It returns true while the job waits for its dependencies, or when it failed the job since a dependency
+// can't reach its condition anymore. The dependencies are checked until they are met once.
+func (jc *JobController) waitForDependencies(metaObject metav1.Object, runtimeObject runtime.Object,
+	runPolicy *apiv1.RunPolicy, jobStatus *apiv1.JobStatus) (bool, error) {
+	if len(runPolicy.DependsOn) == 0 || commonutil.IsRunning(*jobStatus) || dependenciesMet(jobStatus) {
+		return false, nil
+	}
+	if jc.JobReader == nil {
+		return false, fmt.Errorf("job %s depends on other jobs but the controller has no job reader", metaObject.GetName())
+	}
+	ctx := context.Background()
+	jobKind := jc.Controller.GetAPIGroupVersionKind().Kind
+	namespace := metaObject.GetNamespace()
+
+	cycle, err := core.FindDependencyCycle(ctx, jc.JobReader, namespace, jobKind, metaObject.GetName(), runPolicy.DependsOn)
+	if err != nil {
+		return false, err
+	}
+	if cycle != nil {
+		jc.failForDependency(runtimeObject, jobStatus, jobKind,
+			fmt.Sprintf("%s %s has a dependency cycle %s.", jobKind, metaObject.GetName(), strings.Join(cycle, " -> ")))
+		return true, nil
+	}
+
+	var pending []string
+	for _, dependency := range runPolicy.DependsOn {
+		condition := dependency.Condition
+		if len(condition) == 0 {
+			condition = apiv1.JobSucceeded
+		}
+		key := core.DependencyIndexValue(dependency.Kind, dependency.Name)
+		job, err := core.GetDependency(ctx, jc.JobReader, namespace, dependency)
+		if errors.IsNotFound(err) {
+			pending = append(pending, fmt.Sprintf("%s to be created", key))
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		_, status := core.JobRunPolicyAndStatus(job)
+		met, unsatisfiable := core.DependencyState(status, condition)
+		if unsatisfiable {
+			jc.failForDependency(runtimeObject, jobStatus, jobKind,
+				fmt.Sprintf("%s %s has failed because %s can't be %s.", jobKind, metaObject.GetName(), key, condition))
+			return true, nil
+		}
+		if !met {
+			pending = append(pending, fmt.Sprintf("%s to be %s", key, condition))
+		}
+	}
+
+	if len(pending) != 0 {
+		msg := fmt.Sprintf("%s %s is waiting for %s.", jobKind, metaObject.GetName(), strings.Join(pending, ", "))
+		if !isWaiting(jobStatus) {
+			jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobWaitingReason), msg)
+		}
+		commonutil.UpdateJobConditions(jobStatus, apiv1.JobWaiting, corev1.ConditionTrue,
+			commonutil.NewReason(jobKind, commonutil.JobWaitingReason), msg)
+		return true, nil
+	}
+	msg := fmt.Sprintf("The jobs %s %s depends on reached their condition.", jobKind, metaObject.GetName())
+	jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobDependenciesMetReason), msg)
+	commonutil.UpdateJobConditions(jobStatus, apiv1.JobWaiting, corev1.ConditionFalse,
+		commonutil.NewReason(jobKind, commonutil.JobDependenciesMetReason), msg)
+	return false, nil
+}
+
+// failForDependency fails the job which can't start since its dependencies can't be met.
+func (jc *JobController) failForDependency(runtimeObject runtime.Object, jobStatus *apiv1.JobStatus, jobKind, msg string) {
+	reason := commonutil.NewReason(jobKind, commonutil.JobDependencyFailedReason)
+	jc.Recorder.Event(runtimeObject, corev1.EventTypeWarning, reason, msg)
+	if jobStatus.CompletionTime == nil {
+		now := metav1.Now()
+		jobStatus.CompletionTime = &now
+	}
+	commonutil.UpdateJobConditions(jobStatus, apiv1.JobWaiting, corev1.ConditionFalse, reason, msg)
+	commonutil.UpdateJobConditions(jobStatus, apiv1.JobFailed, corev1.ConditionTrue, reason, msg)
+}
+
+// isWaiting checks whether the job has a true Waiting condition.
+func isWaiting(jobStatus *apiv1.JobStatus) bool {
+	for _, condition := range jobStatus.Conditions {
+		if condition.Type == apiv1.JobWaiting {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// dependenciesMet checks whether the dependencies of the job were met, the job keeps running when
+// its dependencies change later, e.g. when they are deleted.
+func dependenciesMet(jobStatus *apiv1.JobStatus) bool {
+	for _, condition := range jobStatus.Conditions {
+		if condition.Type == apiv1.JobWaiting {
+			return condition.Status == corev1.ConditionFalse
+		}
+	}
+	return false
+}
+
+// WatchDependencies indexes the jobs of the kind by the jobs they depend on, and watches the jobs of
+// all kinds installed in the cluster through the shared cache to reconcile the jobs depending on them.
+func WatchDependencies(mgr manager.Manager, c controller.Controller, kind string) error {
+	job, err := core.NewJob(kind)
+	if err != nil {
+		return err
+	}
+	if err = mgr.GetFieldIndexer().IndexField(context.Background(), job, core.DependsOnIndexKey, core.DependsOnIndexFunc); err != nil {
+		return err
+	}
+	eventHandler := handler.EnqueueRequestsFromMapFunc(DependentJobsMapFunc(mgr.GetClient(), kind))
+	for _, dependencyKind := range core.JobKinds {
+		// Skip the kinds whose CRD is not installed.
+		if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: apiv1.GroupVersion.Group, Kind: dependencyKind},
+			apiv1.GroupVersion.Version); err != nil {
+			continue
+		}
+		dependency, err := core.NewJob(dependencyKind)
+		if err != nil {
+			return err
+		}
+		if err = c.Watch(source.Kind(mgr.GetCache(), dependency), eventHandler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DependentJobsMapFunc returns the jobs of the kind which depend on a job.
+func DependentJobsMapFunc(c client.Reader, kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		jobs, err := core.NewJobList(kind)
+		if err != nil {
+			return nil
+		}
+		if err = c.List(ctx, jobs, client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{core.DependsOnIndexKey: core.DependencyIndexValue(core.JobKind(obj), obj.GetName())}); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		_ = meta.EachListItem(jobs, func(item runtime.Object) error {
+			if job, ok := item.(metav1.Object); ok {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: job.GetNamespace(), Name: job.GetName()},
+				})
+			}
+			return nil
+		})
+		return requests
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// dependencyTestController reconciles PyTorchJobs.
+type dependencyTestController struct {
+	testController
+}
+
+func (dependencyTestController) GetAPIGroupVersionKind() schema.GroupVersionKind {
+	return apiv1.GroupVersion.WithKind(apiv1.PyTorchJobKind)
+}
+
+func newDependencyJob(name string, dependsOn []apiv1.JobDependency, conditions ...apiv1.JobConditionType) *apiv1.PyTorchJob {
+	job := &apiv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	job.Spec.RunPolicy.DependsOn = dependsOn
+	for _, condType := range conditions {
+		commonutil.UpdateJobConditions(&job.Status, condType, corev1.ConditionTrue, "", "")
+	}
+	return job
+}
+
+func newDependencyScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := apiv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the kubeflow scheme: %v", err)
+	}
+	return scheme
+}
+
+func TestWaitForDependencies(T *testing.T) {
+	dependsOnPreprocess := []apiv1.JobDependency{{Kind: apiv1.PyTorchJobKind, Name: "preprocess"}}
+	cases := map[string]struct {
+		dependency  *apiv1.PyTorchJob
+		dependsOn   []apiv1.JobDependency
+		wantWaiting bool
+		wantStatus  corev1.ConditionStatus
+		wantFailed  bool
+	}{
+		"dependency is not created": {
+			dependsOn:   dependsOnPreprocess,
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionTrue,
+		},
+		"dependency is running": {
+			dependency:  newDependencyJob("preprocess", nil, apiv1.JobCreated, apiv1.JobRunning),
+			dependsOn:   dependsOnPreprocess,
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionTrue,
+		},
+		"dependency succeeded": {
+			dependency: newDependencyJob("preprocess", nil, apiv1.JobCreated, apiv1.JobSucceeded),
+			dependsOn:  dependsOnPreprocess,
+			wantStatus: corev1.ConditionFalse,
+		},
+		"dependency is running as expected": {
+			dependency: newDependencyJob("preprocess", nil, apiv1.JobCreated, apiv1.JobRunning),
+			dependsOn:  []apiv1.JobDependency{{Kind: apiv1.PyTorchJobKind, Name: "preprocess", Condition: apiv1.JobRunning}},
+			wantStatus: corev1.ConditionFalse,
+		},
+		"dependency failed": {
+			dependency:  newDependencyJob("preprocess", nil, apiv1.JobCreated, apiv1.JobFailed),
+			dependsOn:   dependsOnPreprocess,
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionFalse,
+			wantFailed:  true,
+		},
+		"dependency cycle": {
+			dependency: newDependencyJob("preprocess",
+				[]apiv1.JobDependency{{Kind: apiv1.PyTorchJobKind, Name: "train"}}, apiv1.JobCreated),
+			dependsOn:   dependsOnPreprocess,
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionFalse,
+			wantFailed:  true,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(newDependencyScheme(t))
+			if tc.dependency != nil {
+				builder = builder.WithObjects(tc.dependency)
+			}
+			jobController := JobController{
+				Controller: dependencyTestController{},
+				Recorder:   record.NewFakeRecorder(10),
+				JobReader:  builder.Build(),
+			}
+			job := newDependencyJob("train", tc.dependsOn, apiv1.JobCreated)
+
+			waiting, err := jobController.waitForDependencies(job, job, &job.Spec.RunPolicy, &job.Status)
+			if err != nil {
+				t.Fatalf("waitForDependencies() error = %v", err)
+			}
+			if waiting != tc.wantWaiting {
+				t.Errorf("Unexpected waiting: \nwant: %v\ngot: %v\n", tc.wantWaiting, waiting)
+			}
+			var gotStatus corev1.ConditionStatus
+			for _, condition := range job.Status.Conditions {
+				if condition.Type == apiv1.JobWaiting {
+					gotStatus = condition.Status
+				}
+			}
+			if gotStatus != tc.wantStatus {
+				t.Errorf("Unexpected Waiting condition: \nwant: %v\ngot: %v\n", tc.wantStatus, gotStatus)
+			}
+			if failed := commonutil.IsFailed(job.Status); failed != tc.wantFailed {
+				t.Errorf("Unexpected failed: \nwant: %v\ngot: %v\n", tc.wantFailed, failed)
+			}
+		})
+	}
+}
+
+func TestWaitForDependenciesMetOnce(t *testing.T) {
+	jobController := JobController{
+		Controller: dependencyTestController{},
+		Recorder:   record.NewFakeRecorder(10),
+		JobReader:  fake.NewClientBuilder().WithScheme(newDependencyScheme(t)).Build(),
+	}
+	job := newDependencyJob("train", []apiv1.JobDependency{{Kind: apiv1.TFJobKind, Name: "preprocess"}}, apiv1.JobCreated)
+	commonutil.UpdateJobConditions(&job.Status, apiv1.JobWaiting, corev1.ConditionFalse, "", "")
+
+	// The dependency was deleted once the job started.
+	waiting, err := jobController.waitForDependencies(job, job, &job.Spec.RunPolicy, &job.Status)
+	if err != nil {
+		t.Fatalf("waitForDependencies() error = %v", err)
+	}
+	if waiting {
+		t.Errorf("Unexpected waiting: \nwant: %v\ngot: %v\n", false, waiting)
+	}
+}
+
+func TestDependentJobsMapFunc(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newDependencyScheme(t)).
+		WithIndex(&apiv1.PyTorchJob{}, core.DependsOnIndexKey, core.DependsOnIndexFunc).
+		WithObjects(
+			newDependencyJob("train", []apiv1.JobDependency{{Kind: apiv1.TFJobKind, Name: "preprocess"}}),
+			newDependencyJob("evaluate", []apiv1.JobDependency{{Kind: apiv1.PyTorchJobKind, Name: "train"}}),
+			newDependencyJob("other", nil),
+		).Build()
+	preprocess := &apiv1.TFJob{ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default"}}
+
+	got := DependentJobsMapFunc(c, apiv1.PyTorchJobKind)(context.Background(), client.Object(preprocess))
+	want := []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "train"}}}
+	if diff := cmp.Diff(want, got); len(diff) != 0 {
+		t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// setFailureDetails records the first failure of the pods in the status of a failed job, and replaces
+// the generic reason of its Failed condition with the reason of the failure, e.g. WorkerOOMKilled.
+// The controllers which know better, e.g. from pods which aren't replicas of the job, set the
+// FailureDetails beforehand.
+func setFailureDetails(jobStatus *apiv1.JobStatus, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, pods []*corev1.Pod) {
+	if !commonutil.IsFailed(*jobStatus) {
+		jobStatus.FailureDetails = nil
+		return
+	}
+	if jobStatus.FailureDetails == nil {
+		jobStatus.FailureDetails = core.GetFailureDetails(pods, replicas)
+		if jobStatus.FailureDetails == nil {
+			return
+		}
+	}
+	reason := core.FailureReason(jobStatus.FailureDetails)
+	for i := range jobStatus.Conditions {
+		condition := &jobStatus.Conditions[i]
+		// The specific reasons, e.g. of the pod failure policies, are kept.
+		if condition.Type == apiv1.JobFailed && condition.Status == corev1.ConditionTrue &&
+			strings.HasSuffix(condition.Reason, commonutil.JobFailedReason) {
+			condition.Reason = reason
+		}
+	}
+}
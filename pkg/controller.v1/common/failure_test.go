@@ -0,0 +1,187 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+func newTerminatedPod(name, rtype string, phase corev1.PodPhase, exitCode int32, reason string, finishedAt int64) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{apiv1.ReplicaTypeLabel: rtype},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "pytorch",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode:   exitCode,
+							Reason:     reason,
+							Message:    reason + " message",
+							FinishedAt: metav1.Unix(finishedAt, 0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSetFailureDetails(T *testing.T) {
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+		"Master": {},
+		"Worker": {},
+	}
+	failedCondition := func(reason string) []apiv1.JobCondition {
+		return []apiv1.JobCondition{{Type: apiv1.JobFailed, Status: corev1.ConditionTrue, Reason: reason}}
+	}
+	evictedPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-worker-2",
+			Labels: map[string]string{apiv1.ReplicaTypeLabel: "worker"},
+		},
+		Status: corev1.PodStatus{
+			Phase:   corev1.PodFailed,
+			Reason:  "Evicted",
+			Message: "The node was low on resource: memory.",
+		},
+	}
+	restartedPod := newTerminatedPod("test-worker-3", "worker", corev1.PodRunning, 0, "", 0)
+	restartedPod.Status.ContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	restartedPod.Status.ContainerStatuses[0].LastTerminationState = corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", FinishedAt: metav1.Unix(5, 0)},
+	}
+
+	cases := map[string]struct {
+		jobStatus   apiv1.JobStatus
+		pods        []*corev1.Pod
+		wantReason  string
+		wantDetails *apiv1.FailureDetails
+	}{
+		"job is not failed": {
+			jobStatus: apiv1.JobStatus{FailureDetails: &apiv1.FailureDetails{Reason: "Error"}},
+			pods:      []*corev1.Pod{newTerminatedPod("test-worker-0", "worker", corev1.PodFailed, 137, "OOMKilled", 10)},
+		},
+		"first container terminated with an error": {
+			jobStatus: apiv1.JobStatus{Conditions: failedCondition("PyTorchJobFailed")},
+			pods: []*corev1.Pod{
+				newTerminatedPod("test-master-0", "master", corev1.PodSucceeded, 0, "Completed", 1),
+				newTerminatedPod("test-worker-1", "worker", corev1.PodFailed, 1, "Error", 20),
+				newTerminatedPod("test-worker-0", "worker", corev1.PodFailed, 137, "OOMKilled", 10),
+			},
+			wantReason: "WorkerOOMKilled",
+			wantDetails: &apiv1.FailureDetails{
+				ReplicaType:   "Worker",
+				PodName:       "test-worker-0",
+				ContainerName: "pytorch",
+				ExitCode:      ptr.To[int32](137),
+				Reason:        "OOMKilled",
+				Message:       "OOMKilled message",
+				FailureTime:   ptr.To(metav1.Unix(10, 0)),
+			},
+		},
+		"container restarted after an error": {
+			jobStatus: apiv1.JobStatus{Conditions: failedCondition("PyTorchJobFailed")},
+			pods: []*corev1.Pod{
+				newTerminatedPod("test-worker-0", "worker", corev1.PodFailed, 137, "OOMKilled", 10),
+				restartedPod,
+			},
+			wantReason: "WorkerError",
+			wantDetails: &apiv1.FailureDetails{
+				ReplicaType:   "Worker",
+				PodName:       "test-worker-3",
+				ContainerName: "pytorch",
+				ExitCode:      ptr.To[int32](1),
+				Reason:        "Error",
+				FailureTime:   ptr.To(metav1.Unix(5, 0)),
+			},
+		},
+		"pod evicted": {
+			jobStatus:  apiv1.JobStatus{Conditions: failedCondition("PyTorchJobFailed")},
+			pods:       []*corev1.Pod{evictedPod},
+			wantReason: "WorkerEvicted",
+			wantDetails: &apiv1.FailureDetails{
+				ReplicaType: "Worker",
+				PodName:     "test-worker-2",
+				Reason:      "Evicted",
+				Message:     "The node was low on resource: memory.",
+			},
+		},
+		"reason of the pod failure policy is kept": {
+			jobStatus:  apiv1.JobStatus{Conditions: failedCondition("PyTorchJobPodFailurePolicy")},
+			pods:       []*corev1.Pod{newTerminatedPod("test-worker-0", "worker", corev1.PodFailed, 42, "Error", 10)},
+			wantReason: "PyTorchJobPodFailurePolicy",
+			wantDetails: &apiv1.FailureDetails{
+				ReplicaType:   "Worker",
+				PodName:       "test-worker-0",
+				ContainerName: "pytorch",
+				ExitCode:      ptr.To[int32](42),
+				Reason:        "Error",
+				Message:       "Error message",
+				FailureTime:   ptr.To(metav1.Unix(10, 0)),
+			},
+		},
+		"deadline exceeded": {
+			jobStatus: apiv1.JobStatus{
+				Conditions:     failedCondition("PyTorchJobFailed"),
+				FailureDetails: &apiv1.FailureDetails{Reason: commonutil.JobDeadlineExceededReason},
+			},
+			pods:        []*corev1.Pod{newTerminatedPod("test-worker-0", "worker", corev1.PodFailed, 137, "OOMKilled", 10)},
+			wantReason:  "DeadlineExceeded",
+			wantDetails: &apiv1.FailureDetails{Reason: commonutil.JobDeadlineExceededReason},
+		},
+		"no failed pods": {
+			jobStatus:  apiv1.JobStatus{Conditions: failedCondition("PyTorchJobFailed")},
+			pods:       []*corev1.Pod{newTerminatedPod("test-master-0", "master", corev1.PodSucceeded, 0, "Completed", 1)},
+			wantReason: "PyTorchJobFailed",
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			setFailureDetails(&tc.jobStatus, replicas, tc.pods)
+			if diff := cmp.Diff(tc.wantDetails, tc.jobStatus.FailureDetails); len(diff) != 0 {
+				t.Errorf("Unexpected failure details (-want,+got):\n%s", diff)
+			}
+			if commonutil.IsFailed(tc.jobStatus) && tc.jobStatus.Conditions[0].Reason != tc.wantReason {
+				t.Errorf("Unexpected reason: \nwant: %v\ngot: %v\n", tc.wantReason, tc.jobStatus.Conditions[0].Reason)
+			}
+		})
+	}
+}
+
+func TestSetFailureDetailsMessageTail(t *testing.T) {
+	pod := newTerminatedPod("test-worker-0", "worker", corev1.PodFailed, 1, "Error", 10)
+	pod.Status.ContainerStatuses[0].State.Terminated.Message = strings.Repeat("a", 2000) + "Traceback"
+	jobStatus := apiv1.JobStatus{Conditions: []apiv1.JobCondition{{Type: apiv1.JobFailed, Status: corev1.ConditionTrue}}}
+
+	setFailureDetails(&jobStatus, nil, []*corev1.Pod{pod})
+	message := jobStatus.FailureDetails.Message
+	if len(message) != 1024 || !strings.HasSuffix(message, "Traceback") {
+		t.Errorf("Unexpected message tail of length %d: %q", len(message), message)
+	}
+}
@@ -0,0 +1,487 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package framework provides a generic reconciler for the job kinds of the training operator.
+// A framework only supplies a Plugin with the accessors of its job type, the cluster spec
+// injection and the success and failure rules, the rest of the controller is shared.
+//
+// MPIJob isn't reconciled by the framework: its launcher is a batch Job instead of a pod of the
+// job, and it manages a ConfigMap, RBAC or SSH resources and an HPA depending on its spec. Its
+// reconciler shares the RunPolicy handling through JobController.ReconcileJobs and the requeue
+// durations through util.DurationUntilRequeue, so both have to be kept in sync with this package.
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/informers"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	"github.com/kubeflow/training-operator/pkg/common/util"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+const (
+	FailedDeleteJobReason     = "FailedDeleteJob"
+	SuccessfulDeleteJobReason = "SuccessfulDeleteJob"
+)
+
+// Info describes the job kind reconciled by a Reconciler.
+type Info struct {
+	// Kind is the kind of the job, e.g. PyTorchJob.
+	Kind string
+	// Singular is the singular name of the job resource, e.g. pytorchjob.
+	Singular string
+	// FrameworkName is the name of the framework reported in the metrics, e.g. pytorch.
+	FrameworkName string
+	// ControllerName is the name of the controller, e.g. pytorchjob-controller.
+	ControllerName string
+	// DefaultContainerName is the name of the container running the training code.
+	DefaultContainerName string
+	// DefaultContainerPortName is the name of the port used for the communication between replicas.
+	DefaultContainerPortName string
+}
+
+// Plugin provides the framework specific parts of a job controller. The plugin is used as the
+// ControllerInterface of the JobController, so it can override any method of the Reconciler.
+// A plugin embedding *Reconciler only needs to implement SetClusterSpec and IsMasterRole of the
+// ControllerInterface besides the methods below.
+type Plugin[J client.Object] interface {
+	trainingoperatorcommon.ControllerInterface
+
+	// NewJob returns an empty job object.
+	NewJob() J
+	// GetReplicaSpecs returns the replica specs of the job.
+	GetReplicaSpecs(job J) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec
+	// GetRunPolicy returns the run policy of the job.
+	GetRunPolicy(job J) *kubeflowv1.RunPolicy
+	// GetJobStatus returns the status of the job.
+	GetJobStatus(job J) *kubeflowv1.JobStatus
+	// ValidateJob validates the spec of the job.
+	ValidateJob(job J) error
+	// UpdateJobConditions updates the conditions of the job status according to the success and
+	// failure rules of the framework.
+	UpdateJobConditions(job J, replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec, jobStatus *kubeflowv1.JobStatus) error
+}
+
+// ResourceReconciler is implemented by plugins managing resources besides the pods and services
+// of the job, e.g. a HorizontalPodAutoscaler. ReconcileResources is called before the pods and
+// services are reconciled.
+type ResourceReconciler[J client.Object] interface {
+	ReconcileResources(job J) error
+}
+
+// Reconciler reconciles the jobs of a framework.
+type Reconciler[J client.Object] struct {
+	common.JobController
+	client.Client
+	Scheme    *runtime.Scheme
+	Log       logr.Logger
+	apiReader client.Reader
+	plugin    Plugin[J]
+	info      Info
+}
+
+// NewReconciler creates a Reconciler for the job kind described by info.
+func NewReconciler[J client.Object](mgr manager.Manager, plugin Plugin[J], info Info,
+	gangSchedulingSetupFunc common.GangSchedulingSetupFunc) *Reconciler[J] {
+	r := &Reconciler[J]{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Log:       log.Log,
+		apiReader: mgr.GetAPIReader(),
+		plugin:    plugin,
+		info:      info,
+	}
+
+	// Create clients
+	cfg := mgr.GetConfig()
+	kubeClientSet := kubeclientset.NewForConfigOrDie(cfg)
+	sharedInformers := informers.NewSharedInformerFactory(kubeClientSet, 0)
+	priorityClassInformer := sharedInformers.Scheduling().V1().PriorityClasses()
+	recorder := mgr.GetEventRecorderFor(info.ControllerName)
+
+	// Initialize common job controller
+	r.JobController = common.JobController{
+		Controller:                  plugin,
+		Expectations:                expectation.NewControllerExpectations(),
+		WorkQueue:                   &util.FakeWorkQueue{},
+		Recorder:                    recorder,
+		KubeClientSet:               kubeClientSet,
+		PriorityClassLister:         priorityClassInformer.Lister(),
+		PriorityClassInformerSynced: priorityClassInformer.Informer().HasSynced,
+		PodControl:                  control.RealPodControl{KubeClient: kubeClientSet, Recorder: recorder, Config: cfg},
+		ServiceControl:              control.RealServiceControl{KubeClient: kubeClientSet, Recorder: recorder},
+		JobReader:                   mgr.GetClient(),
+	}
+
+	gangSchedulingSetupFunc(&r.JobController)
+
+	return r
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *Reconciler[J]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues(r.info.Singular, req.NamespacedName)
+
+	job := r.plugin.NewJob()
+	err := r.Get(ctx, req.NamespacedName, job)
+	if err != nil {
+		logger.Info(err.Error(), "unable to fetch "+r.info.Kind, req.NamespacedName.String())
+		if errors.IsNotFound(err) {
+			trainingoperatorcommon.ActiveJobsGaugeDelete(req.NamespacedName.String(), r.info.FrameworkName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Merge the training runtime before the job is validated, the job may leave the runtime's fields unset.
+	if err = common.ApplyTrainingRuntime(r.Client, r.apiReader, job, r.plugin.GetAPIGroupVersionKind(),
+		r.plugin.GetReplicaSpecs(job), r.plugin.GetRunPolicy(job)); err != nil {
+		logger.Error(err, r.info.Kind+" failed to resolve the training runtime")
+		r.Recorder.Eventf(job, corev1.EventTypeWarning, commonutil.NewReason(r.info.Kind, commonutil.JobFailedRuntimeReason),
+			"%s failed to resolve the training runtime because %s", r.info.Kind, err)
+		return ctrl.Result{}, err
+	}
+	if r.plugin.GetRunPolicy(job).RuntimeRef != nil {
+		// The job isn't defaulted at admission when it references a training runtime.
+		r.Scheme.Default(job)
+	}
+
+	if err = r.plugin.ValidateJob(job); err != nil {
+		logger.Error(err, r.info.Kind+" failed validation")
+		r.Recorder.Eventf(job, corev1.EventTypeWarning, commonutil.NewReason(r.info.Kind, commonutil.JobFailedValidationReason),
+			"%s failed validation because %s", r.info.Kind, err)
+		return ctrl.Result{}, err
+	}
+
+	// Check if reconciliation is needed
+	jobKey, err := common.KeyFunc(job)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get jobKey for job object %#v: %v", job, err))
+	}
+
+	replicaTypes := util.GetReplicaTypes(r.plugin.GetReplicaSpecs(job))
+	needReconcile := util.SatisfiedExpectations(r.Expectations, jobKey, replicaTypes)
+
+	if !needReconcile || job.GetDeletionTimestamp() != nil {
+		logger.Info("reconcile cancelled, job does not need to do reconcile or has been deleted",
+			"sync", needReconcile, "deleted", job.GetDeletionTimestamp() != nil)
+		return ctrl.Result{}, nil
+	}
+
+	// Set default priorities to the job
+	r.Scheme.Default(job)
+
+	if resourceReconciler, ok := r.plugin.(ResourceReconciler[J]); ok {
+		if err = resourceReconciler.ReconcileResources(job); err != nil {
+			logger.Error(err, "Reconcile "+r.info.Kind+" resources error")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Use common to reconcile the job related pod and service
+	runPolicy := r.plugin.GetRunPolicy(job)
+	jobStatus := r.plugin.GetJobStatus(job)
+	err = r.ReconcileJobs(job, r.plugin.GetReplicaSpecs(job), *jobStatus, runPolicy)
+	if err != nil {
+		logger.Error(err, "Reconcile "+r.info.Kind+" error")
+		return ctrl.Result{}, err
+	}
+
+	t, err := util.DurationUntilRequeue(runPolicy, r.plugin.GetReplicaSpecs(job), *jobStatus)
+	if err != nil {
+		logrus.Warnf("Reconcile %s error %v", r.info.Kind, err)
+		return ctrl.Result{}, err
+	}
+	if t >= 0 {
+		return ctrl.Result{Requeue: true, RequeueAfter: t}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler[J]) SetupWithManager(mgr ctrl.Manager, controllerThreads int) error {
+	c, err := controller.New(r.info.ControllerName, mgr, controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: controllerThreads,
+	})
+	if err != nil {
+		return err
+	}
+
+	// using onOwnerCreateFunc is easier to set defaults
+	if err = c.Watch(source.Kind(mgr.GetCache(), r.plugin.NewJob()), &handler.EnqueueRequestForObject{},
+		predicate.Funcs{CreateFunc: r.onOwnerCreateFunc()},
+	); err != nil {
+		return err
+	}
+
+	// eventHandler for owned objects
+	eventHandler := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), r.plugin.NewJob(), handler.OnlyControllerOwner())
+	predicates := predicate.Funcs{
+		CreateFunc: util.OnDependentCreateFunc(r.Expectations),
+		UpdateFunc: util.OnDependentUpdateFunc(&r.JobController),
+		DeleteFunc: util.OnDependentDeleteFunc(r.Expectations),
+	}
+	// Create generic predicates
+	genericPredicates := predicate.Funcs{
+		CreateFunc: util.OnDependentCreateFuncGeneric(r.Expectations),
+		UpdateFunc: util.OnDependentUpdateFuncGeneric(&r.JobController),
+		DeleteFunc: util.OnDependentDeleteFuncGeneric(r.Expectations),
+	}
+	// inject watching for job related pod
+	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Pod{}), eventHandler, predicates); err != nil {
+		return err
+	}
+	// inject watching for job related service
+	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Service{}), eventHandler, predicates); err != nil {
+		return err
+	}
+	// skip watching volcano PodGroup if volcano PodGroup is not installed
+	if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: v1beta1.GroupName, Kind: "PodGroup"},
+		v1beta1.SchemeGroupVersion.Version); err == nil {
+		// inject watching for job related volcano PodGroup
+		if err = c.Watch(source.Kind(mgr.GetCache(), &v1beta1.PodGroup{}), eventHandler, genericPredicates); err != nil {
+			return err
+		}
+	}
+	// skip watching scheduler-plugins PodGroup if scheduler-plugins PodGroup is not installed
+	if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: schedulerpluginsv1alpha1.SchemeGroupVersion.Group, Kind: "PodGroup"},
+		schedulerpluginsv1alpha1.SchemeGroupVersion.Version); err == nil {
+		// inject watching for job related scheduler-plugins PodGroup
+		if err = c.Watch(source.Kind(mgr.GetCache(), &schedulerpluginsv1alpha1.PodGroup{}), eventHandler, genericPredicates); err != nil {
+			return err
+		}
+	}
+	// inject watching for the jobs the jobs depend on
+	return common.WatchDependencies(mgr, c, r.info.Kind)
+}
+
+func (r *Reconciler[J]) ControllerName() string {
+	return r.info.ControllerName
+}
+
+func (r *Reconciler[J]) GetAPIGroupVersionKind() schema.GroupVersionKind {
+	return kubeflowv1.GroupVersion.WithKind(r.info.Kind)
+}
+
+func (r *Reconciler[J]) GetAPIGroupVersion() schema.GroupVersion {
+	return kubeflowv1.GroupVersion
+}
+
+func (r *Reconciler[J]) GetGroupNameLabelValue() string {
+	return kubeflowv1.GroupVersion.Group
+}
+
+func (r *Reconciler[J]) GetFrameworkName() string {
+	return r.info.FrameworkName
+}
+
+func (r *Reconciler[J]) GetDefaultContainerName() string {
+	return r.info.DefaultContainerName
+}
+
+func (r *Reconciler[J]) GetDefaultContainerPortName() string {
+	return r.info.DefaultContainerPortName
+}
+
+func (r *Reconciler[J]) GetJobFromInformerCache(namespace, name string) (metav1.Object, error) {
+	return r.getJob(r.Client, namespace, name)
+}
+
+func (r *Reconciler[J]) GetJobFromAPIClient(namespace, name string) (metav1.Object, error) {
+	return r.getJob(r.apiReader, namespace, name)
+}
+
+func (r *Reconciler[J]) getJob(reader client.Reader, namespace, name string) (metav1.Object, error) {
+	job := r.plugin.NewJob()
+	err := reader.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, job)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logrus.Error(err, r.info.Singular+" not found", "namespace", namespace, "name", name)
+		} else {
+			logrus.Error(err, "failed to get job from api-server", "namespace", namespace, "name", name)
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *Reconciler[J]) GetPodsForJob(obj interface{}) ([]*corev1.Pod, error) {
+	job, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// List all pods to include those that don't match the selector anymore
+	// but have a ControllerRef pointing to this controller.
+	podlist := &corev1.PodList{}
+	err = r.List(context.Background(), podlist, client.MatchingLabels(r.GenLabels(job.GetName())), client.InNamespace(job.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	return util.JobControlledPodList(podlist.Items, job), nil
+}
+
+func (r *Reconciler[J]) GetServicesForJob(obj interface{}) ([]*corev1.Service, error) {
+	job, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceList := &corev1.ServiceList{}
+	err = r.List(context.Background(), serviceList, client.MatchingLabels(r.GenLabels(job.GetName())), client.InNamespace(job.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := util.ConvertServiceList(serviceList.Items)
+	return ret, nil
+}
+
+func (r *Reconciler[J]) DeleteJob(obj interface{}) error {
+	job, ok := obj.(J)
+	if !ok {
+		return fmt.Errorf("%+v is not a type of %s", obj, r.info.Kind)
+	}
+
+	logger := commonutil.LoggerForJob(job)
+	if err := r.Delete(context.Background(), job); err != nil {
+		r.Recorder.Eventf(job, corev1.EventTypeWarning, FailedDeleteJobReason, "Error deleting: %v", err)
+		logger.Errorf("failed to delete job %s/%s, %v", job.GetNamespace(), job.GetName(), err)
+		return err
+	}
+
+	r.Recorder.Eventf(job, corev1.EventTypeNormal, SuccessfulDeleteJobReason, "Deleted job: %v", job.GetName())
+	logger.Infof("job %s/%s has been deleted", job.GetNamespace(), job.GetName())
+	trainingoperatorcommon.DeletedJobsCounterInc(job.GetNamespace(), r.info.FrameworkName)
+	return nil
+}
+
+// GetRunPolicyForJob returns the RunPolicy of the job, the pods are created according to it.
+func (r *Reconciler[J]) GetRunPolicyForJob(obj interface{}) *kubeflowv1.RunPolicy {
+	job, ok := obj.(J)
+	if !ok {
+		return nil
+	}
+	return r.plugin.GetRunPolicy(job)
+}
+
+// GenLabelSelector returns the label selector of the pods of the given replica type.
+func (r *Reconciler[J]) GenLabelSelector(jobName string, rtype kubeflowv1.ReplicaType) *metav1.LabelSelector {
+	labels := r.GenLabels(jobName)
+	labels[kubeflowv1.ReplicaTypeLabel] = strings.ToLower(string(rtype))
+
+	return &metav1.LabelSelector{
+		MatchLabels: labels,
+	}
+}
+
+// UpdateJobStatus updates the job status and job conditions
+func (r *Reconciler[J]) UpdateJobStatus(obj interface{},
+	replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
+	jobStatus *kubeflowv1.JobStatus) error {
+	job, ok := obj.(J)
+	if !ok {
+		return fmt.Errorf("%+v is not a type of %s", obj, r.info.Kind)
+	}
+
+	// Set StartTime. Reconcile requeues the job until it exceeds ActiveDeadlineSeconds.
+	if jobStatus.StartTime == nil {
+		now := metav1.Now()
+		jobStatus.StartTime = &now
+	}
+
+	for rtype := range replicas {
+		if status := jobStatus.ReplicaStatuses[rtype]; status != nil {
+			// Generate the label selector.
+			status.Selector = metav1.FormatLabelSelector(r.GenLabelSelector(job.GetName(), rtype))
+		}
+	}
+
+	// The job may have been failed by a PodFailurePolicy while reconciling its pods.
+	if commonutil.IsFailed(*jobStatus) {
+		return nil
+	}
+	return r.plugin.UpdateJobConditions(job, replicas, jobStatus)
+}
+
+// UpdateJobStatusInApiServer updates the job status in to cluster.
+func (r *Reconciler[J]) UpdateJobStatusInApiServer(obj interface{}, jobStatus *kubeflowv1.JobStatus) error {
+	if jobStatus.ReplicaStatuses == nil {
+		jobStatus.ReplicaStatuses = map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus{}
+	}
+
+	job, ok := obj.(J)
+	if !ok {
+		return fmt.Errorf("%+v is not a type of %s", obj, r.info.Kind)
+	}
+
+	// Job status passed in differs with status in job, update in basis of the passed in one.
+	if !equality.Semantic.DeepEqual(r.plugin.GetJobStatus(job), jobStatus) {
+		job = job.DeepCopyObject().(J)
+		*r.plugin.GetJobStatus(job) = *jobStatus.DeepCopy()
+	}
+
+	return r.Status().Update(context.Background(), job)
+}
+
+// onOwnerCreateFunc modify creation condition.
+func (r *Reconciler[J]) onOwnerCreateFunc() func(event.CreateEvent) bool {
+	return func(e event.CreateEvent) bool {
+		job, ok := e.Object.(J)
+		if !ok {
+			return true
+		}
+		r.Scheme.Default(job)
+		msg := fmt.Sprintf("%s %s is created.", r.info.Kind, e.Object.GetName())
+		logrus.Info(msg)
+		trainingoperatorcommon.CreatedJobsCounterInc(job.GetNamespace(), r.info.FrameworkName)
+		commonutil.UpdateJobConditions(r.plugin.GetJobStatus(job), kubeflowv1.JobCreated, corev1.ConditionTrue,
+			commonutil.NewReason(r.info.Kind, commonutil.JobCreatedReason), msg)
+		return true
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// testPlugin succeeds the job as soon as all replicas succeeded.
+type testPlugin struct {
+	*Reconciler[*kubeflowv1.PyTorchJob]
+}
+
+func (p *testPlugin) NewJob() *kubeflowv1.PyTorchJob {
+	return &kubeflowv1.PyTorchJob{}
+}
+
+func (p *testPlugin) GetReplicaSpecs(job *kubeflowv1.PyTorchJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+	return job.Spec.PyTorchReplicaSpecs
+}
+
+func (p *testPlugin) GetRunPolicy(job *kubeflowv1.PyTorchJob) *kubeflowv1.RunPolicy {
+	return &job.Spec.RunPolicy
+}
+
+func (p *testPlugin) GetJobStatus(job *kubeflowv1.PyTorchJob) *kubeflowv1.JobStatus {
+	return &job.Status
+}
+
+func (p *testPlugin) ValidateJob(job *kubeflowv1.PyTorchJob) error {
+	return nil
+}
+
+func (p *testPlugin) UpdateJobConditions(job *kubeflowv1.PyTorchJob,
+	replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec, jobStatus *kubeflowv1.JobStatus) error {
+	for rtype, spec := range replicas {
+		if jobStatus.ReplicaStatuses[rtype].Succeeded != *spec.Replicas {
+			return nil
+		}
+	}
+	commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobSucceeded, corev1.ConditionTrue, "Succeeded", "")
+	return nil
+}
+
+func (p *testPlugin) SetClusterSpec(job interface{}, podTemplate *corev1.PodTemplateSpec, rtype, index string) error {
+	return nil
+}
+
+func (p *testPlugin) IsMasterRole(replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
+	rtype kubeflowv1.ReplicaType, index int) bool {
+	return rtype == kubeflowv1.PyTorchJobReplicaTypeMaster
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) *testPlugin {
+	scheme := runtime.NewScheme()
+	if err := kubeflowv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	p := &testPlugin{}
+	p.Reconciler = &Reconciler[*kubeflowv1.PyTorchJob]{
+		JobController: common.JobController{
+			Controller: p,
+			Recorder:   record.NewFakeRecorder(10),
+		},
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+			WithStatusSubresource(&kubeflowv1.PyTorchJob{}).Build(),
+		Scheme: scheme,
+		Log:    log.Log,
+		plugin: p,
+		info: Info{
+			Kind:           kubeflowv1.PyTorchJobKind,
+			Singular:       kubeflowv1.PyTorchJobSingular,
+			FrameworkName:  kubeflowv1.PyTorchJobFrameworkName,
+			ControllerName: "pytorchjob-controller",
+		},
+	}
+	return p
+}
+
+func newTestJob() *kubeflowv1.PyTorchJob {
+	return &kubeflowv1.PyTorchJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: kubeflowv1.PyTorchJobSpec{
+			PyTorchReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+				kubeflowv1.PyTorchJobReplicaTypeMaster: {Replicas: ptr.To[int32](1)},
+			},
+		},
+	}
+}
+
+func TestUpdateJobStatus(t *testing.T) {
+	job := newTestJob()
+	r := newTestReconciler(t)
+	jobStatus := &kubeflowv1.JobStatus{
+		ReplicaStatuses: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus{
+			kubeflowv1.PyTorchJobReplicaTypeMaster: {Succeeded: 1},
+		},
+	}
+
+	if err := r.UpdateJobStatus(job, job.Spec.PyTorchReplicaSpecs, jobStatus); err != nil {
+		t.Fatalf("UpdateJobStatus() error = %v", err)
+	}
+	if jobStatus.StartTime == nil {
+		t.Errorf("expected the start time to be set")
+	}
+	if selector := jobStatus.ReplicaStatuses[kubeflowv1.PyTorchJobReplicaTypeMaster].Selector; selector == "" {
+		t.Errorf("expected the replica selector to be set")
+	}
+	if !commonutil.IsSucceeded(*jobStatus) {
+		t.Errorf("expected the plugin to succeed the job, got conditions %v", jobStatus.Conditions)
+	}
+
+	if err := r.UpdateJobStatus(&kubeflowv1.TFJob{}, nil, jobStatus); err == nil {
+		t.Errorf("expected an error for a job of another kind")
+	}
+}
+
+func TestUpdateJobStatusInApiServer(t *testing.T) {
+	job := newTestJob()
+	r := newTestReconciler(t, job)
+	jobStatus := &kubeflowv1.JobStatus{}
+	commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobRunning, corev1.ConditionTrue, "Running", "")
+
+	if err := r.UpdateJobStatusInApiServer(job, jobStatus); err != nil {
+		t.Fatalf("UpdateJobStatusInApiServer() error = %v", err)
+	}
+	if len(job.Status.Conditions) != 0 {
+		t.Errorf("expected the passed in job to be unchanged")
+	}
+	if jobStatus.ReplicaStatuses == nil {
+		t.Errorf("expected the replica statuses to be initialized")
+	}
+
+	got := &kubeflowv1.PyTorchJob{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(job), got); err != nil {
+		t.Fatal(err)
+	}
+	if !commonutil.IsRunning(got.Status) {
+		t.Errorf("expected the status to be updated, got conditions %v", got.Status.Conditions)
+	}
+}
+
+func TestOnOwnerCreateFunc(t *testing.T) {
+	job := newTestJob()
+	r := newTestReconciler(t)
+
+	if !r.onOwnerCreateFunc()(event.CreateEvent{Object: job}) {
+		t.Fatalf("expected the create event to be processed")
+	}
+	if len(job.Status.Conditions) != 1 || job.Status.Conditions[0].Type != kubeflowv1.JobCreated {
+		t.Errorf("expected the created condition to be set, got conditions %v", job.Status.Conditions)
+	}
+	if !r.onOwnerCreateFunc()(event.CreateEvent{Object: &kubeflowv1.TFJob{}}) {
+		t.Errorf("expected the create event of another kind to be processed")
+	}
+}
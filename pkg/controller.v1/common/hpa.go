@@ -0,0 +1,117 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainutil "github.com/kubeflow/training-operator/pkg/util/train"
+)
+
+// HPAPolicy describes the HorizontalPodAutoscaler scaling an elastic job.
+type HPAPolicy struct {
+	MinReplicas *int32
+	MaxReplicas *int32
+	Metrics     []autoscalingv2.MetricSpec
+}
+
+// ReconcileHPA creates or updates the HorizontalPodAutoscaler of the job from the policy, and deletes it while
+// the job is suspended. The HPA isn't reconciled when the policy has no metrics or no maxReplicas.
+func ReconcileHPA(c client.Client, scheme *runtime.Scheme, job client.Object, gvk schema.GroupVersionKind,
+	runPolicy *apiv1.RunPolicy, policy *HPAPolicy) error {
+	logger := log.Log.WithValues(strings.ToLower(gvk.Kind), job.GetName())
+
+	if policy == nil || policy.Metrics == nil || policy.MaxReplicas == nil {
+		logger.V(1).Info(
+			"No ElasticPolicy or Metric is specified, skipping HPA reconciling process")
+		return nil
+	}
+
+	current := &autoscalingv2.HorizontalPodAutoscaler{}
+
+	// Get the expected HPA.
+	expected, err := desiredHPA(job, gvk, policy, scheme)
+	if err != nil {
+		return err
+	}
+
+	err = c.Get(context.TODO(), client.ObjectKeyFromObject(expected), current)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			if trainutil.IsJobSuspended(runPolicy) {
+				// If the job is suspended, it's correct behavior that HPA doesn't exist.
+				return nil
+			}
+			// Create the new HPA.
+			logger.V(1).Info("Creating HPA", "namespace", expected.Namespace, "name", expected.Name)
+			return c.Create(context.TODO(), expected)
+		}
+		return err
+	}
+	if trainutil.IsJobSuspended(runPolicy) {
+		// Delete the current HPA
+		logger.V(1).Info("Deleting HPA", "HorizontalPodAutoscaler", klog.KObj(current))
+		return c.Delete(context.TODO(), current)
+	}
+
+	if !equality.Semantic.DeepEqual(expected.Spec, current.Spec) {
+		logger.V(1).Info("Updating HPA", "namespace", current.Namespace, "name", current.Name)
+		expected.ResourceVersion = current.ResourceVersion
+		err = c.Update(context.TODO(), expected)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func desiredHPA(job client.Object, gvk schema.GroupVersionKind, policy *HPAPolicy, scheme *runtime.Scheme) (
+	*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.GetName(),
+			Namespace: job.GetNamespace(),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			// The type meta of the typed objects read from the client is empty, so the scale target is set from
+			// the kind of the job.
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       gvk.Kind,
+				Name:       job.GetName(),
+				APIVersion: gvk.GroupVersion().String(),
+			},
+			MinReplicas: policy.MinReplicas,
+			MaxReplicas: *policy.MaxReplicas,
+			Metrics:     policy.Metrics,
+		},
+	}
+	if err := controllerruntime.SetControllerReference(job, hpa, scheme); err != nil {
+		return nil, err
+	}
+	return hpa, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func newHPASpec(maxReplicas int32) *autoscalingv2.HorizontalPodAutoscalerSpec {
+	return &autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			Kind:       apiv1.PyTorchJobKind,
+			Name:       "test",
+			APIVersion: apiv1.GroupVersion.String(),
+		},
+		MinReplicas: ptr.To[int32](1),
+		MaxReplicas: maxReplicas,
+		Metrics:     []autoscalingv2.MetricSpec{{Type: autoscalingv2.ResourceMetricSourceType}},
+	}
+}
+
+func TestReconcileHPA(T *testing.T) {
+	policy := &HPAPolicy{
+		MinReplicas: ptr.To[int32](1),
+		MaxReplicas: ptr.To[int32](4),
+		Metrics:     []autoscalingv2.MetricSpec{{Type: autoscalingv2.ResourceMetricSourceType}},
+	}
+	cases := map[string]struct {
+		current  *autoscalingv2.HorizontalPodAutoscalerSpec
+		policy   *HPAPolicy
+		suspend  bool
+		wantSpec *autoscalingv2.HorizontalPodAutoscalerSpec
+	}{
+		"HPA is created": {
+			policy:   policy,
+			wantSpec: newHPASpec(4),
+		},
+		"HPA is updated": {
+			current:  newHPASpec(2),
+			policy:   policy,
+			wantSpec: newHPASpec(4),
+		},
+		"HPA is deleted while the job is suspended": {
+			current: newHPASpec(4),
+			policy:  policy,
+			suspend: true,
+		},
+		"HPA isn't created for a suspended job": {
+			policy:  policy,
+			suspend: true,
+		},
+		"job without metrics": {
+			policy: &HPAPolicy{MaxReplicas: ptr.To[int32](4)},
+		},
+		"job without elastic policy": {},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := apiv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add the kubeflow scheme: %v", err)
+			}
+			if err := autoscalingv2.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add the autoscaling scheme: %v", err)
+			}
+			var objects []client.Object
+			if tc.current != nil {
+				objects = append(objects, &autoscalingv2.HorizontalPodAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Spec:       *tc.current,
+				})
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			job := &apiv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "uid"}}
+			runPolicy := &apiv1.RunPolicy{Suspend: ptr.To(tc.suspend)}
+
+			err := ReconcileHPA(c, scheme, job, apiv1.GroupVersion.WithKind(apiv1.PyTorchJobKind), runPolicy, tc.policy)
+			if err != nil {
+				t.Fatalf("ReconcileHPA() error = %v", err)
+			}
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			err = c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test"}, hpa)
+			if tc.wantSpec == nil {
+				if !errors.IsNotFound(err) {
+					t.Errorf("Unexpected HPA: %v, error: %v", hpa.Spec, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to get the HPA: %v", err)
+			}
+			if diff := cmp.Diff(*tc.wantSpec, hpa.Spec); len(diff) != 0 {
+				t.Errorf("Unexpected HPA spec (-want,+got):\n%s", diff)
+			}
+			if !metav1.IsControlledBy(hpa, job) {
+				t.Errorf("Unexpected owner of the HPA: %v", hpa.OwnerReferences)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
 	"github.com/kubeflow/training-operator/pkg/core"
 	commonutil "github.com/kubeflow/training-operator/pkg/util"
@@ -33,9 +35,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/klog/v2"
-	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
-	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
 // DeletePodsAndServices deletes pods and services considering cleanPodPolicy.
@@ -137,13 +136,21 @@ func (jc *JobController) ReconcileJobs(
 
 		// No need to update the job status if the status hasn't changed since last time.
 		if !reflect.DeepEqual(*oldStatus, jobStatus) {
-			return jc.Controller.UpdateJobStatusInApiServer(job, &jobStatus)
+			return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
 		}
-
+		jc.recordJobMetrics(metaObject, oldStatus, &jobStatus)
 		return nil
 	}
 
 	if trainutil.IsJobSuspended(runPolicy) {
+		if done, err := jc.WaitForCheckpoint(runtimeObject, runPolicy, &jobStatus, pods); err != nil {
+			return err
+		} else if !done {
+			if !reflect.DeepEqual(*oldStatus, jobStatus) {
+				return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
+			}
+			return nil
+		}
 		if err = jc.CleanUpResources(runPolicy, runtimeObject, metaObject, jobStatus, pods); err != nil {
 			return err
 		}
@@ -160,8 +167,9 @@ func (jc *JobController) ReconcileJobs(
 		}
 		jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobSuspendedReason), msg)
 		if !reflect.DeepEqual(*oldStatus, jobStatus) {
-			return jc.Controller.UpdateJobStatusInApiServer(job, &jobStatus)
+			return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
 		}
+		jc.recordJobMetrics(metaObject, oldStatus, &jobStatus)
 		return nil
 	}
 	if commonutil.IsSuspended(jobStatus) {
@@ -172,6 +180,16 @@ func (jc *JobController) ReconcileJobs(
 		jc.Recorder.Eventf(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobResumedReason), msg)
 	}
 
+	// No pods or PodGroup are created while the job waits for the jobs it depends on.
+	if waiting, err := jc.waitForDependencies(metaObject, runtimeObject, runPolicy, &jobStatus); err != nil {
+		return err
+	} else if waiting {
+		if !reflect.DeepEqual(*oldStatus, jobStatus) {
+			return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
+		}
+		return nil
+	}
+
 	// retrieve the previous number of retry
 	previousRetry := jc.WorkQueue.NumRequeues(jobKey)
 
@@ -180,7 +198,7 @@ func (jc *JobController) ReconcileJobs(
 	jc.recordAbnormalPods(activePods, runtimeObject)
 
 	active := int32(len(activePods))
-	failed := k8sutil.FilterPodCount(pods, corev1.PodFailed)
+	failed := k8sutil.FilterPodCount(jc.filterIgnoredPodFailures(pods, replicas), corev1.PodFailed)
 	totalReplicas := k8sutil.GetTotalReplicas(replicas)
 	prevReplicasFailedNum := k8sutil.GetTotalFailedReplicas(jobStatus.ReplicaStatuses)
 
@@ -188,8 +206,12 @@ func (jc *JobController) ReconcileJobs(
 	jobExceedsLimit := false
 	exceedsBackoffLimit := false
 	pastBackoffLimit := false
+	deadlineExceeded := false
 
-	if runPolicy.BackoffLimit != nil {
+	if isJobRestartScope(runPolicy) {
+		// The restarts of the job count towards the BackoffLimit instead of the failures of its pods.
+		pastBackoffLimit = jc.pastJobRestartLimit(runPolicy, replicas, &jobStatus, pods)
+	} else if runPolicy.BackoffLimit != nil {
 		jobHasNewFailure := failed > prevReplicasFailedNum
 		// new failures happen when status does not reflect the failures and active
 		// is different from parallelism, otherwise the previous controller loop
@@ -201,6 +223,10 @@ func (jc *JobController) ReconcileJobs(
 		if err != nil {
 			return err
 		}
+		// The pods restarted by the operator are recreated, their restarts are counted in the status.
+		if !pastBackoffLimit {
+			pastBackoffLimit = jc.pastRestartLimit(runPolicy, replicas, &jobStatus, pods)
+		}
 	}
 
 	if exceedsBackoffLimit || pastBackoffLimit {
@@ -211,9 +237,19 @@ func (jc *JobController) ReconcileJobs(
 	} else if jc.PastActiveDeadline(runPolicy, jobStatus) {
 		failureMessage = fmt.Sprintf("Job %s has failed because it was active longer than specified deadline", jobName)
 		jobExceedsLimit = true
+		deadlineExceeded = true
 	}
 
 	if jobExceedsLimit {
+		if done, err := jc.WaitForCheckpoint(runtimeObject, runPolicy, &jobStatus, pods); err != nil {
+			return err
+		} else if !done {
+			if !reflect.DeepEqual(*oldStatus, jobStatus) {
+				return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
+			}
+			return nil
+		}
+
 		// Set job completion time before resource cleanup
 		if jobStatus.CompletionTime == nil {
 			now := metav1.Now()
@@ -243,19 +279,45 @@ func (jc *JobController) ReconcileJobs(
 		jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobFailedReason), failureMessage)
 
 		commonutil.UpdateJobConditions(&jobStatus, apiv1.JobFailed, corev1.ConditionTrue, commonutil.NewReason(jobKind, commonutil.JobFailedReason), failureMessage)
+		if deadlineExceeded {
+			// The pods were killed by the operator, their failures aren't the cause.
+			jobStatus.FailureDetails = &apiv1.FailureDetails{
+				Reason:  commonutil.JobDeadlineExceededReason,
+				Message: failureMessage,
+			}
+		}
+		setFailureDetails(&jobStatus, replicas, pods)
 
-		return jc.Controller.UpdateJobStatusInApiServer(job, &jobStatus)
+		return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
 	} else {
+		// The job was resumed while waiting for a checkpoint, its pods are kept.
+		if getCheckpointingCondition(jobStatus) != nil {
+			msg := fmt.Sprintf("%s %s is resumed, the checkpoint is not awaited anymore.", jobKind, jobName)
+			commonutil.UpdateJobConditions(&jobStatus, apiv1.JobCheckpointing, corev1.ConditionFalse, commonutil.NewReason(jobKind, commonutil.JobResumedReason), msg)
+		}
+
+		// All the replicas are restarted together with RestartScope Job.
+		if restarting, err := jc.restartJob(metaObject, runtimeObject, runPolicy, replicas, &jobStatus, pods); err != nil {
+			return err
+		} else if restarting {
+			if !reflect.DeepEqual(*oldStatus, jobStatus) {
+				return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
+			}
+			return nil
+		}
+
 		// General cases which need to reconcile
 		if jc.Config.EnableGangScheduling() {
-			minMember := totalReplicas
+			gang := gangReplicas(runPolicy, replicas)
+			gangMembers := k8sutil.GetTotalReplicas(gang)
+			minMember := gangMembers
 			queue := "default"
 			priorityClass := ""
 			var schedulerTimeout *int32
 			var minResources *corev1.ResourceList
 
 			if runPolicy.SchedulingPolicy != nil {
-				if minAvailable := runPolicy.SchedulingPolicy.MinAvailable; minAvailable != nil {
+				if minAvailable := runPolicy.SchedulingPolicy.MinAvailable; minAvailable != nil && *minAvailable < gangMembers {
 					minMember = *minAvailable
 				}
 				if q := runPolicy.SchedulingPolicy.Queue; len(q) != 0 {
@@ -273,38 +335,18 @@ func (jc *JobController) ReconcileJobs(
 			}
 
 			if minResources == nil {
-				minResources = jc.calcPGMinResources(minMember, replicas)
+				minResources = jc.calcPGMinResources(minMember, gang)
 			}
 
-			var pgSpecFill FillPodGroupSpecFunc
-			switch jc.Config.GangScheduling {
-			case GangSchedulerVolcano:
-				pgSpecFill = func(pg metav1.Object) error {
-					volcanoPodGroup, match := pg.(*volcanov1beta1.PodGroup)
-					if !match {
-						return fmt.Errorf("unable to recognize PodGroup: %v", klog.KObj(pg))
-					}
-					volcanoPodGroup.Spec = volcanov1beta1.PodGroupSpec{
-						MinMember:         minMember,
-						Queue:             queue,
-						PriorityClassName: priorityClass,
-						MinResources:      minResources,
-					}
-					return nil
-				}
-			default:
-				pgSpecFill = func(pg metav1.Object) error {
-					schedulerPluginsPodGroup, match := pg.(*schedulerpluginsv1alpha1.PodGroup)
-					if !match {
-						return fmt.Errorf("unable to recognize PodGroup: %v", klog.KObj(pg))
-					}
-					schedulerPluginsPodGroup.Spec = schedulerpluginsv1alpha1.PodGroupSpec{
-						MinMember:              minMember,
-						MinResources:           *minResources,
-						ScheduleTimeoutSeconds: schedulerTimeout,
-					}
-					return nil
-				}
+			pgSpec := control.PodGroupSpec{
+				MinMember:              minMember,
+				Queue:                  queue,
+				PriorityClassName:      priorityClass,
+				MinResources:           minResources,
+				ScheduleTimeoutSeconds: schedulerTimeout,
+			}
+			pgSpecFill := func(pg metav1.Object) error {
+				return jc.PodGroupControl.FillPodGroupSpec(pg, pgSpec)
 			}
 
 			syncReplicas := true
@@ -318,6 +360,9 @@ func (jc *JobController) ReconcileJobs(
 			if jc.PodGroupControl.DelayPodCreationDueToPodGroup(pg) {
 				log.Warnf("PodGroup %v unschedulable", jobKey)
 				syncReplicas = false
+			} else if pg != nil && len(pods) == 0 {
+				trainingoperatorcommon.PodGroupQueueingTimeObserve(jobKey, metaObject.GetNamespace(),
+					jc.Controller.GetFrameworkName(), time.Since(pg.GetCreationTimestamp().Time))
 			}
 
 			if !syncReplicas {
@@ -325,7 +370,7 @@ func (jc *JobController) ReconcileJobs(
 				jobStatus.LastReconcileTime = &now
 
 				// Update job status here to trigger a new reconciliation
-				return jc.Controller.UpdateJobStatusInApiServer(job, &jobStatus)
+				return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
 			}
 		}
 
@@ -351,13 +396,83 @@ func (jc *JobController) ReconcileJobs(
 		log.Warnf("UpdateJobStatus error %v", err)
 		return err
 	}
+	jc.applySuccessPolicy(runtimeObject, metaObject, runPolicy, replicas, &jobStatus, pods)
+	setFailureDetails(&jobStatus, replicas, pods)
 	// No need to update the job status if the status hasn't changed since last time.
 	if !reflect.DeepEqual(*oldStatus, jobStatus) {
-		return jc.Controller.UpdateJobStatusInApiServer(job, &jobStatus)
+		return jc.updateJobStatusInApiServer(job, oldStatus, &jobStatus)
 	}
+	jc.recordJobMetrics(metaObject, oldStatus, &jobStatus)
 	return nil
 }
 
+// updateJobStatusInApiServer updates the job status in the API server and records the lifecycle
+// metrics of the conditions it persisted.
+func (jc *JobController) updateJobStatusInApiServer(job interface{}, oldStatus, jobStatus *apiv1.JobStatus) error {
+	if err := jc.Controller.UpdateJobStatusInApiServer(job, jobStatus); err != nil {
+		return err
+	}
+	if metaObject, ok := job.(metav1.Object); ok {
+		jc.recordJobMetrics(metaObject, oldStatus, jobStatus)
+	}
+	return nil
+}
+
+// recordJobMetrics observes the time to running and to completion when the job has transitioned to
+// these conditions, and moves the job to its latest condition in the active jobs gauge.
+func (jc *JobController) recordJobMetrics(job metav1.Object, oldStatus, jobStatus *apiv1.JobStatus) {
+	jobKey, err := KeyFunc(job)
+	if err != nil {
+		return
+	}
+	namespace := job.GetNamespace()
+	framework := jc.Controller.GetFrameworkName()
+	creationTime := job.GetCreationTimestamp().Time
+
+	// Only the first time the job is running is observed, not the ones after restarts or resumes.
+	if commonutil.IsRunning(*jobStatus) && !hasCondition(*oldStatus, apiv1.JobRunning) &&
+		!hasCondition(*oldStatus, apiv1.JobRestarting) {
+		trainingoperatorcommon.TimeToRunningObserve(namespace, framework, time.Since(creationTime))
+	}
+
+	if commonutil.IsFinished(*jobStatus) {
+		if !commonutil.IsFinished(*oldStatus) {
+			completionTime := time.Now()
+			if jobStatus.CompletionTime != nil {
+				completionTime = jobStatus.CompletionTime.Time
+			}
+			condition := apiv1.JobSucceeded
+			if commonutil.IsFailed(*jobStatus) {
+				condition = apiv1.JobFailed
+			}
+			trainingoperatorcommon.TimeToCompletionObserve(namespace, framework, string(condition), completionTime.Sub(creationTime))
+		}
+		trainingoperatorcommon.ActiveJobsGaugeDelete(jobKey, framework)
+		return
+	}
+	trainingoperatorcommon.ActiveJobsGaugeSet(jobKey, namespace, framework, string(latestCondition(*jobStatus)))
+}
+
+// hasCondition checks if the job has a condition of the given type, regardless of its status.
+func hasCondition(status apiv1.JobStatus, condType apiv1.JobConditionType) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == condType {
+			return true
+		}
+	}
+	return false
+}
+
+// latestCondition returns the type of the latest condition which is true, empty if there is none.
+func latestCondition(status apiv1.JobStatus) apiv1.JobConditionType {
+	for i := len(status.Conditions) - 1; i >= 0; i-- {
+		if status.Conditions[i].Status == corev1.ConditionTrue {
+			return status.Conditions[i].Type
+		}
+	}
+	return ""
+}
+
 func (jc *JobController) CleanUpResources(
 	runPolicy *apiv1.RunPolicy,
 	runtimeObject runtime.Object,
@@ -412,6 +527,21 @@ func (jc *JobController) PastBackoffLimit(jobName string, runPolicy *apiv1.RunPo
 	return core.PastBackoffLimit(jobName, runPolicy, replicas, pods, jc.FilterPodsForReplicaType)
 }
 
+// pastRestartLimit checks if a failed pod must be restarted by the operator while the pods were already
+// restarted BackoffLimit times. The restarts of failures ignored by the PodFailurePolicy aren't counted.
+func (jc *JobController) pastRestartLimit(runPolicy *apiv1.RunPolicy, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec,
+	jobStatus *apiv1.JobStatus, pods []*corev1.Pod) bool {
+	restarts := int32(0)
+	for _, status := range jobStatus.ReplicaStatuses {
+		restarts += status.CountedRestarts
+	}
+	if restarts < *runPolicy.BackoffLimit {
+		return false
+	}
+	pod, _ := jc.podToRestart(replicas, jobStatus, pods)
+	return pod != nil
+}
+
 func (jc *JobController) CleanupJob(runPolicy *apiv1.RunPolicy, jobStatus apiv1.JobStatus, job interface{}) error {
 	currentTime := time.Now()
 	metaObject, _ := job.(metav1.Object)
@@ -72,13 +72,15 @@ type GangScheduler string
 const (
 	GangSchedulerNone    GangScheduler = "None"
 	GangSchedulerVolcano GangScheduler = "volcano"
-	// GangSchedulerSchedulerPlugins Using this scheduler name or any scheduler name different than volcano uses the scheduler-plugins PodGroup
+	// GangSchedulerSchedulerPlugins Using this scheduler name or any scheduler name which is not registered uses the scheduler-plugins PodGroup
 	GangSchedulerSchedulerPlugins GangScheduler = "scheduler-plugins"
+	// GangSchedulerKoordinator uses the scheduler-plugins PodGroup with the koord-scheduler
+	GangSchedulerKoordinator GangScheduler = "koord-scheduler"
 )
 
 // JobControllerConfiguration contains configuration of operator.
 type JobControllerConfiguration struct {
-	// GangScheduling choice: None, volcano and scheduler-plugins
+	// GangScheduling choice: None or the name of a gang scheduler registered with control.RegisterPodGroupBackend
 	GangScheduling GangScheduler
 }
 
@@ -161,6 +163,9 @@ type JobController struct {
 	// Recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
 	Recorder record.EventRecorder
+
+	// JobReader reads the jobs of RunPolicy.DependsOn from the shared cache.
+	JobReader client.Reader
 }
 
 type GangSchedulingSetupFunc func(jc *JobController)
@@ -179,6 +184,14 @@ var GenSchedulerPluginsSetupFunc = func(c client.Client, gangSchedulerName strin
 	}
 }
 
+// GenPodGroupSetupFunc sets up gang scheduling with the PodGroupControl of a registered gang scheduler.
+var GenPodGroupSetupFunc = func(gangSchedulerName string, pgControl control.PodGroupControlInterface) GangSchedulingSetupFunc {
+	return func(jc *JobController) {
+		jc.Config.GangScheduling = GangScheduler(gangSchedulerName)
+		jc.PodGroupControl = pgControl
+	}
+}
+
 var GenNonGangSchedulerSetupFunc = func() GangSchedulingSetupFunc {
 	return func(jc *JobController) {
 		jc.Config.GangScheduling = ""
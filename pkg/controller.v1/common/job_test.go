@@ -219,6 +219,39 @@ func TestPastActiveDeadline(T *testing.T) {
 	}
 }
 
+func TestLatestCondition(T *testing.T) {
+	cases := map[string]struct {
+		conditions []apiv1.JobCondition
+		want       apiv1.JobConditionType
+	}{
+		"no conditions": {
+			want: "",
+		},
+		"running job": {
+			conditions: []apiv1.JobCondition{
+				{Type: apiv1.JobCreated, Status: corev1.ConditionTrue},
+				{Type: apiv1.JobRunning, Status: corev1.ConditionTrue},
+			},
+			want: apiv1.JobRunning,
+		},
+		"resumed job": {
+			conditions: []apiv1.JobCondition{
+				{Type: apiv1.JobCreated, Status: corev1.ConditionTrue},
+				{Type: apiv1.JobRunning, Status: corev1.ConditionFalse},
+				{Type: apiv1.JobSuspended, Status: corev1.ConditionFalse},
+			},
+			want: apiv1.JobCreated,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			if got := latestCondition(apiv1.JobStatus{Conditions: tc.conditions}); got != tc.want {
+				t.Errorf("Unexpected latestCondition: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
+
 func newPod(name string, phase corev1.PodPhase) *corev1.Pod {
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
@@ -292,6 +293,12 @@ func (jc *JobController) ReconcilePods(
 
 	// Convert ReplicaType to lower string.
 	logger := commonutil.LoggerForReplica(metaObject, rt)
+	runPolicy := jc.runPolicyForJob(job)
+	// The pods of the type rt are created once the replicas they depend on started.
+	pendingDependency, err := jc.PendingStartupDependency(runPolicy, rType, pods, replicas)
+	if err != nil {
+		return err
+	}
 	// Get all pods for the type rt.
 	pods, err = jc.FilterPodsForReplicaType(pods, rt)
 	if err != nil {
@@ -313,11 +320,19 @@ func (jc *JobController) ReconcilePods(
 		if len(podSlice) > 1 {
 			logger.Warningf("We have too many pods for %s %d", rt, index)
 		} else if len(podSlice) == 0 {
+			if len(pendingDependency) != 0 {
+				logger.Infof("Waiting to create pod %s-%d until its startup dependencies are met: %s", rt, index, pendingDependency)
+				continue
+			}
+			if next, ok := pendingRestart(jobStatus, rType, spec, time.Now()); ok {
+				logger.Infof("Waiting to create pod %s-%d until the restart backoff expires at %s", rt, index, next.Format(time.RFC3339))
+				continue
+			}
 			logger.Infof("Need to create new pod: %s-%d", rt, index)
 
 			// check if this replica is the master role
 			masterRole = jc.Controller.IsMasterRole(replicas, rType, index)
-			err = jc.createNewPod(job, rt, index, spec, masterRole, replicas)
+			err = jc.createNewPod(job, rt, index, spec, masterRole, replicas, runPolicy, jobStatus.RestartGeneration)
 			if err != nil {
 				return err
 			}
@@ -346,10 +361,24 @@ func (jc *JobController) ReconcilePods(
 				}
 			}
 			// Check if the pod is retryable.
-			if pod.Status.Phase == v1.PodFailed &&
-				(spec.RestartPolicy == apiv1.RestartPolicyExitCode && trainutil.IsRetryableExitCode(exitCode) ||
-					spec.RestartPolicy == apiv1.RestartPolicyOnFailure ||
-					spec.RestartPolicy == apiv1.RestartPolicyAlways) {
+			restart := isRetryablePodFailure(pod, spec, exitCode)
+			// Pods restarted by the PodFailurePolicy are replaced, so they aren't counted as failed.
+			countFailure := true
+			ignored := false
+			if rule, ruleIndex := matchPodFailurePolicy(spec.PodFailurePolicy, pod); rule != nil {
+				msg := podFailurePolicyMessage(pod, rType, rule, ruleIndex)
+				logger.Infof("Pod: %v.%v %s", pod.Namespace, pod.Name, msg)
+				switch rule.Action {
+				case apiv1.PodFailurePolicyActionFailJob:
+					restart = false
+					jc.failJobByPodFailurePolicy(runtimeObject, metaObject, jobStatus, msg)
+				case apiv1.PodFailurePolicyActionRestartPod, apiv1.PodFailurePolicyActionIgnore:
+					restart = true
+					countFailure = false
+					ignored = rule.Action == apiv1.PodFailurePolicyActionIgnore
+				}
+			}
+			if restart {
 				failedPodsCount.Inc()
 				logger.Infof("Need to restart the pod: %v.%v", pod.Namespace, pod.Name)
 				if err := jc.PodControl.DeletePod(pod.Namespace, pod.Name, runtimeObject); err != nil {
@@ -357,23 +386,76 @@ func (jc *JobController) ReconcilePods(
 				}
 				// Deletion is expected
 				jc.Expectations.RaiseExpectations(expectationPodsKey, 0, 1)
+				if pod.DeletionTimestamp == nil {
+					recordReplicaRestart(jobStatus, rType, pod)
+					if !ignored {
+						jobStatus.ReplicaStatuses[rType].CountedRestarts++
+					}
+				}
 
 				msg := fmt.Sprintf("job %s is restarting because %s replica(s) failed.",
 					metaObject.GetName(), rType)
+				if spec.RestartBackoff != nil {
+					if pod.DeletionTimestamp == nil {
+						recordRestartBackoff(jobStatus, rType, spec.RestartBackoff, time.Now())
+					}
+					if next := jobStatus.ReplicaStatuses[rType].NextRestartTime; next != nil {
+						msg = fmt.Sprintf("job %s is restarting because %s replica(s) failed, the next attempt is at %s.",
+							metaObject.GetName(), rType, next.UTC().Format(time.RFC3339))
+					}
+				}
 				jc.Recorder.Event(runtimeObject, v1.EventTypeWarning, commonutil.NewReason(jobKind, commonutil.JobRestartingReason), msg)
 				commonutil.UpdateJobConditions(jobStatus, apiv1.JobRestarting, v1.ConditionTrue, commonutil.NewReason(jobKind, commonutil.JobRestartingReason), msg)
 				trainingoperatorcommon.RestartedJobsCounterInc(metaObject.GetNamespace(), jc.Controller.GetFrameworkName())
 			}
 
-			updateJobReplicaStatuses(jobStatus, rType, pod)
+			if countFailure {
+				updateJobReplicaStatuses(jobStatus, rType, pod)
+			}
 		}
 	}
 	return nil
 }
 
+// runPolicyForJob returns the RunPolicy of the job if the controller implements RunPolicyInterface,
+// an empty RunPolicy otherwise.
+func (jc *JobController) runPolicyForJob(job interface{}) *apiv1.RunPolicy {
+	if c, ok := jc.Controller.(trainingoperatorcommon.RunPolicyInterface); ok {
+		if runPolicy := c.GetRunPolicyForJob(job); runPolicy != nil {
+			return runPolicy
+		}
+	}
+	return &apiv1.RunPolicy{}
+}
+
+// isRetryablePodFailure returns whether the failed pod is restarted according to the restart policy of the replica.
+func isRetryablePodFailure(pod *v1.Pod, spec *apiv1.ReplicaSpec, exitCode int32) bool {
+	return pod.Status.Phase == v1.PodFailed &&
+		(spec.RestartPolicy == apiv1.RestartPolicyExitCode && trainutil.IsRetryableExitCode(exitCode) ||
+			spec.RestartPolicy == apiv1.RestartPolicyOnFailure ||
+			spec.RestartPolicy == apiv1.RestartPolicyAlways)
+}
+
+// failJobByPodFailurePolicy marks the job as failed because a failed pod matched a FailJob rule.
+func (jc *JobController) failJobByPodFailurePolicy(runtimeObject runtime.Object, metaObject metav1.Object,
+	jobStatus *apiv1.JobStatus, msg string) {
+	if commonutil.IsFailed(*jobStatus) {
+		return
+	}
+	jobKind := jc.Controller.GetAPIGroupVersionKind().Kind
+	msg = fmt.Sprintf("%s %s is failed because %s.", jobKind, metaObject.GetName(), msg)
+	jc.Recorder.Event(runtimeObject, v1.EventTypeWarning, commonutil.NewReason(jobKind, commonutil.JobPodFailurePolicyReason), msg)
+	if jobStatus.CompletionTime == nil {
+		now := metav1.Now()
+		jobStatus.CompletionTime = &now
+	}
+	commonutil.UpdateJobConditions(jobStatus, apiv1.JobFailed, v1.ConditionTrue, commonutil.NewReason(jobKind, commonutil.JobPodFailurePolicyReason), msg)
+	trainingoperatorcommon.FailedJobsCounterInc(metaObject.GetNamespace(), jc.Controller.GetFrameworkName())
+}
+
 // createNewPod creates a new pod for the given index and type.
 func (jc *JobController) createNewPod(job interface{}, rt string, index int, spec *apiv1.ReplicaSpec, masterRole bool,
-	replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) error {
+	replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, runPolicy *apiv1.RunPolicy, restartGeneration int32) error {
 
 	metaObject, ok := job.(metav1.Object)
 	if !ok {
@@ -426,6 +508,12 @@ func (jc *JobController) createNewPod(job interface{}, rt string, index int, spe
 	}
 	core.SetRestartPolicy(podTemplate, spec)
 
+	if err := ApplyAdmission(metaObject, rt, podTemplate); err != nil {
+		return err
+	}
+	jc.ApplyTopologyPolicy(runPolicy, metaObject, replicas, rt, podTemplate)
+	ApplyRestartScope(runPolicy, restartGeneration, podTemplate)
+
 	// if gang-scheduling is enabled:
 	// 1. if user has specified other scheduler, we report a warning without overriding any fields.
 	// 2. if no SchedulerName is set for pods, we set the SchedulerName to gang-scheduler-name.
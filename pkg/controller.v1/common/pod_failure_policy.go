@@ -0,0 +1,116 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// matchPodFailurePolicy returns the first rule of the policy which matches the failed pod
+// and its index, or nil if no rule matches.
+func matchPodFailurePolicy(policy *apiv1.PodFailurePolicy, pod *v1.Pod) (*apiv1.PodFailurePolicyRule, int) {
+	if policy == nil || pod.Status.Phase != v1.PodFailed {
+		return nil, -1
+	}
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if rule.OnExitCodes != nil && matchOnExitCodes(rule.OnExitCodes, pod) {
+			return rule, i
+		}
+		if len(rule.OnPodConditions) > 0 && matchOnPodConditions(rule.OnPodConditions, pod) {
+			return rule, i
+		}
+	}
+	return nil, -1
+}
+
+func matchOnExitCodes(requirement *apiv1.PodFailurePolicyOnExitCodesRequirement, pod *v1.Pod) bool {
+	statuses := append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if requirement.ContainerName != nil && *requirement.ContainerName != status.Name {
+			continue
+		}
+		if status.State.Terminated == nil {
+			continue
+		}
+		exitCode := status.State.Terminated.ExitCode
+		contains := false
+		for _, value := range requirement.Values {
+			if value == exitCode {
+				contains = true
+				break
+			}
+		}
+		switch requirement.Operator {
+		case apiv1.PodFailurePolicyOnExitCodesOpIn:
+			if contains {
+				return true
+			}
+		case apiv1.PodFailurePolicyOnExitCodesOpNotIn:
+			if !contains && exitCode != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchOnPodConditions(patterns []apiv1.PodFailurePolicyOnPodConditionsPattern, pod *v1.Pod) bool {
+	for _, pattern := range patterns {
+		status := pattern.Status
+		if status == "" {
+			status = v1.ConditionTrue
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == pattern.Type && condition.Status == status {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterIgnoredPodFailures returns the pods without the failed pods whose failure is ignored by the
+// PodFailurePolicy of their replica, since those don't count towards the BackoffLimit.
+func (jc *JobController) filterIgnoredPodFailures(pods []*v1.Pod, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) []*v1.Pod {
+	result := make([]*v1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		ignored := false
+		for rtype, spec := range replicas {
+			if spec.PodFailurePolicy == nil || pod.Labels[apiv1.ReplicaTypeLabel] != strings.ToLower(string(rtype)) {
+				continue
+			}
+			if rule, _ := matchPodFailurePolicy(spec.PodFailurePolicy, pod); rule != nil && rule.Action == apiv1.PodFailurePolicyActionIgnore {
+				ignored = true
+			}
+		}
+		if !ignored {
+			result = append(result, pod)
+		}
+	}
+	return result
+}
+
+// podFailurePolicyMessage describes the rule which matched the failed pod.
+func podFailurePolicyMessage(pod *v1.Pod, rType apiv1.ReplicaType, rule *apiv1.PodFailurePolicyRule, index int) string {
+	return fmt.Sprintf("pod %s of %s replica failed and matched rule %d of the pod failure policy with the action %s",
+		pod.Name, rType, index, rule.Action)
+}
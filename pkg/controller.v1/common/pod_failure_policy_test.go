@@ -0,0 +1,282 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+var testPodFailurePolicy = &apiv1.PodFailurePolicy{
+	Rules: []apiv1.PodFailurePolicyRule{
+		{
+			Action:          apiv1.PodFailurePolicyActionIgnore,
+			OnPodConditions: []apiv1.PodFailurePolicyOnPodConditionsPattern{{Type: corev1.DisruptionTarget}},
+		},
+		{
+			Action: apiv1.PodFailurePolicyActionRestartPod,
+			OnExitCodes: &apiv1.PodFailurePolicyOnExitCodesRequirement{
+				ContainerName: ptr.To("test-container"),
+				Operator:      apiv1.PodFailurePolicyOnExitCodesOpIn,
+				Values:        []int32{134, 137},
+			},
+		},
+		{
+			Action: apiv1.PodFailurePolicyActionFailJob,
+			OnExitCodes: &apiv1.PodFailurePolicyOnExitCodesRequirement{
+				Operator: apiv1.PodFailurePolicyOnExitCodesOpNotIn,
+				Values:   []int32{3},
+			},
+		},
+	},
+}
+
+func newFailedPod(name string, exitCodes map[string]int32, conditions ...corev1.PodConditionType) *corev1.Pod {
+	pod := newPod(name, corev1.PodFailed)
+	pod.Labels[apiv1.ReplicaIndexLabel] = "0"
+	for container, exitCode := range exitCodes {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:  container,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode}},
+		})
+	}
+	for _, condition := range conditions {
+		pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{Type: condition, Status: corev1.ConditionTrue})
+	}
+	return pod
+}
+
+func TestMatchPodFailurePolicy(T *testing.T) {
+	cases := map[string]struct {
+		pod       *corev1.Pod
+		wantIndex int
+	}{
+		"pod is evicted": {
+			pod:       newFailedPod("pod", map[string]int32{"test-container": 137}, corev1.DisruptionTarget),
+			wantIndex: 0,
+		},
+		"container is OOM killed": {
+			pod:       newFailedPod("pod", map[string]int32{"test-container": 137}),
+			wantIndex: 1,
+		},
+		"exit code of another container": {
+			pod:       newFailedPod("pod", map[string]int32{"sidecar": 137}),
+			wantIndex: 2,
+		},
+		"exit code is not in the values": {
+			pod:       newFailedPod("pod", map[string]int32{"test-container": 1}),
+			wantIndex: 2,
+		},
+		"no rule matches": {
+			pod:       newFailedPod("pod", map[string]int32{"test-container": 3, "sidecar": 0}),
+			wantIndex: -1,
+		},
+		"pod is not failed": {
+			pod:       newPod("pod", corev1.PodRunning),
+			wantIndex: -1,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			rule, index := matchPodFailurePolicy(testPodFailurePolicy, tc.pod)
+			if index != tc.wantIndex {
+				t.Errorf("Unexpected rule index: \nwant: %v\ngot: %v\n", tc.wantIndex, index)
+			}
+			if (rule == nil) != (tc.wantIndex == -1) {
+				t.Errorf("Unexpected rule: %v", rule)
+			}
+		})
+	}
+}
+
+func TestReconcilePodsWithPodFailurePolicy(T *testing.T) {
+	cases := map[string]struct {
+		pod                 *corev1.Pod
+		wantDeleted         bool
+		wantCountedRestarts int32
+		wantFailed          int32
+		wantJobFail         bool
+	}{
+		"ignored failure": {
+			pod:         newFailedPod("pod", nil, corev1.DisruptionTarget),
+			wantDeleted: true,
+		},
+		"restarted pod": {
+			pod:                 newFailedPod("pod", map[string]int32{"test-container": 137}),
+			wantDeleted:         true,
+			wantCountedRestarts: 1,
+		},
+		"failed job": {
+			pod:         newFailedPod("pod", map[string]int32{"test-container": 1}),
+			wantFailed:  1,
+			wantJobFail: true,
+		},
+		"counted failure": {
+			pod:        newFailedPod("pod", map[string]int32{"test-container": 3}),
+			wantFailed: 1,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			podControl := &control.FakePodControl{}
+			jobController := JobController{
+				Controller:   testController{},
+				PodControl:   podControl,
+				Expectations: expectation.NewControllerExpectations(),
+				Recorder:     record.NewFakeRecorder(10),
+			}
+			job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			spec := &apiv1.ReplicaSpec{
+				Replicas:         ptr.To[int32](1),
+				RestartPolicy:    apiv1.RestartPolicyNever,
+				PodFailurePolicy: testPodFailurePolicy,
+			}
+			jobStatus := &apiv1.JobStatus{}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"test": spec}
+
+			if err := jobController.ReconcilePods(job, jobStatus, []*corev1.Pod{tc.pod}, "test", spec, replicas); err != nil {
+				t.Fatalf("ReconcilePods() error = %v", err)
+			}
+			if deleted := len(podControl.DeletePodName) == 1; deleted != tc.wantDeleted {
+				t.Errorf("Unexpected pod deletion: \nwant: %v\ngot: %v\n", tc.wantDeleted, deleted)
+			}
+			if restarts := jobStatus.ReplicaStatuses["test"].CountedRestarts; restarts != tc.wantCountedRestarts {
+				t.Errorf("Unexpected counted restarts: \nwant: %v\ngot: %v\n", tc.wantCountedRestarts, restarts)
+			}
+			if failed := jobStatus.ReplicaStatuses["test"].Failed; failed != tc.wantFailed {
+				t.Errorf("Unexpected failed replicas: \nwant: %v\ngot: %v\n", tc.wantFailed, failed)
+			}
+			if failed := commonutil.IsFailed(*jobStatus); failed != tc.wantJobFail {
+				t.Errorf("Unexpected job failure: \nwant: %v\ngot: %v\n", tc.wantJobFail, failed)
+			}
+		})
+	}
+}
+
+func TestFilterIgnoredPodFailures(T *testing.T) {
+	jobController := JobController{}
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+		"test": {PodFailurePolicy: testPodFailurePolicy},
+	}
+	pods := []*corev1.Pod{
+		newFailedPod("evicted", nil, corev1.DisruptionTarget),
+		newFailedPod("oom-killed", map[string]int32{"test-container": 137}),
+		newPod("running", corev1.PodRunning),
+	}
+	got := jobController.filterIgnoredPodFailures(pods, replicas)
+	if len(got) != 2 || got[0].Name != "oom-killed" || got[1].Name != "running" {
+		T.Errorf("Unexpected pods: %v", got)
+	}
+}
+
+// backoffTestController runs ReconcileJobs on the given pods and keeps the job status it updates.
+type backoffTestController struct {
+	startupTestController
+	jc     *JobController
+	pods   []*corev1.Pod
+	status apiv1.JobStatus
+}
+
+func (c *backoffTestController) GetPodsForJob(interface{}) ([]*corev1.Pod, error) {
+	return c.pods, nil
+}
+
+func (c *backoffTestController) GetServicesForJob(interface{}) ([]*corev1.Service, error) {
+	return nil, nil
+}
+
+func (c *backoffTestController) ReconcilePods(job interface{}, jobStatus *apiv1.JobStatus, pods []*corev1.Pod, rtype apiv1.ReplicaType,
+	spec *apiv1.ReplicaSpec, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) error {
+	return c.jc.ReconcilePods(job, jobStatus, pods, rtype, spec, replicas)
+}
+
+func (c *backoffTestController) ReconcileServices(metav1.Object, []*corev1.Service, apiv1.ReplicaType, *apiv1.ReplicaSpec) error {
+	return nil
+}
+
+func (c *backoffTestController) UpdateJobStatus(interface{}, map[apiv1.ReplicaType]*apiv1.ReplicaSpec, *apiv1.JobStatus) error {
+	return nil
+}
+
+func (c *backoffTestController) UpdateJobStatusInApiServer(_ interface{}, jobStatus *apiv1.JobStatus) error {
+	c.status = *jobStatus.DeepCopy()
+	return nil
+}
+
+func TestReconcileJobsWithPodFailurePolicyBackoffLimit(T *testing.T) {
+	cases := map[string]struct {
+		pod          func() *corev1.Pod
+		wantFailedAt int
+	}{
+		"restarted pods reach the backoff limit": {
+			pod:          func() *corev1.Pod { return newFailedPod("pod", map[string]int32{"test-container": 137}) },
+			wantFailedAt: 3,
+		},
+		"ignored failures aren't counted": {
+			pod:          func() *corev1.Pod { return newFailedPod("pod", nil, corev1.DisruptionTarget) },
+			wantFailedAt: -1,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			controller := &backoffTestController{}
+			jobController := &JobController{
+				Controller:     controller,
+				PodControl:     &control.FakePodControl{},
+				ServiceControl: &control.FakeServiceControl{},
+				Expectations:   expectation.NewControllerExpectations(),
+				Recorder:       record.NewFakeRecorder(100),
+				WorkQueue:      workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+			}
+			controller.jc = jobController
+			job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"test": {
+				Replicas:         ptr.To[int32](1),
+				RestartPolicy:    apiv1.RestartPolicyNever,
+				PodFailurePolicy: testPodFailurePolicy,
+			}}
+			runPolicy := &apiv1.RunPolicy{
+				BackoffLimit:   ptr.To[int32](2),
+				CleanPodPolicy: ptr.To(apiv1.CleanPodPolicyAll),
+			}
+
+			// Every recreated pod fails again.
+			failedAt := -1
+			for i := 1; i <= 5 && failedAt < 0; i++ {
+				controller.pods = []*corev1.Pod{tc.pod()}
+				if err := jobController.ReconcileJobs(job, replicas, controller.status, runPolicy); err != nil {
+					t.Fatalf("ReconcileJobs() error = %v", err)
+				}
+				if commonutil.IsFailed(controller.status) {
+					failedAt = i
+				}
+			}
+			if failedAt != tc.wantFailedAt {
+				t.Errorf("Unexpected failed reconcile: \nwant: %v\ngot: %v\n", tc.wantFailedAt, failedAt)
+			}
+		})
+	}
+}
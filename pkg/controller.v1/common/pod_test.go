@@ -54,6 +54,13 @@ func TestSetRestartPolicy(t *testing.T) {
 			},
 			expectedRestartPolicy: v1.RestartPolicyOnFailure,
 		},
+		"podFailurePolicy is set": {
+			replicaSpec: &apiv1.ReplicaSpec{
+				RestartPolicy:    apiv1.RestartPolicyOnFailure,
+				PodFailurePolicy: &apiv1.PodFailurePolicy{},
+			},
+			expectedRestartPolicy: v1.RestartPolicyNever,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,66 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// GetAdmission returns the admission the queueing system assigned to the replicas of the replica type,
+// or nil if the job has no AdmissionAnnotation or the annotation has no entry for the replica type.
+func GetAdmission(job metav1.Object, rtype string) (*apiv1.ReplicaAdmission, error) {
+	value, ok := job.GetAnnotations()[apiv1.AdmissionAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	admissions := map[apiv1.ReplicaType]apiv1.ReplicaAdmission{}
+	if err := json.Unmarshal([]byte(value), &admissions); err != nil {
+		return nil, fmt.Errorf("failed to parse the annotation %s: %v", apiv1.AdmissionAnnotation, err)
+	}
+	for t, admission := range admissions {
+		if strings.EqualFold(string(t), rtype) {
+			return &admission, nil
+		}
+	}
+	return nil, nil
+}
+
+// ApplyAdmission adds the node selector and tolerations the queueing system assigned to the
+// replica type on admission to the pod template. Only the pods get the scheduling constraints of
+// the admission, so they are gone, and the templates of the job restored, once a re-suspended job
+// deleted its pods.
+func ApplyAdmission(job metav1.Object, rtype string, podTemplate *v1.PodTemplateSpec) error {
+	admission, err := GetAdmission(job, rtype)
+	if err != nil || admission == nil {
+		return err
+	}
+	if len(admission.NodeSelector) > 0 {
+		if podTemplate.Spec.NodeSelector == nil {
+			podTemplate.Spec.NodeSelector = make(map[string]string, len(admission.NodeSelector))
+		}
+		for key, value := range admission.NodeSelector {
+			podTemplate.Spec.NodeSelector[key] = value
+		}
+	}
+	podTemplate.Spec.Tolerations = append(podTemplate.Spec.Tolerations, admission.Tolerations...)
+	return nil
+}
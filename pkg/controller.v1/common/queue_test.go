@@ -0,0 +1,87 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+func TestApplyAdmission(T *testing.T) {
+	gpuToleration := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}
+	userToleration := corev1.Toleration{Key: "user", Operator: corev1.TolerationOpExists}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		rtype       string
+		template    corev1.PodSpec
+		want        corev1.PodSpec
+		wantErr     bool
+	}{
+		"no admission": {
+			rtype:    "worker",
+			template: corev1.PodSpec{NodeSelector: map[string]string{"zone": "a"}},
+			want:     corev1.PodSpec{NodeSelector: map[string]string{"zone": "a"}},
+		},
+		"admission of the replica type": {
+			annotations: map[string]string{
+				apiv1.AdmissionAnnotation: `{"Worker":{"nodeSelector":{"flavor":"gpu","zone":"b"},"tolerations":[{"key":"nvidia.com/gpu","operator":"Exists"}]}}`,
+			},
+			rtype: "worker",
+			template: corev1.PodSpec{
+				NodeSelector: map[string]string{"zone": "a"},
+				Tolerations:  []corev1.Toleration{userToleration},
+			},
+			want: corev1.PodSpec{
+				NodeSelector: map[string]string{"flavor": "gpu", "zone": "b"},
+				Tolerations:  []corev1.Toleration{userToleration, gpuToleration},
+			},
+		},
+		"admission of another replica type": {
+			annotations: map[string]string{
+				apiv1.AdmissionAnnotation: `{"Worker":{"nodeSelector":{"flavor":"gpu"}}}`,
+			},
+			rtype: "master",
+			want:  corev1.PodSpec{},
+		},
+		"invalid admission": {
+			annotations: map[string]string{apiv1.AdmissionAnnotation: "invalid"},
+			rtype:       "worker",
+			wantErr:     true,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: tc.annotations}}
+			template := &corev1.PodTemplateSpec{Spec: tc.template}
+			err := ApplyAdmission(job, tc.rtype, template)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ApplyAdmission() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, template.Spec); diff != "" {
+				t.Errorf("Unexpected pod spec (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
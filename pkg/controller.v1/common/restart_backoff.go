@@ -0,0 +1,89 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// recordRestartBackoff delays the recreation of the pods of the replica type restarted at now,
+// unless a delayed restart is already pending.
+func recordRestartBackoff(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaType, backoff *apiv1.RestartBackoff, now time.Time) {
+	status := jobStatus.ReplicaStatuses[rtype]
+	if next := status.NextRestartTime; next != nil {
+		if now.Before(next.Time) {
+			return
+		}
+		// The pods recreated at the last restart ran long enough, the failure is not consecutive.
+		if !now.Before(next.Add(restartBackoffSeconds(backoff.ResetAfterSeconds, apiv1.DefaultRestartBackoffResetAfterSeconds))) {
+			status.BackoffRestarts = 0
+		}
+	}
+	next := metav1.NewTime(now.Add(restartBackoffDelay(backoff, status.BackoffRestarts)))
+	status.BackoffRestarts++
+	status.NextRestartTime = &next
+}
+
+// restartBackoffDelay returns the delay of a restart following the given number of consecutive restarts,
+// BaseSeconds doubled on every restart up to MaxSeconds.
+func restartBackoffDelay(backoff *apiv1.RestartBackoff, restarts int32) time.Duration {
+	delay := restartBackoffSeconds(backoff.BaseSeconds, apiv1.DefaultRestartBackoffBaseSeconds)
+	maxDelay := restartBackoffSeconds(backoff.MaxSeconds, apiv1.DefaultRestartBackoffMaxSeconds)
+	for i := int32(0); i < restarts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
+
+func restartBackoffSeconds(seconds *int32, defaultSeconds int32) time.Duration {
+	if seconds != nil {
+		return time.Duration(*seconds) * time.Second
+	}
+	return time.Duration(defaultSeconds) * time.Second
+}
+
+// pendingRestart returns the time the restarted pods of the replica type are recreated at, if it is after now.
+func pendingRestart(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaType, spec *apiv1.ReplicaSpec, now time.Time) (time.Time, bool) {
+	if spec.RestartBackoff == nil {
+		return time.Time{}, false
+	}
+	status := jobStatus.ReplicaStatuses[rtype]
+	if status == nil || status.NextRestartTime == nil || !now.Before(status.NextRestartTime.Time) {
+		return time.Time{}, false
+	}
+	return status.NextRestartTime.Time, true
+}
+
+// DurationUntilNextRestart returns the time left until the restarted pods of a replica type are recreated,
+// or -1 if no restart is delayed by a RestartBackoff.
+func DurationUntilNextRestart(replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, jobStatus apiv1.JobStatus) time.Duration {
+	remaining := time.Duration(-1)
+	now := time.Now()
+	for rtype, spec := range replicas {
+		if next, ok := pendingRestart(&jobStatus, rtype, spec, now); ok {
+			if d := next.Sub(now); remaining < 0 || d < remaining {
+				remaining = d
+			}
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,233 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+func TestRestartBackoffDelay(T *testing.T) {
+	cases := map[string]struct {
+		backoff  *apiv1.RestartBackoff
+		restarts int32
+		want     time.Duration
+	}{
+		"first restart": {
+			backoff:  &apiv1.RestartBackoff{},
+			restarts: 0,
+			want:     10 * time.Second,
+		},
+		"doubled delay": {
+			backoff:  &apiv1.RestartBackoff{},
+			restarts: 3,
+			want:     80 * time.Second,
+		},
+		"maximum delay": {
+			backoff:  &apiv1.RestartBackoff{},
+			restarts: 10,
+			want:     300 * time.Second,
+		},
+		"custom delays": {
+			backoff:  &apiv1.RestartBackoff{BaseSeconds: ptr.To[int32](3), MaxSeconds: ptr.To[int32](20)},
+			restarts: 2,
+			want:     12 * time.Second,
+		},
+		"many restarts": {
+			backoff:  &apiv1.RestartBackoff{BaseSeconds: ptr.To[int32](1), MaxSeconds: ptr.To[int32](20)},
+			restarts: 1000,
+			want:     20 * time.Second,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			if got := restartBackoffDelay(tc.backoff, tc.restarts); got != tc.want {
+				t.Errorf("Unexpected delay: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordRestartBackoff(T *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(d))
+		return &t
+	}
+	cases := map[string]struct {
+		status       apiv1.ReplicaStatus
+		wantRestarts int32
+		wantNext     *metav1.Time
+	}{
+		"first restart": {
+			status:       apiv1.ReplicaStatus{},
+			wantRestarts: 1,
+			wantNext:     at(10 * time.Second),
+		},
+		"consecutive restart": {
+			status:       apiv1.ReplicaStatus{BackoffRestarts: 2, NextRestartTime: at(-time.Minute)},
+			wantRestarts: 3,
+			wantNext:     at(40 * time.Second),
+		},
+		"pending restart": {
+			status:       apiv1.ReplicaStatus{BackoffRestarts: 2, NextRestartTime: at(time.Second)},
+			wantRestarts: 2,
+			wantNext:     at(time.Second),
+		},
+		"reset after running long enough": {
+			status:       apiv1.ReplicaStatus{BackoffRestarts: 5, NextRestartTime: at(-10 * time.Minute)},
+			wantRestarts: 1,
+			wantNext:     at(10 * time.Second),
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			jobStatus := &apiv1.JobStatus{
+				ReplicaStatuses: map[apiv1.ReplicaType]*apiv1.ReplicaStatus{"worker": &tc.status},
+			}
+			recordRestartBackoff(jobStatus, "worker", &apiv1.RestartBackoff{}, now)
+
+			got := jobStatus.ReplicaStatuses["worker"]
+			if got.BackoffRestarts != tc.wantRestarts {
+				t.Errorf("Unexpected backoff restarts: \nwant: %v\ngot: %v\n", tc.wantRestarts, got.BackoffRestarts)
+			}
+			if !got.NextRestartTime.Equal(tc.wantNext) {
+				t.Errorf("Unexpected next restart time: \nwant: %v\ngot: %v\n", tc.wantNext, got.NextRestartTime)
+			}
+		})
+	}
+}
+
+func TestReconcilePodsWithRestartBackoff(T *testing.T) {
+	pending := metav1.NewTime(time.Now().Add(time.Minute))
+	expired := metav1.NewTime(time.Now().Add(-time.Second))
+	cases := map[string]struct {
+		pods           []*corev1.Pod
+		nextRestart    *metav1.Time
+		wantDeleted    int
+		wantCreated    int
+		wantRestarting bool
+	}{
+		"failed pod": {
+			pods:           []*corev1.Pod{newFailedPod("pod", map[string]int32{"test-container": 1})},
+			wantDeleted:    1,
+			wantRestarting: true,
+		},
+		"restart is pending": {
+			nextRestart: &pending,
+			wantCreated: 0,
+		},
+		"backoff expired": {
+			nextRestart: &expired,
+			wantCreated: 1,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			podControl := &control.FakePodControl{}
+			jobController := JobController{
+				Controller:   startupTestController{},
+				PodControl:   podControl,
+				Expectations: expectation.NewControllerExpectations(),
+				Recorder:     record.NewFakeRecorder(10),
+			}
+			job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			spec := &apiv1.ReplicaSpec{
+				Replicas:       ptr.To[int32](1),
+				RestartPolicy:  apiv1.RestartPolicyOnFailure,
+				RestartBackoff: &apiv1.RestartBackoff{},
+			}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"test": spec}
+			jobStatus := &apiv1.JobStatus{
+				ReplicaStatuses: map[apiv1.ReplicaType]*apiv1.ReplicaStatus{
+					"test": {BackoffRestarts: 1, NextRestartTime: tc.nextRestart},
+				},
+			}
+
+			if err := jobController.ReconcilePods(job, jobStatus, tc.pods, "test", spec, replicas); err != nil {
+				t.Fatalf("ReconcilePods() error = %v", err)
+			}
+			if deleted := len(podControl.DeletePodName); deleted != tc.wantDeleted {
+				t.Errorf("Unexpected deleted pods: \nwant: %v\ngot: %v\n", tc.wantDeleted, deleted)
+			}
+			if created := len(podControl.Templates); created != tc.wantCreated {
+				t.Errorf("Unexpected created pods: \nwant: %v\ngot: %v\n", tc.wantCreated, created)
+			}
+			if !tc.wantRestarting {
+				return
+			}
+			next := jobStatus.ReplicaStatuses["test"].NextRestartTime
+			if next == nil || next.Before(ptr.To(metav1.Now())) {
+				t.Fatalf("Unexpected next restart time: %v", next)
+			}
+			if d := DurationUntilNextRestart(replicas, *jobStatus); d <= 0 || d > 20*time.Second {
+				t.Errorf("Unexpected duration until the next restart: %v", d)
+			}
+			var restarting *apiv1.JobCondition
+			for i := range jobStatus.Conditions {
+				if jobStatus.Conditions[i].Type == apiv1.JobRestarting {
+					restarting = &jobStatus.Conditions[i]
+				}
+			}
+			if restarting == nil || !strings.Contains(restarting.Message, next.UTC().Format(time.RFC3339)) {
+				t.Errorf("Unexpected Restarting condition: %v", restarting)
+			}
+		})
+	}
+}
+
+func TestDurationUntilNextRestart(T *testing.T) {
+	next := metav1.NewTime(time.Now().Add(time.Minute))
+	cases := map[string]struct {
+		backoff *apiv1.RestartBackoff
+		next    *metav1.Time
+		wantSet bool
+	}{
+		"no backoff": {
+			next: &next,
+		},
+		"no restart": {
+			backoff: &apiv1.RestartBackoff{},
+		},
+		"pending restart": {
+			backoff: &apiv1.RestartBackoff{},
+			next:    &next,
+			wantSet: true,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"worker": {RestartBackoff: tc.backoff}}
+			jobStatus := apiv1.JobStatus{
+				ReplicaStatuses: map[apiv1.ReplicaType]*apiv1.ReplicaStatus{"worker": {NextRestartTime: tc.next}},
+			}
+			got := DurationUntilNextRestart(replicas, jobStatus)
+			if set := got >= 0; set != tc.wantSet {
+				t.Errorf("Unexpected duration until the next restart: %v", got)
+			}
+		})
+	}
+}
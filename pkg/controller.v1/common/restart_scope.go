@@ -0,0 +1,178 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	"github.com/kubeflow/training-operator/pkg/core"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// isJobRestartScope returns whether all the replicas of the job are restarted together.
+func isJobRestartScope(runPolicy *apiv1.RunPolicy) bool {
+	return runPolicy.RestartScope != nil && *runPolicy.RestartScope == apiv1.RestartScopeJob
+}
+
+// ApplyRestartScope prepares the pod template of a replica of a job with RestartScope Job. The pods are
+// never restarted by the kubelet, so that the operator restarts all the replicas on a failure, and they
+// carry the RestartGeneration of the job they were created for.
+func ApplyRestartScope(runPolicy *apiv1.RunPolicy, restartGeneration int32, podTemplate *corev1.PodTemplateSpec) {
+	if !isJobRestartScope(runPolicy) {
+		return
+	}
+	podTemplate.Spec.RestartPolicy = corev1.RestartPolicyNever
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = make(map[string]string)
+	}
+	generation := strconv.Itoa(int(restartGeneration))
+	podTemplate.Labels[apiv1.RestartGenerationLabel] = generation
+	for i := range podTemplate.Spec.Containers {
+		podTemplate.Spec.Containers[i].Env = append(podTemplate.Spec.Containers[i].Env, corev1.EnvVar{
+			Name:  apiv1.RestartGenerationEnvVar,
+			Value: generation,
+		})
+	}
+}
+
+// RestartGeneration returns the RestartGeneration of RestartGenerationLabel, 0 if unset.
+func RestartGeneration(labels map[string]string) int32 {
+	generation, err := strconv.Atoi(labels[apiv1.RestartGenerationLabel])
+	if err != nil {
+		return 0
+	}
+	return int32(generation)
+}
+
+// podRestartGeneration returns the RestartGeneration the pod was created for.
+func podRestartGeneration(pod *corev1.Pod) int32 {
+	return RestartGeneration(pod.Labels)
+}
+
+// podToRestart returns a failed pod of the current RestartGeneration which must be restarted. No pod is
+// returned when a failed pod matches a FailJob rule of a PodFailurePolicy, the job is failed instead.
+// The failures matching an Ignore rule are skipped, ReconcilePods restarts those pods alone.
+func (jc *JobController) podToRestart(replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec,
+	jobStatus *apiv1.JobStatus, pods []*corev1.Pod) (*corev1.Pod, apiv1.ReplicaType) {
+	var restart *corev1.Pod
+	var restartType apiv1.ReplicaType
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodFailed || pod.DeletionTimestamp != nil ||
+			podRestartGeneration(pod) != jobStatus.RestartGeneration {
+			continue
+		}
+		for rtype, spec := range replicas {
+			if pod.Labels[apiv1.ReplicaTypeLabel] != strings.ToLower(string(rtype)) {
+				continue
+			}
+			retryable := isRetryablePodFailure(pod, spec, containerExitCode(pod, jc.Controller.GetDefaultContainerName()))
+			if rule, _ := matchPodFailurePolicy(spec.PodFailurePolicy, pod); rule != nil {
+				switch rule.Action {
+				case apiv1.PodFailurePolicyActionFailJob:
+					return nil, ""
+				case apiv1.PodFailurePolicyActionRestartPod:
+					retryable = true
+				case apiv1.PodFailurePolicyActionIgnore:
+					retryable = false
+				}
+			}
+			if retryable && restart == nil {
+				restart, restartType = pod, rtype
+			}
+		}
+	}
+	return restart, restartType
+}
+
+// containerExitCode returns the exit code of the terminated container of the pod with the given name.
+func containerExitCode(pod *corev1.Pod, containerName string) int32 {
+	var exitCode int32 = 0xbeef // magic number
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName && status.State.Terminated != nil {
+			exitCode = status.State.Terminated.ExitCode
+		}
+	}
+	return exitCode
+}
+
+// pastJobRestartLimit returns whether a replica of a job with RestartScope Job must be restarted
+// while the job was already restarted BackoffLimit times.
+func (jc *JobController) pastJobRestartLimit(runPolicy *apiv1.RunPolicy, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec,
+	jobStatus *apiv1.JobStatus, pods []*corev1.Pod) bool {
+	if runPolicy.BackoffLimit == nil || jobStatus.RestartGeneration < *runPolicy.BackoffLimit {
+		return false
+	}
+	pod, _ := jc.podToRestart(replicas, jobStatus, pods)
+	return pod != nil
+}
+
+// restartJob restarts all the replicas of a job with RestartScope Job once a replica must be restarted:
+// the RestartGeneration of the job is incremented, the pods of the previous generations and the PodGroup
+// are deleted. It returns whether the pods of the previous generations are still being deleted, no pod
+// of the job is created until then.
+func (jc *JobController) restartJob(metaObject metav1.Object, runtimeObject runtime.Object, runPolicy *apiv1.RunPolicy,
+	replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, jobStatus *apiv1.JobStatus, pods []*corev1.Pod) (bool, error) {
+	if !isJobRestartScope(runPolicy) {
+		return false, nil
+	}
+	jobKind := jc.Controller.GetAPIGroupVersionKind().Kind
+	logger := commonutil.LoggerForJob(metaObject)
+
+	if pod, rtype := jc.podToRestart(replicas, jobStatus, pods); pod != nil {
+		jobStatus.RestartGeneration++
+		if status := jobStatus.ReplicaStatuses[rtype]; status != nil {
+			core.RecordReplicaRestart(status, pod)
+		}
+		msg := fmt.Sprintf("%s %s is restarting all its replicas because pod %s of %s replica failed, the restart generation is %d.",
+			jobKind, metaObject.GetName(), pod.Name, rtype, jobStatus.RestartGeneration)
+		logger.Info(msg)
+		jc.Recorder.Event(runtimeObject, corev1.EventTypeWarning, commonutil.NewReason(jobKind, commonutil.JobRestartingReason), msg)
+		commonutil.UpdateJobConditions(jobStatus, apiv1.JobRestarting, corev1.ConditionTrue, commonutil.NewReason(jobKind, commonutil.JobRestartingReason), msg)
+		trainingoperatorcommon.RestartedJobsCounterInc(metaObject.GetNamespace(), jc.Controller.GetFrameworkName())
+
+		// The PodGroup is recreated for the new pods, once the pods of the previous generation are deleted.
+		if jc.Config.EnableGangScheduling() {
+			if err := jc.DeletePodGroup(metaObject); err != nil {
+				return true, err
+			}
+		}
+	}
+
+	stale := false
+	for _, pod := range pods {
+		if podRestartGeneration(pod) == jobStatus.RestartGeneration {
+			continue
+		}
+		stale = true
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := jc.PodControl.DeletePod(pod.Namespace, pod.Name, runtimeObject); err != nil {
+			return true, err
+		}
+	}
+	if stale {
+		logger.Infof("Waiting for the pods of the previous restart generations to be deleted")
+	}
+	return stale, nil
+}
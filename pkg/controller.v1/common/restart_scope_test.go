@@ -0,0 +1,227 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+func newGenerationPod(name string, phase corev1.PodPhase, generation string) *corev1.Pod {
+	pod := newPod(name, phase)
+	pod.Labels[apiv1.RestartGenerationLabel] = generation
+	return pod
+}
+
+func TestApplyRestartScope(T *testing.T) {
+	cases := map[string]struct {
+		restartScope      *apiv1.RestartScope
+		wantRestartPolicy corev1.RestartPolicy
+		wantLabels        map[string]string
+		wantEnv           []corev1.EnvVar
+	}{
+		"pod restart scope": {
+			restartScope:      ptr.To(apiv1.RestartScopePod),
+			wantRestartPolicy: corev1.RestartPolicyOnFailure,
+		},
+		"job restart scope": {
+			restartScope:      ptr.To(apiv1.RestartScopeJob),
+			wantRestartPolicy: corev1.RestartPolicyNever,
+			wantLabels:        map[string]string{apiv1.RestartGenerationLabel: "2"},
+			wantEnv:           []corev1.EnvVar{{Name: apiv1.RestartGenerationEnvVar, Value: "2"}},
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			podTemplate := &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers:    []corev1.Container{{Name: "test-container"}},
+				},
+			}
+			ApplyRestartScope(&apiv1.RunPolicy{RestartScope: tc.restartScope}, 2, podTemplate)
+
+			if podTemplate.Spec.RestartPolicy != tc.wantRestartPolicy {
+				t.Errorf("Unexpected restart policy: \nwant: %v\ngot: %v\n", tc.wantRestartPolicy, podTemplate.Spec.RestartPolicy)
+			}
+			if diff := cmp.Diff(tc.wantLabels, podTemplate.Labels); len(diff) != 0 {
+				t.Errorf("Unexpected labels (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEnv, podTemplate.Spec.Containers[0].Env); len(diff) != 0 {
+				t.Errorf("Unexpected env (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRestartJob(T *testing.T) {
+	failJob := &apiv1.PodFailurePolicy{Rules: []apiv1.PodFailurePolicyRule{{
+		Action:      apiv1.PodFailurePolicyActionFailJob,
+		OnExitCodes: &apiv1.PodFailurePolicyOnExitCodesRequirement{Operator: apiv1.PodFailurePolicyOnExitCodesOpIn, Values: []int32{42}},
+	}}}
+	ignore := &apiv1.PodFailurePolicy{Rules: []apiv1.PodFailurePolicyRule{{
+		Action:          apiv1.PodFailurePolicyActionIgnore,
+		OnPodConditions: []apiv1.PodFailurePolicyOnPodConditionsPattern{{Type: corev1.DisruptionTarget}},
+	}}}
+	deleting := newGenerationPod("deleting", corev1.PodRunning, "0")
+	deleting.DeletionTimestamp = ptr.To(metav1.Now())
+	cases := map[string]struct {
+		restartScope      apiv1.RestartScope
+		podFailurePolicy  *apiv1.PodFailurePolicy
+		restartGeneration int32
+		pods              []*corev1.Pod
+		wantRestarting    bool
+		wantGeneration    int32
+		wantDeleted       []string
+	}{
+		"pod restart scope": {
+			restartScope: apiv1.RestartScopePod,
+			pods: []*corev1.Pod{
+				newFailedPod("failed", map[string]int32{"test-container": 1}),
+				newPod("running", corev1.PodRunning),
+			},
+		},
+		"no failed pod": {
+			restartScope: apiv1.RestartScopeJob,
+			pods:         []*corev1.Pod{newPod("running", corev1.PodRunning)},
+		},
+		"failed pod": {
+			restartScope: apiv1.RestartScopeJob,
+			pods: []*corev1.Pod{
+				newFailedPod("failed", map[string]int32{"test-container": 1}),
+				newPod("running", corev1.PodRunning),
+			},
+			wantRestarting: true,
+			wantGeneration: 1,
+			wantDeleted:    []string{"failed", "running"},
+		},
+		"failed pod matches a FailJob rule": {
+			restartScope:     apiv1.RestartScopeJob,
+			podFailurePolicy: failJob,
+			pods: []*corev1.Pod{
+				newFailedPod("failed", map[string]int32{"test-container": 42}),
+				newPod("running", corev1.PodRunning),
+			},
+		},
+		"failed pod matches an Ignore rule": {
+			restartScope:     apiv1.RestartScopeJob,
+			podFailurePolicy: ignore,
+			pods: []*corev1.Pod{
+				newFailedPod("failed", map[string]int32{"test-container": 137}, corev1.DisruptionTarget),
+				newPod("running", corev1.PodRunning),
+			},
+		},
+		"pods of the previous generation are deleted": {
+			restartScope:      apiv1.RestartScopeJob,
+			restartGeneration: 1,
+			pods:              []*corev1.Pod{deleting, newGenerationPod("running", corev1.PodRunning, "0")},
+			wantRestarting:    true,
+			wantGeneration:    1,
+			wantDeleted:       []string{"running"},
+		},
+		"pods of the current generation": {
+			restartScope:      apiv1.RestartScopeJob,
+			restartGeneration: 1,
+			pods:              []*corev1.Pod{newGenerationPod("running", corev1.PodRunning, "1")},
+			wantGeneration:    1,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			podControl := &control.FakePodControl{}
+			jobController := JobController{
+				Controller: testController{},
+				PodControl: podControl,
+				Recorder:   record.NewFakeRecorder(10),
+			}
+			job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"test": {
+				Replicas:         ptr.To[int32](2),
+				RestartPolicy:    apiv1.RestartPolicyOnFailure,
+				PodFailurePolicy: tc.podFailurePolicy,
+			}}
+			runPolicy := &apiv1.RunPolicy{RestartScope: ptr.To(tc.restartScope)}
+			jobStatus := &apiv1.JobStatus{
+				RestartGeneration: tc.restartGeneration,
+				ReplicaStatuses:   map[apiv1.ReplicaType]*apiv1.ReplicaStatus{"test": {}},
+			}
+
+			restarting, err := jobController.restartJob(job, job, runPolicy, replicas, jobStatus, tc.pods)
+			if err != nil {
+				t.Fatalf("restartJob() error = %v", err)
+			}
+			if restarting != tc.wantRestarting {
+				t.Errorf("Unexpected restarting: \nwant: %v\ngot: %v\n", tc.wantRestarting, restarting)
+			}
+			if jobStatus.RestartGeneration != tc.wantGeneration {
+				t.Errorf("Unexpected restart generation: \nwant: %v\ngot: %v\n", tc.wantGeneration, jobStatus.RestartGeneration)
+			}
+			if diff := cmp.Diff(tc.wantDeleted, podControl.DeletePodName); len(diff) != 0 {
+				t.Errorf("Unexpected deleted pods (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPastJobRestartLimit(T *testing.T) {
+	failed := newFailedPod("failed", map[string]int32{"test-container": 1})
+	cases := map[string]struct {
+		backoffLimit      *int32
+		restartGeneration int32
+		pods              []*corev1.Pod
+		want              bool
+	}{
+		"no backoff limit": {
+			restartGeneration: 5,
+			pods:              []*corev1.Pod{failed},
+		},
+		"restarts left": {
+			backoffLimit:      ptr.To[int32](3),
+			restartGeneration: 2,
+			pods:              []*corev1.Pod{newGenerationPod("failed", corev1.PodFailed, "2")},
+		},
+		"no restart needed": {
+			backoffLimit:      ptr.To[int32](3),
+			restartGeneration: 3,
+			pods:              []*corev1.Pod{newGenerationPod("running", corev1.PodRunning, "3")},
+		},
+		"past the backoff limit": {
+			backoffLimit:      ptr.To[int32](3),
+			restartGeneration: 3,
+			pods:              []*corev1.Pod{newGenerationPod("failed", corev1.PodFailed, "3")},
+			want:              true,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			jobController := JobController{Controller: testController{}}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"test": {RestartPolicy: apiv1.RestartPolicyOnFailure}}
+			runPolicy := &apiv1.RunPolicy{BackoffLimit: tc.backoffLimit, RestartScope: ptr.To(apiv1.RestartScopeJob)}
+			jobStatus := &apiv1.JobStatus{RestartGeneration: tc.restartGeneration}
+			if got := jobController.pastJobRestartLimit(runPolicy, replicas, jobStatus, tc.pods); got != tc.want {
+				t.Errorf("Unexpected past backoff limit: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+)
+
+//+kubebuilder:rbac:groups=kubeflow.org,resources=trainingruntimes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kubeflow.org,resources=clustertrainingruntimes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;create
+
+// ApplyTrainingRuntime merges the runtime referenced by the RunPolicy into the replica specs and the run policy
+// of the job. The runtime is saved in a ControllerRevision owned by the job the first time it is resolved,
+// so the updates of the runtime don't change the jobs which already started.
+func ApplyTrainingRuntime(c client.Client, reader client.Reader, job metav1.Object, gvk schema.GroupVersionKind,
+	replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, runPolicy *apiv1.RunPolicy) error {
+	if runPolicy == nil || runPolicy.RuntimeRef == nil {
+		return nil
+	}
+	ctx := context.Background()
+	spec, revision, err := core.ResolveTrainingRuntime(ctx, reader, job, runPolicy.RuntimeRef)
+	if err != nil {
+		return err
+	}
+	if revision == nil {
+		if revision, err = core.NewTrainingRuntimeRevision(job, gvk, runPolicy.RuntimeRef, spec); err != nil {
+			return err
+		}
+		if err = c.Create(ctx, revision); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		if errors.IsAlreadyExists(err) {
+			// Another reconcile saved the runtime, use the saved one.
+			if spec, _, err = core.ResolveTrainingRuntime(ctx, reader, job, runPolicy.RuntimeRef); err != nil {
+				return err
+			}
+		}
+	}
+	return core.MergeTrainingRuntime(spec, replicas, runPolicy)
+}
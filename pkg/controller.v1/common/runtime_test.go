@@ -0,0 +1,239 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+)
+
+func newTrainingRuntime(image string) *apiv1.ClusterTrainingRuntime {
+	return &apiv1.ClusterTrainingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "torch"},
+		Spec: apiv1.TrainingRuntimeSpec{
+			ReplicaSpecs: map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+				"Worker": {
+					Replicas:       ptr.To[int32](4),
+					RestartPolicy:  apiv1.RestartPolicyOnFailure,
+					RestartBackoff: &apiv1.RestartBackoff{BaseSeconds: ptr.To[int32](10)},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "pytorch",
+									Image: image,
+									Env:   []corev1.EnvVar{{Name: "NCCL_DEBUG", Value: "WARN"}},
+								},
+							},
+						},
+					},
+				},
+				"Master": {
+					Replicas: ptr.To[int32](1),
+				},
+			},
+			RunPolicy: &apiv1.RunPolicy{
+				BackoffLimit:   ptr.To[int32](3),
+				CleanPodPolicy: apiv1.CleanPodPolicyPointer(apiv1.CleanPodPolicyAll),
+				RestartScope:   ptr.To(apiv1.RestartScopeJob),
+			},
+		},
+	}
+}
+
+func TestApplyTrainingRuntime(T *testing.T) {
+	cases := map[string]struct {
+		worker        *apiv1.ReplicaSpec
+		runPolicy     apiv1.RunPolicy
+		wantWorker    *apiv1.ReplicaSpec
+		wantRunPolicy apiv1.RunPolicy
+	}{
+		"job without overrides": {
+			worker:    &apiv1.ReplicaSpec{},
+			runPolicy: apiv1.RunPolicy{RuntimeRef: &apiv1.RuntimeRef{Name: "torch"}},
+			wantWorker: &apiv1.ReplicaSpec{
+				Replicas:       ptr.To[int32](4),
+				RestartPolicy:  apiv1.RestartPolicyOnFailure,
+				RestartBackoff: &apiv1.RestartBackoff{BaseSeconds: ptr.To[int32](10)},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "pytorch",
+								Image: "torch:v1",
+								Env:   []corev1.EnvVar{{Name: "NCCL_DEBUG", Value: "WARN"}},
+							},
+						},
+					},
+				},
+			},
+			wantRunPolicy: apiv1.RunPolicy{
+				RuntimeRef:     &apiv1.RuntimeRef{Name: "torch"},
+				BackoffLimit:   ptr.To[int32](3),
+				CleanPodPolicy: apiv1.CleanPodPolicyPointer(apiv1.CleanPodPolicyAll),
+				RestartScope:   ptr.To(apiv1.RestartScopeJob),
+			},
+		},
+		"job overrides": {
+			worker: &apiv1.ReplicaSpec{
+				Replicas:       ptr.To[int32](2),
+				RestartBackoff: &apiv1.RestartBackoff{MaxSeconds: ptr.To[int32](60)},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "pytorch",
+								Env:  []corev1.EnvVar{{Name: "EPOCHS", Value: "10"}},
+							},
+						},
+					},
+				},
+			},
+			runPolicy: apiv1.RunPolicy{
+				RuntimeRef:   &apiv1.RuntimeRef{Name: "torch"},
+				BackoffLimit: ptr.To[int32](1),
+				RestartScope: ptr.To(apiv1.RestartScopePod),
+			},
+			wantWorker: &apiv1.ReplicaSpec{
+				Replicas:       ptr.To[int32](2),
+				RestartPolicy:  apiv1.RestartPolicyOnFailure,
+				RestartBackoff: &apiv1.RestartBackoff{MaxSeconds: ptr.To[int32](60)},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "pytorch",
+								Image: "torch:v1",
+								Env: []corev1.EnvVar{
+									{Name: "EPOCHS", Value: "10"},
+									{Name: "NCCL_DEBUG", Value: "WARN"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantRunPolicy: apiv1.RunPolicy{
+				RuntimeRef:     &apiv1.RuntimeRef{Name: "torch"},
+				BackoffLimit:   ptr.To[int32](1),
+				CleanPodPolicy: apiv1.CleanPodPolicyPointer(apiv1.CleanPodPolicyAll),
+				RestartScope:   ptr.To(apiv1.RestartScopePod),
+			},
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := apiv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add the kubeflow scheme: %v", err)
+			}
+			if err := appsv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add the apps scheme: %v", err)
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newTrainingRuntime("torch:v1")).Build()
+			job := &apiv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "uid"}}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"Worker": tc.worker}
+			runPolicy := tc.runPolicy
+
+			err := ApplyTrainingRuntime(c, c, job, apiv1.GroupVersion.WithKind(apiv1.PyTorchJobKind), replicas, &runPolicy)
+			if err != nil {
+				t.Fatalf("ApplyTrainingRuntime() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorker, replicas["Worker"]); len(diff) != 0 {
+				t.Errorf("Unexpected worker spec (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRunPolicy, runPolicy); len(diff) != 0 {
+				t.Errorf("Unexpected run policy (-want,+got):\n%s", diff)
+			}
+
+			revision := &appsv1.ControllerRevision{}
+			key := types.NamespacedName{Namespace: "default", Name: core.TrainingRuntimeRevisionName("test")}
+			if err = c.Get(context.Background(), key, revision); err != nil {
+				t.Fatalf("Failed to get the runtime revision: %v", err)
+			}
+			if !metav1.IsControlledBy(revision, job) {
+				t.Errorf("Unexpected owner of the runtime revision: %v", revision.OwnerReferences)
+			}
+		})
+	}
+}
+
+func TestApplyTrainingRuntimeUpdated(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := apiv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the kubeflow scheme: %v", err)
+	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the apps scheme: %v", err)
+	}
+	trainingRuntime := newTrainingRuntime("torch:v1")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(trainingRuntime).Build()
+	job := &apiv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "uid"}}
+	gvk := apiv1.GroupVersion.WithKind(apiv1.PyTorchJobKind)
+	runPolicy := &apiv1.RunPolicy{RuntimeRef: &apiv1.RuntimeRef{Name: "torch"}}
+
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"Worker": {}}
+	if err := ApplyTrainingRuntime(c, c, job, gvk, replicas, runPolicy); err != nil {
+		t.Fatalf("ApplyTrainingRuntime() error = %v", err)
+	}
+
+	// The job keeps the runtime it started with.
+	trainingRuntime.Spec.ReplicaSpecs["Worker"].Template.Spec.Containers[0].Image = "torch:v2"
+	if err := c.Update(context.Background(), trainingRuntime); err != nil {
+		t.Fatalf("Failed to update the runtime: %v", err)
+	}
+	replicas = map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"Worker": {}}
+	if err := ApplyTrainingRuntime(c, c, job, gvk, replicas, runPolicy); err != nil {
+		t.Fatalf("ApplyTrainingRuntime() error = %v", err)
+	}
+	if got := replicas["Worker"].Template.Spec.Containers[0].Image; got != "torch:v1" {
+		t.Errorf("Unexpected image: \nwant: %v\ngot: %v\n", "torch:v1", got)
+	}
+}
+
+func TestApplyTrainingRuntimeDependsOn(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := apiv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the kubeflow scheme: %v", err)
+	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the apps scheme: %v", err)
+	}
+	trainingRuntime := newTrainingRuntime("torch:v1")
+	trainingRuntime.Spec.RunPolicy.DependsOn = []apiv1.JobDependency{{Kind: apiv1.TFJobKind, Name: "preprocess"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(trainingRuntime).Build()
+	job := &apiv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "uid"}}
+	runPolicy := &apiv1.RunPolicy{RuntimeRef: &apiv1.RuntimeRef{Name: "torch"}}
+
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"Worker": {}}
+	if err := ApplyTrainingRuntime(c, c, job, apiv1.GroupVersion.WithKind(apiv1.PyTorchJobKind), replicas, runPolicy); err == nil {
+		t.Error("Expected an error for the dependsOn of the runtime")
+	}
+	if len(runPolicy.DependsOn) != 0 {
+		t.Errorf("Unexpected dependsOn: %v", runPolicy.DependsOn)
+	}
+}
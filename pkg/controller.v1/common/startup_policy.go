@@ -0,0 +1,99 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// PendingStartupDependency returns why the pods of the replica type can't be created yet according to
+// the RunPolicy.StartupPolicy, or an empty string once the replicas it depends on reached their condition.
+func (jc *JobController) PendingStartupDependency(runPolicy *apiv1.RunPolicy, rType apiv1.ReplicaType,
+	pods []*corev1.Pod, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) (string, error) {
+	if runPolicy == nil || runPolicy.StartupPolicy == nil {
+		return "", nil
+	}
+	for _, dependency := range runPolicy.StartupPolicy.Dependencies {
+		if dependency.ReplicaType != rType {
+			continue
+		}
+		condition := dependency.Condition
+		if len(condition) == 0 {
+			condition = apiv1.ReplicaStartupConditionRunning
+		}
+		for _, dependsOn := range dependency.DependsOn {
+			spec, ok := replicas[dependsOn]
+			if !ok || spec.Replicas == nil {
+				continue
+			}
+			dependsOnPods, err := jc.FilterPodsForReplicaType(pods, strings.ToLower(string(dependsOn)))
+			if err != nil {
+				return "", err
+			}
+			started := 0
+			for _, pod := range dependsOnPods {
+				if hasReachedStartupCondition(pod, condition) {
+					started++
+				}
+			}
+			if started < int(*spec.Replicas) {
+				return fmt.Sprintf("%d/%d %s replicas are %s", started, *spec.Replicas, dependsOn, condition), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// gangReplicas returns the replicas which are scheduled together with gang scheduling. The replicas
+// waiting for other replicas according to the StartupPolicy are left out, their pods are created once
+// the others are running, so the PodGroup would never be scheduled with them.
+func gangReplicas(runPolicy *apiv1.RunPolicy, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) map[apiv1.ReplicaType]*apiv1.ReplicaSpec {
+	if runPolicy.StartupPolicy == nil || len(runPolicy.StartupPolicy.Dependencies) == 0 {
+		return replicas
+	}
+	result := make(map[apiv1.ReplicaType]*apiv1.ReplicaSpec, len(replicas))
+	for rtype, spec := range replicas {
+		result[rtype] = spec
+	}
+	for _, dependency := range runPolicy.StartupPolicy.Dependencies {
+		delete(result, dependency.ReplicaType)
+	}
+	return result
+}
+
+// hasReachedStartupCondition checks whether the pod is Running or Ready. The pods which succeeded
+// have reached any condition, their dependents would never start otherwise.
+func hasReachedStartupCondition(pod *corev1.Pod, condition apiv1.ReplicaStartupCondition) bool {
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return true
+	}
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	if condition != apiv1.ReplicaStartupConditionReady {
+		return true
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
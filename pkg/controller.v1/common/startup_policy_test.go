@@ -0,0 +1,220 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	"github.com/kubeflow/training-operator/pkg/util/k8sutil"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+// startupTestController creates the pods of the test jobs.
+type startupTestController struct {
+	testController
+	runPolicy *apiv1.RunPolicy
+}
+
+func (startupTestController) ControllerName() string {
+	return "test-controller"
+}
+
+func (startupTestController) GetAPIGroupVersion() schema.GroupVersion {
+	return testjobv1.SchemeGroupVersion
+}
+
+func (startupTestController) IsMasterRole(map[apiv1.ReplicaType]*apiv1.ReplicaSpec, apiv1.ReplicaType, int) bool {
+	return false
+}
+
+func (c startupTestController) GetRunPolicyForJob(interface{}) *apiv1.RunPolicy {
+	return c.runPolicy
+}
+
+func (startupTestController) SetClusterSpec(interface{}, *corev1.PodTemplateSpec, string, string) error {
+	return nil
+}
+
+func newStartupPolicy(condition apiv1.ReplicaStartupCondition) *apiv1.RunPolicy {
+	return &apiv1.RunPolicy{
+		StartupPolicy: &apiv1.StartupPolicy{
+			Dependencies: []apiv1.ReplicaStartupDependency{
+				{
+					ReplicaType: "worker",
+					DependsOn:   []apiv1.ReplicaType{"master"},
+					Condition:   condition,
+				},
+			},
+		},
+	}
+}
+
+func newMasterPod(phase corev1.PodPhase, ready bool) *corev1.Pod {
+	pod := newPod("master", phase)
+	pod.Labels[apiv1.ReplicaTypeLabel] = "master"
+	pod.Labels[apiv1.ReplicaIndexLabel] = "0"
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}}
+	return pod
+}
+
+func TestPendingStartupDependency(T *testing.T) {
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+		"master": {Replicas: ptr.To[int32](1)},
+		"worker": {Replicas: ptr.To[int32](3)},
+	}
+	cases := map[string]struct {
+		runPolicy   *apiv1.RunPolicy
+		rType       apiv1.ReplicaType
+		pods        []*corev1.Pod
+		wantPending bool
+	}{
+		"no startup policy": {
+			runPolicy: &apiv1.RunPolicy{},
+			rType:     "worker",
+		},
+		"replica type without dependencies": {
+			runPolicy: newStartupPolicy(apiv1.ReplicaStartupConditionRunning),
+			rType:     "master",
+		},
+		"master is not created": {
+			runPolicy:   newStartupPolicy(apiv1.ReplicaStartupConditionRunning),
+			rType:       "worker",
+			wantPending: true,
+		},
+		"master is pending": {
+			runPolicy:   newStartupPolicy(""),
+			rType:       "worker",
+			pods:        []*corev1.Pod{newMasterPod(corev1.PodPending, false)},
+			wantPending: true,
+		},
+		"master is running": {
+			runPolicy: newStartupPolicy(""),
+			rType:     "worker",
+			pods:      []*corev1.Pod{newMasterPod(corev1.PodRunning, false)},
+		},
+		"master is running but not ready": {
+			runPolicy:   newStartupPolicy(apiv1.ReplicaStartupConditionReady),
+			rType:       "worker",
+			pods:        []*corev1.Pod{newMasterPod(corev1.PodRunning, false)},
+			wantPending: true,
+		},
+		"master is ready": {
+			runPolicy: newStartupPolicy(apiv1.ReplicaStartupConditionReady),
+			rType:     "worker",
+			pods:      []*corev1.Pod{newMasterPod(corev1.PodRunning, true)},
+		},
+		"master succeeded": {
+			runPolicy: newStartupPolicy(apiv1.ReplicaStartupConditionReady),
+			rType:     "worker",
+			pods:      []*corev1.Pod{newMasterPod(corev1.PodSucceeded, false)},
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			jobController := JobController{}
+			pending, err := jobController.PendingStartupDependency(tc.runPolicy, tc.rType, tc.pods, replicas)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if (len(pending) != 0) != tc.wantPending {
+				t.Errorf("Unexpected pending dependency: \nwant: %v\ngot: %q\n", tc.wantPending, pending)
+			}
+		})
+	}
+}
+
+func TestGangReplicas(T *testing.T) {
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+		"master": {Replicas: ptr.To[int32](1)},
+		"worker": {Replicas: ptr.To[int32](3)},
+	}
+	cases := map[string]struct {
+		runPolicy *apiv1.RunPolicy
+		want      int32
+	}{
+		"no startup policy": {
+			runPolicy: &apiv1.RunPolicy{},
+			want:      4,
+		},
+		"workers wait for the master": {
+			runPolicy: newStartupPolicy(apiv1.ReplicaStartupConditionRunning),
+			want:      1,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			if got := k8sutil.GetTotalReplicas(gangReplicas(tc.runPolicy, replicas)); got != tc.want {
+				t.Errorf("Unexpected gang members: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+			if len(replicas) != 2 {
+				t.Errorf("Unexpected change of the replicas: %v", replicas)
+			}
+		})
+	}
+}
+
+func TestReconcilePodsWithStartupPolicy(T *testing.T) {
+	cases := map[string]struct {
+		pods        []*corev1.Pod
+		wantCreated int
+	}{
+		"master is running but not ready": {
+			pods:        []*corev1.Pod{newMasterPod(corev1.PodRunning, false)},
+			wantCreated: 0,
+		},
+		"master is ready": {
+			pods:        []*corev1.Pod{newMasterPod(corev1.PodRunning, true)},
+			wantCreated: 2,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			podControl := &control.FakePodControl{}
+			jobController := JobController{
+				Controller:   startupTestController{runPolicy: newStartupPolicy(apiv1.ReplicaStartupConditionReady)},
+				PodControl:   podControl,
+				Expectations: expectation.NewControllerExpectations(),
+				Recorder:     record.NewFakeRecorder(10),
+			}
+			job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			spec := &apiv1.ReplicaSpec{Replicas: ptr.To[int32](2)}
+			replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+				"master": {Replicas: ptr.To[int32](1)},
+				"worker": spec,
+			}
+
+			err := jobController.ReconcilePods(job, &apiv1.JobStatus{}, tc.pods, "worker", spec, replicas)
+			if err != nil {
+				t.Fatalf("ReconcilePods() error = %v", err)
+			}
+			if created := len(podControl.Templates); created != tc.wantCreated {
+				t.Errorf("Unexpected created pods: \nwant: %v\ngot: %v\n", tc.wantCreated, created)
+			}
+		})
+	}
+}
@@ -15,3 +15,8 @@ func initializeReplicaStatuses(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaTy
 func updateJobReplicaStatuses(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaType, pod *corev1.Pod) {
 	core.UpdateJobReplicaStatuses(jobStatus, rtype, pod)
 }
+
+// recordReplicaRestart counts a restart of the pod in the JobReplicaStatuses.
+func recordReplicaRestart(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaType, pod *corev1.Pod) {
+	core.RecordReplicaRestart(jobStatus.ReplicaStatuses[rtype], pod)
+}
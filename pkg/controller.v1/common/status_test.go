@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestUpdateJobReplicaStatuses(t *testing.T) {
@@ -47,3 +48,66 @@ func setStatusForTest(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaType, faile
 		updateJobReplicaStatuses(jobStatus, rtype, &pod)
 	}
 }
+
+func TestUpdateJobReplicaStatusesPodState(t *testing.T) {
+	newIndexPod := func(index string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+		readyStatus := corev1.ConditionFalse
+		if ready {
+			readyStatus = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:   "test-worker-" + index,
+				Labels: map[string]string{apiv1.ReplicaIndexLabel: index},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-" + index},
+			Status: corev1.PodStatus{
+				Phase:      phase,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+			},
+		}
+	}
+	failedPod := newIndexPod("1", corev1.PodFailed, false)
+	failedPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			RestartCount: 1,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, FinishedAt: metaV1.Unix(10, 0)},
+			},
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, FinishedAt: metaV1.Unix(20, 0)},
+			},
+		},
+	}
+	terminatingPod := newIndexPod("2", corev1.PodRunning, true)
+	terminatingPod.DeletionTimestamp = &metaV1.Time{Time: time.Now()}
+
+	jobStatus := apiv1.JobStatus{}
+	initializeReplicaStatuses(&jobStatus, "worker")
+	for _, pod := range []*corev1.Pod{
+		terminatingPod,
+		newIndexPod("0", corev1.PodRunning, true),
+		failedPod,
+		newIndexPod("3", corev1.PodPending, false),
+	} {
+		updateJobReplicaStatuses(&jobStatus, "worker", pod)
+	}
+	recordReplicaRestart(&jobStatus, "worker", failedPod)
+
+	status := jobStatus.ReplicaStatuses["worker"]
+	assert.Equal(t, int32(1), status.Ready)
+	assert.Equal(t, int32(1), status.Terminating)
+	assert.Equal(t, int32(1), status.Restarts)
+	assert.Equal(t, []apiv1.ReplicaIndexStatus{
+		{Index: 0, PodName: "test-worker-0", Phase: corev1.PodRunning, NodeName: "node-0"},
+		{Index: 1, PodName: "test-worker-1", Phase: corev1.PodFailed, NodeName: "node-1", Restarts: 1, ContainerRestarts: 1, LastExitCode: ptr.To[int32](137)},
+		{Index: 2, PodName: "test-worker-2", Phase: corev1.PodRunning, NodeName: "node-2"},
+		{Index: 3, PodName: "test-worker-3", Phase: corev1.PodPending, NodeName: "node-3"},
+	}, status.Indexes)
+
+	// The restarts are carried over to the next reconciliation.
+	initializeReplicaStatuses(&jobStatus, "worker")
+	status = jobStatus.ReplicaStatuses["worker"]
+	assert.Equal(t, int32(1), status.Restarts)
+	assert.Equal(t, []apiv1.ReplicaIndexStatus{{Index: 1, Restarts: 1}}, status.Indexes)
+}
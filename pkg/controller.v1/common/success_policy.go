@@ -0,0 +1,83 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// applySuccessPolicy marks the job as succeeded once its RunPolicy.SuccessPolicy is met.
+// The framework controllers leave the success of jobs with a SuccessPolicy to it.
+func (jc *JobController) applySuccessPolicy(runtimeObject runtime.Object, metaObject metav1.Object,
+	runPolicy *apiv1.RunPolicy, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec,
+	jobStatus *apiv1.JobStatus, pods []*corev1.Pod) {
+	if runPolicy.SuccessPolicy == nil || commonutil.IsFinished(*jobStatus) {
+		return
+	}
+	if !isSuccessPolicyMet(runPolicy.SuccessPolicy, replicas, *jobStatus, pods) {
+		return
+	}
+	jobKind := jc.Controller.GetAPIGroupVersionKind().Kind
+	msg := fmt.Sprintf("%s %s is successfully completed.", jobKind, metaObject.GetName())
+	jc.Recorder.Event(runtimeObject, corev1.EventTypeNormal, commonutil.NewReason(jobKind, commonutil.JobSucceededReason), msg)
+	if jobStatus.CompletionTime == nil {
+		now := metav1.Now()
+		jobStatus.CompletionTime = &now
+	}
+	commonutil.UpdateJobConditions(jobStatus, apiv1.JobSucceeded, corev1.ConditionTrue, commonutil.NewReason(jobKind, commonutil.JobSucceededReason), msg)
+	trainingoperatorcommon.SuccessfulJobsCounterInc(metaObject.GetNamespace(), jc.Controller.GetFrameworkName())
+}
+
+// isSuccessPolicyMet checks whether the replicas of a job succeeded according to the success policy.
+func isSuccessPolicyMet(policy *apiv1.JobSuccessPolicy, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec,
+	jobStatus apiv1.JobStatus, pods []*corev1.Pod) bool {
+	switch policy.Mode {
+	case apiv1.JobSuccessPolicyModeLeader:
+		for _, pod := range pods {
+			if pod.Labels[apiv1.JobRoleLabel] == "master" && pod.Status.Phase == corev1.PodSucceeded {
+				return true
+			}
+		}
+	case apiv1.JobSuccessPolicyModeAllReplicas:
+		rtypes := policy.ReplicaTypes
+		if len(rtypes) == 0 {
+			for rtype := range replicas {
+				rtypes = append(rtypes, rtype)
+			}
+		}
+		for _, rtype := range rtypes {
+			spec, status := replicas[rtype], jobStatus.ReplicaStatuses[rtype]
+			if spec == nil || status == nil || status.Succeeded < *spec.Replicas {
+				return false
+			}
+		}
+		return len(rtypes) > 0
+	case apiv1.JobSuccessPolicyModeMinSucceeded:
+		if policy.ReplicaType == nil || policy.MinSucceeded == nil {
+			return false
+		}
+		status := jobStatus.ReplicaStatuses[*policy.ReplicaType]
+		return status != nil && status.Succeeded >= *policy.MinSucceeded
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	testjobv1 "github.com/kubeflow/training-operator/test_job/apis/test_job/v1"
+)
+
+func TestIsSuccessPolicyMet(T *testing.T) {
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{
+		"master": {Replicas: ptr.To[int32](1)},
+		"worker": {Replicas: ptr.To[int32](3)},
+	}
+	newJobStatus := func(masterSucceeded, workerSucceeded int32) apiv1.JobStatus {
+		return apiv1.JobStatus{
+			ReplicaStatuses: map[apiv1.ReplicaType]*apiv1.ReplicaStatus{
+				"master": {Succeeded: masterSucceeded},
+				"worker": {Succeeded: workerSucceeded},
+			},
+		}
+	}
+	succeededMaster := newPod("master", corev1.PodSucceeded)
+	succeededMaster.Labels[apiv1.JobRoleLabel] = "master"
+
+	cases := map[string]struct {
+		policy    *apiv1.JobSuccessPolicy
+		jobStatus apiv1.JobStatus
+		pods      []*corev1.Pod
+		want      bool
+	}{
+		"leader succeeded": {
+			policy:    &apiv1.JobSuccessPolicy{Mode: apiv1.JobSuccessPolicyModeLeader},
+			jobStatus: newJobStatus(1, 0),
+			pods:      []*corev1.Pod{succeededMaster, newPod("worker", corev1.PodRunning)},
+			want:      true,
+		},
+		"leader is running": {
+			policy:    &apiv1.JobSuccessPolicy{Mode: apiv1.JobSuccessPolicyModeLeader},
+			jobStatus: newJobStatus(0, 3),
+			pods:      []*corev1.Pod{newPod("worker", corev1.PodSucceeded)},
+			want:      false,
+		},
+		"all replicas succeeded": {
+			policy:    &apiv1.JobSuccessPolicy{Mode: apiv1.JobSuccessPolicyModeAllReplicas},
+			jobStatus: newJobStatus(1, 3),
+			want:      true,
+		},
+		"some replicas are running": {
+			policy:    &apiv1.JobSuccessPolicy{Mode: apiv1.JobSuccessPolicyModeAllReplicas},
+			jobStatus: newJobStatus(1, 2),
+			want:      false,
+		},
+		"all workers succeeded": {
+			policy:    &apiv1.JobSuccessPolicy{Mode: apiv1.JobSuccessPolicyModeAllReplicas, ReplicaTypes: []apiv1.ReplicaType{"worker"}},
+			jobStatus: newJobStatus(0, 3),
+			want:      true,
+		},
+		"min succeeded workers": {
+			policy: &apiv1.JobSuccessPolicy{
+				Mode:         apiv1.JobSuccessPolicyModeMinSucceeded,
+				ReplicaType:  ptr.To[apiv1.ReplicaType]("worker"),
+				MinSucceeded: ptr.To[int32](2),
+			},
+			jobStatus: newJobStatus(0, 2),
+			want:      true,
+		},
+		"too few succeeded workers": {
+			policy: &apiv1.JobSuccessPolicy{
+				Mode:         apiv1.JobSuccessPolicyModeMinSucceeded,
+				ReplicaType:  ptr.To[apiv1.ReplicaType]("worker"),
+				MinSucceeded: ptr.To[int32](2),
+			},
+			jobStatus: newJobStatus(1, 1),
+			want:      false,
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			got := isSuccessPolicyMet(tc.policy, replicas, tc.jobStatus, tc.pods)
+			if got != tc.want {
+				t.Errorf("Unexpected result: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestApplySuccessPolicy(T *testing.T) {
+	jobController := JobController{
+		Controller: testController{},
+		Recorder:   record.NewFakeRecorder(10),
+	}
+	job := &testjobv1.TestJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"worker": {Replicas: ptr.To[int32](2)}}
+	runPolicy := &apiv1.RunPolicy{
+		SuccessPolicy: &apiv1.JobSuccessPolicy{Mode: apiv1.JobSuccessPolicyModeAllReplicas},
+	}
+	jobStatus := &apiv1.JobStatus{
+		ReplicaStatuses: map[apiv1.ReplicaType]*apiv1.ReplicaStatus{"worker": {Succeeded: 2}},
+	}
+
+	jobController.applySuccessPolicy(job, job, runPolicy, replicas, jobStatus, nil)
+	if !commonutil.IsSucceeded(*jobStatus) {
+		T.Errorf("Unexpected conditions: %v", jobStatus.Conditions)
+	}
+	if jobStatus.CompletionTime == nil {
+		T.Errorf("Expected the completion time to be set")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// topologyPreferredWeight is the weight of the preferred pod affinity, the co-location
+// outweighs the other preferences of the pod template.
+const topologyPreferredWeight = 100
+
+// ApplyTopologyPolicy adds the pod affinity or the topology spread constraint of the RunPolicy.TopologyPolicy
+// to the pod template of the replica type. The pods are placed relative to the pods of the job whose
+// replica types the policy applies to, all the replica types of the job if the policy lists none.
+func (jc *JobController) ApplyTopologyPolicy(runPolicy *apiv1.RunPolicy, job metav1.Object,
+	replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, rtype string, podTemplate *v1.PodTemplateSpec) {
+	if runPolicy == nil || runPolicy.TopologyPolicy == nil {
+		return
+	}
+	policy := runPolicy.TopologyPolicy
+	if !appliesToReplicaType(policy, rtype) {
+		return
+	}
+
+	// The pods of the other jobs with the same name, or the pods of the job which aren't replicas,
+	// e.g. the rendezvous pod of a PyTorchJob, aren't matched.
+	replicaTypes := policy.ReplicaTypes
+	if len(replicaTypes) == 0 {
+		replicaTypes = make([]apiv1.ReplicaType, 0, len(replicas))
+		for rType := range replicas {
+			replicaTypes = append(replicaTypes, rType)
+		}
+	}
+	values := make([]string, 0, len(replicaTypes))
+	for _, rType := range replicaTypes {
+		values = append(values, strings.ToLower(string(rType)))
+	}
+	sort.Strings(values)
+	selector := &metav1.LabelSelector{
+		MatchLabels: jc.GenLabels(job.GetName()),
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      apiv1.ReplicaTypeLabel,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   values,
+			},
+		},
+	}
+
+	switch policy.Mode {
+	case apiv1.TopologyPolicyModeRequired, apiv1.TopologyPolicyModePreferred:
+		if podTemplate.Spec.Affinity == nil {
+			podTemplate.Spec.Affinity = &v1.Affinity{}
+		}
+		if podTemplate.Spec.Affinity.PodAffinity == nil {
+			podTemplate.Spec.Affinity.PodAffinity = &v1.PodAffinity{}
+		}
+		podAffinity := podTemplate.Spec.Affinity.PodAffinity
+		term := v1.PodAffinityTerm{
+			LabelSelector: selector,
+			TopologyKey:   policy.TopologyKey,
+		}
+		if policy.Mode == apiv1.TopologyPolicyModeRequired {
+			// The first pod of the job is scheduled anywhere since it matches its own affinity.
+			podAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+				podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+		} else {
+			podAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+				podAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				v1.WeightedPodAffinityTerm{Weight: topologyPreferredWeight, PodAffinityTerm: term})
+		}
+	case apiv1.TopologyPolicyModeSpread:
+		podTemplate.Spec.TopologySpreadConstraints = append(podTemplate.Spec.TopologySpreadConstraints,
+			v1.TopologySpreadConstraint{
+				MaxSkew:           1,
+				TopologyKey:       policy.TopologyKey,
+				WhenUnsatisfiable: v1.DoNotSchedule,
+				LabelSelector:     selector,
+			})
+	}
+}
+
+// appliesToReplicaType checks whether the replica type is placed by the topology policy.
+func appliesToReplicaType(policy *apiv1.TopologyPolicy, rtype string) bool {
+	if len(policy.ReplicaTypes) == 0 {
+		return true
+	}
+	for _, rType := range policy.ReplicaTypes {
+		if strings.EqualFold(string(rType), rtype) {
+			return true
+		}
+	}
+	return false
+}
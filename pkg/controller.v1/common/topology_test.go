@@ -0,0 +1,118 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestApplyTopologyPolicy(T *testing.T) {
+	job := &metav1.ObjectMeta{Name: "test", Namespace: "default"}
+	replicas := map[apiv1.ReplicaType]*apiv1.ReplicaSpec{"Master": {}, "Worker": {}}
+	jobLabels := map[string]string{apiv1.OperatorNameLabel: "test-controller", apiv1.JobNameLabel: "test"}
+	jobSelector := &metav1.LabelSelector{
+		MatchLabels: jobLabels,
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: apiv1.ReplicaTypeLabel, Operator: metav1.LabelSelectorOpIn, Values: []string{"master", "worker"}},
+		},
+	}
+	workerSelector := &metav1.LabelSelector{
+		MatchLabels: jobLabels,
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: apiv1.ReplicaTypeLabel, Operator: metav1.LabelSelectorOpIn, Values: []string{"worker"}},
+		},
+	}
+
+	cases := map[string]struct {
+		policy   *apiv1.TopologyPolicy
+		rtype    string
+		wantSpec corev1.PodSpec
+	}{
+		"no topology policy": {
+			rtype: "worker",
+		},
+		"required": {
+			policy: &apiv1.TopologyPolicy{TopologyKey: "topology.kubernetes.io/zone", Mode: apiv1.TopologyPolicyModeRequired},
+			rtype:  "master",
+			wantSpec: corev1.PodSpec{
+				Affinity: &corev1.Affinity{
+					PodAffinity: &corev1.PodAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+							{LabelSelector: jobSelector, TopologyKey: "topology.kubernetes.io/zone"},
+						},
+					},
+				},
+			},
+		},
+		"preferred for the workers": {
+			policy: &apiv1.TopologyPolicy{
+				TopologyKey:  "example.com/rack",
+				Mode:         apiv1.TopologyPolicyModePreferred,
+				ReplicaTypes: []apiv1.ReplicaType{"Worker"},
+			},
+			rtype: "worker",
+			wantSpec: corev1.PodSpec{
+				Affinity: &corev1.Affinity{
+					PodAffinity: &corev1.PodAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight:          topologyPreferredWeight,
+								PodAffinityTerm: corev1.PodAffinityTerm{LabelSelector: workerSelector, TopologyKey: "example.com/rack"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"replica type not placed by the policy": {
+			policy: &apiv1.TopologyPolicy{
+				TopologyKey:  "example.com/rack",
+				Mode:         apiv1.TopologyPolicyModeRequired,
+				ReplicaTypes: []apiv1.ReplicaType{"Worker"},
+			},
+			rtype: "master",
+		},
+		"spread": {
+			policy: &apiv1.TopologyPolicy{TopologyKey: "topology.kubernetes.io/zone", Mode: apiv1.TopologyPolicyModeSpread},
+			rtype:  "worker",
+			wantSpec: corev1.PodSpec{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.DoNotSchedule,
+						LabelSelector:     jobSelector,
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			jobController := JobController{Controller: startupTestController{}}
+			podTemplate := &corev1.PodTemplateSpec{}
+			jobController.ApplyTopologyPolicy(&apiv1.RunPolicy{TopologyPolicy: tc.policy}, job, replicas, tc.rtype, podTemplate)
+			if diff := cmp.Diff(tc.wantSpec, podTemplate.Spec); len(diff) != 0 {
+				t.Errorf("Unexpected pod spec (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,9 +15,12 @@
 package control
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	commonutil "github.com/kubeflow/training-operator/pkg/util"
 
@@ -29,7 +32,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 // Reasons for pod events
@@ -62,12 +68,20 @@ type PodControlInterface interface {
 	DeletePod(namespace string, podID string, object runtime.Object) error
 	// PatchPod patches the pod.
 	PatchPod(namespace, name string, data []byte) error
+	// SignalPod sends the signal to the main process of the container of the pod.
+	SignalPod(namespace, name, container, signal string) error
 }
 
+// SignalPodTimeout bounds the exec sending a signal to a pod, so that a hung exec doesn't block the
+// reconciliation of the job.
+const SignalPodTimeout = 10 * time.Second
+
 // RealPodControl is the default implementation of PodControlInterface.
 type RealPodControl struct {
 	KubeClient clientset.Interface
 	Recorder   record.EventRecorder
+	// Config is the config of the exec of the pods, the pods can't be signaled without it.
+	Config *rest.Config
 }
 
 var _ PodControlInterface = &RealPodControl{}
@@ -117,6 +131,38 @@ func (r RealPodControl) PatchPod(namespace, name string, data []byte) error {
 	return err
 }
 
+// SignalPod runs the kill command of the container, the signal is sent to the process 1 of the container.
+// The image of the container must have a kill binary, and the training code must run as the process 1,
+// e.g. exec'ed by the entrypoint script, a wrapper which doesn't forward the signal loses it silently.
+// The exec is canceled after SignalPodTimeout.
+func (r RealPodControl) SignalPod(namespace, name, container, signal string) error {
+	if r.Config == nil {
+		return fmt.Errorf("unable to signal pod %s/%s: no config to exec in pods", namespace, name)
+	}
+	req := r.KubeClient.CoreV1().RESTClient().Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   []string{"kill", "-s", strings.TrimPrefix(signal, "SIG"), "1"},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(r.Config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("unable to signal pod %s/%s: %v", namespace, name, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), SignalPodTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	if err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return fmt.Errorf("unable to signal pod %s/%s: %v: %s", namespace, name, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
 func GetPodFromTemplate(template *v1.PodTemplateSpec, parentObject runtime.Object, controllerRef *metav1.OwnerReference) (*v1.Pod, error) {
 	desiredLabels := getPodsLabelSet(template)
 	desiredFinalizers := getPodsFinalizers(template)
@@ -198,6 +244,7 @@ type FakePodControl struct {
 	ControllerRefs  []metav1.OwnerReference
 	DeletePodName   []string
 	Patches         [][]byte
+	Signals         []string
 	Err             error
 	CreateLimit     int
 	CreateCallCount int
@@ -215,6 +262,16 @@ func (f *FakePodControl) PatchPod(namespace, name string, data []byte) error {
 	return nil
 }
 
+func (f *FakePodControl) SignalPod(namespace, name, container, signal string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.Signals = append(f.Signals, name+"/"+container+":"+signal)
+	if f.Err != nil {
+		return f.Err
+	}
+	return nil
+}
+
 func (f *FakePodControl) CreatePods(namespace string, spec *v1.PodTemplateSpec, object runtime.Object) error {
 	f.Lock()
 	defer f.Unlock()
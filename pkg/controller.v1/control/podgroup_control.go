@@ -44,6 +44,8 @@ type PodGroupControlInterface interface {
 	UpdatePodGroup(podGroup client.Object) error
 	// CreatePodGroup creates a new PodGroup with PodGroup spec fill function.
 	CreatePodGroup(podGroup client.Object) error
+	// FillPodGroupSpec sets the spec of the PodGroup from the gang-scheduling parameters of the job.
+	FillPodGroupSpec(pg metav1.Object, spec PodGroupSpec) error
 	// DelayPodCreationDueToPodGroup determines whether it should delay Pod Creation.
 	DelayPodCreationDueToPodGroup(pg metav1.Object) bool
 	// DecoratePodTemplateSpec decorates PodTemplateSpec.
@@ -53,6 +55,21 @@ type PodGroupControlInterface interface {
 	GetSchedulerName() string
 }
 
+// PodGroupSpec holds the gang-scheduling parameters of a job, resolved from its SchedulingPolicy,
+// which each gang scheduler translates into its own PodGroup.
+type PodGroupSpec struct {
+	// MinMember is the number of pods which must be scheduled together.
+	MinMember int32
+	// Queue is the queue of the gang scheduler the PodGroup is submitted to.
+	Queue string
+	// PriorityClassName is the priority class of the PodGroup.
+	PriorityClassName string
+	// MinResources is the total amount of resources required by the MinMember pods.
+	MinResources *corev1.ResourceList
+	// ScheduleTimeoutSeconds is the maximum time to wait for the gang to be scheduled.
+	ScheduleTimeoutSeconds *int32
+}
+
 // VolcanoControl is the implementation of PodGroupControlInterface with volcano.
 type VolcanoControl struct {
 	Client volcanoclient.Interface
@@ -86,6 +103,20 @@ func (v *VolcanoControl) DelayPodCreationDueToPodGroup(pg metav1.Object) bool {
 	return len(volcanoPodGroup.Status.Phase) == 0 || volcanoPodGroup.Status.Phase == volcanov1beta1.PodGroupPending
 }
 
+func (v *VolcanoControl) FillPodGroupSpec(pg metav1.Object, spec PodGroupSpec) error {
+	volcanoPodGroup, match := pg.(*volcanov1beta1.PodGroup)
+	if !match {
+		return fmt.Errorf("unable to recognize PodGroup: %v", klog.KObj(pg))
+	}
+	volcanoPodGroup.Spec = volcanov1beta1.PodGroupSpec{
+		MinMember:         spec.MinMember,
+		Queue:             spec.Queue,
+		PriorityClassName: spec.PriorityClassName,
+		MinResources:      spec.MinResources,
+	}
+	return nil
+}
+
 func (v *VolcanoControl) NewEmptyPodGroup() client.Object {
 	return &volcanov1beta1.PodGroup{}
 }
@@ -152,6 +183,21 @@ func (s *SchedulerPluginsControl) DelayPodCreationDueToPodGroup(pg metav1.Object
 	return false
 }
 
+func (s *SchedulerPluginsControl) FillPodGroupSpec(pg metav1.Object, spec PodGroupSpec) error {
+	schedulerPluginsPodGroup, match := pg.(*schedulerpluginsv1alpha1.PodGroup)
+	if !match {
+		return fmt.Errorf("unable to recognize PodGroup: %v", klog.KObj(pg))
+	}
+	schedulerPluginsPodGroup.Spec = schedulerpluginsv1alpha1.PodGroupSpec{
+		MinMember:              spec.MinMember,
+		ScheduleTimeoutSeconds: spec.ScheduleTimeoutSeconds,
+	}
+	if spec.MinResources != nil {
+		schedulerPluginsPodGroup.Spec.MinResources = *spec.MinResources
+	}
+	return nil
+}
+
 func (s *SchedulerPluginsControl) NewEmptyPodGroup() client.Object {
 	return &schedulerpluginsv1alpha1.PodGroup{}
 }
@@ -197,3 +243,17 @@ func (s *SchedulerPluginsControl) CreatePodGroup(podGroup client.Object) error {
 }
 
 var _ PodGroupControlInterface = &SchedulerPluginsControl{}
+
+// KoordinatorControl is the implementation of PodGroupControlInterface with the koord-scheduler of Koordinator.
+// The coscheduling plugin of the koord-scheduler gang-schedules the pods of the scheduler-plugins PodGroups,
+// so the PodGroups are managed the same way as with scheduler-plugins.
+type KoordinatorControl struct {
+	SchedulerPluginsControl
+}
+
+// NewKoordinatorControl returns a KoordinatorControl
+func NewKoordinatorControl(c client.Client, schedulerName string) PodGroupControlInterface {
+	return &KoordinatorControl{SchedulerPluginsControl{Client: c, SchedulerName: schedulerName}}
+}
+
+var _ PodGroupControlInterface = &KoordinatorControl{}
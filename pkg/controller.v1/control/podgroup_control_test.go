@@ -0,0 +1,150 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestFillPodGroupSpec(t *testing.T) {
+	minResources := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+	spec := PodGroupSpec{
+		MinMember:              3,
+		Queue:                  "research",
+		PriorityClassName:      "high",
+		MinResources:           &minResources,
+		ScheduleTimeoutSeconds: ptr.To[int32](60),
+	}
+	cases := map[string]struct {
+		pgControl PodGroupControlInterface
+		wantPG    metav1.Object
+		wantErr   bool
+	}{
+		"volcano": {
+			pgControl: NewVolcanoControl(nil),
+			wantPG: &volcanov1beta1.PodGroup{
+				Spec: volcanov1beta1.PodGroupSpec{
+					MinMember:         3,
+					Queue:             "research",
+					PriorityClassName: "high",
+					MinResources:      &minResources,
+				},
+			},
+		},
+		"scheduler-plugins": {
+			pgControl: NewSchedulerPluginsControl(nil, "scheduler-plugins"),
+			wantPG: &schedulerpluginsv1alpha1.PodGroup{
+				Spec: schedulerpluginsv1alpha1.PodGroupSpec{
+					MinMember:              3,
+					MinResources:           minResources,
+					ScheduleTimeoutSeconds: ptr.To[int32](60),
+				},
+			},
+		},
+		"koord-scheduler": {
+			pgControl: NewKoordinatorControl(nil, "koord-scheduler"),
+			wantPG: &schedulerpluginsv1alpha1.PodGroup{
+				Spec: schedulerpluginsv1alpha1.PodGroupSpec{
+					MinMember:              3,
+					MinResources:           minResources,
+					ScheduleTimeoutSeconds: ptr.To[int32](60),
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pg := tc.pgControl.NewEmptyPodGroup()
+			if err := tc.pgControl.FillPodGroupSpec(pg, spec); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPG, pg); len(diff) != 0 {
+				t.Errorf("Unexpected PodGroup (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFillPodGroupSpecUnrecognizedPodGroup(t *testing.T) {
+	if err := NewVolcanoControl(nil).FillPodGroupSpec(&schedulerpluginsv1alpha1.PodGroup{}, PodGroupSpec{}); err == nil {
+		t.Error("Expected an error filling a scheduler-plugins PodGroup with volcano")
+	}
+	if err := NewSchedulerPluginsControl(nil, "scheduler-plugins").FillPodGroupSpec(&volcanov1beta1.PodGroup{}, PodGroupSpec{}); err == nil {
+		t.Error("Expected an error filling a volcano PodGroup with scheduler-plugins")
+	}
+}
+
+func TestGetPodGroupBackend(t *testing.T) {
+	cases := map[string]struct {
+		name           string
+		wantRegistered bool
+		wantCRDs       int
+	}{
+		"volcano": {
+			name:           "volcano",
+			wantRegistered: true,
+			wantCRDs:       1,
+		},
+		"the name is case insensitive": {
+			name:           "Volcano",
+			wantRegistered: true,
+			wantCRDs:       1,
+		},
+		"scheduler-plugins": {
+			name:           "scheduler-plugins",
+			wantRegistered: true,
+			wantCRDs:       1,
+		},
+		"koord-scheduler": {
+			name:           "koord-scheduler",
+			wantRegistered: true,
+			wantCRDs:       1,
+		},
+		"unknown scheduler": {
+			name: "scheduler-plugins-scheduler",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			backend, registered := GetPodGroupBackend(tc.name)
+			if registered != tc.wantRegistered {
+				t.Fatalf("Unexpected registered: \nwant: %v\ngot: %v\n", tc.wantRegistered, registered)
+			}
+			if len(backend.CRDs) != tc.wantCRDs {
+				t.Errorf("Unexpected number of CRDs: \nwant: %v\ngot: %v\n", tc.wantCRDs, len(backend.CRDs))
+			}
+		})
+	}
+}
+
+func TestRegisterPodGroupBackend(t *testing.T) {
+	RegisterPodGroupBackend("Test-Scheduler", SchedulerPluginsBackend)
+	defer func() {
+		podGroupBackendsMu.Lock()
+		delete(podGroupBackends, "test-scheduler")
+		podGroupBackendsMu.Unlock()
+	}()
+	if _, registered := GetPodGroupBackend("test-scheduler"); !registered {
+		t.Error("Expected the backend to be registered")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned"
+)
+
+const (
+	// PodGroupBackendVolcano is the name of the Volcano gang scheduler.
+	PodGroupBackendVolcano = "volcano"
+	// PodGroupBackendSchedulerPlugins is the name of the coscheduling plugin of scheduler-plugins.
+	PodGroupBackendSchedulerPlugins = "scheduler-plugins"
+	// PodGroupBackendKoordinator is the name of the koord-scheduler of Koordinator.
+	PodGroupBackendKoordinator = "koord-scheduler"
+)
+
+// PodGroupControlFactory creates the PodGroupControlInterface of a gang scheduler running as schedulerName.
+type PodGroupControlFactory func(mgr manager.Manager, schedulerName string) (PodGroupControlInterface, error)
+
+// PodGroupBackend is a gang scheduler the training-operator can create PodGroups for.
+type PodGroupBackend struct {
+	// New creates the PodGroupControlInterface of the gang scheduler.
+	New PodGroupControlFactory
+	// CRDs are the custom resources which must be installed in the cluster to use the gang scheduler.
+	CRDs []schema.GroupVersionKind
+}
+
+var (
+	podGroupBackendsMu sync.RWMutex
+	podGroupBackends   = map[string]PodGroupBackend{}
+)
+
+// RegisterPodGroupBackend registers the gang scheduler under the name, the name is case insensitive.
+// Registering a name twice replaces the previous backend.
+func RegisterPodGroupBackend(name string, backend PodGroupBackend) {
+	podGroupBackendsMu.Lock()
+	defer podGroupBackendsMu.Unlock()
+	podGroupBackends[strings.ToLower(name)] = backend
+}
+
+// GetPodGroupBackend returns the gang scheduler registered under the name.
+func GetPodGroupBackend(name string) (PodGroupBackend, bool) {
+	podGroupBackendsMu.RLock()
+	defer podGroupBackendsMu.RUnlock()
+	backend, ok := podGroupBackends[strings.ToLower(name)]
+	return backend, ok
+}
+
+// SchedulerPluginsBackend manages the PodGroups of scheduler-plugins, it is also used for the
+// scheduler names which are not registered since scheduler-plugins is usually deployed under a custom name.
+var SchedulerPluginsBackend = PodGroupBackend{
+	New: func(mgr manager.Manager, schedulerName string) (PodGroupControlInterface, error) {
+		return NewSchedulerPluginsControl(mgr.GetClient(), schedulerName), nil
+	},
+	CRDs: []schema.GroupVersionKind{schedulerpluginsv1alpha1.SchemeGroupVersion.WithKind("PodGroup")},
+}
+
+func init() {
+	RegisterPodGroupBackend(PodGroupBackendVolcano, PodGroupBackend{
+		New: func(mgr manager.Manager, _ string) (PodGroupControlInterface, error) {
+			vci, err := volcanoclient.NewForConfig(mgr.GetConfig())
+			if err != nil {
+				return nil, err
+			}
+			return NewVolcanoControl(vci), nil
+		},
+		CRDs: []schema.GroupVersionKind{volcanov1beta1.SchemeGroupVersion.WithKind("PodGroup")},
+	})
+	RegisterPodGroupBackend(PodGroupBackendSchedulerPlugins, SchedulerPluginsBackend)
+	RegisterPodGroupBackend(PodGroupBackendKoordinator, PodGroupBackend{
+		New: func(mgr manager.Manager, schedulerName string) (PodGroupControlInterface, error) {
+			return NewKoordinatorControl(mgr.GetClient(), schedulerName), nil
+		},
+		CRDs: []schema.GroupVersionKind{schedulerpluginsv1alpha1.SchemeGroupVersion.WithKind("PodGroup")},
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package jax
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+const (
+	// EnvCoordinatorAddress is the environment variable name for the address of the JAX coordinator,
+	// which is served by worker 0.
+	EnvCoordinatorAddress = "JAX_COORDINATOR_ADDRESS"
+	// EnvNumProcesses is the environment variable name for the number of JAX processes.
+	EnvNumProcesses = "JAX_NUM_PROCESSES"
+	// EnvProcessID is the environment variable name for the id of the JAX process.
+	EnvProcessID = "JAX_PROCESS_ID"
+)
+
+func setPodEnv(obj interface{}, podTemplateSpec *corev1.PodTemplateSpec, rtype, index string) error {
+	jaxJob, ok := obj.(*kubeflowv1.JAXJob)
+	if !ok {
+		return fmt.Errorf("%+v is not a type of JAXJob", obj)
+	}
+
+	processID, err := strconv.Atoi(index)
+	if err != nil {
+		return err
+	}
+	coordinatorPort, err := getPortFromJAXJob(jaxJob, kubeflowv1.JAXJobReplicaTypeWorker)
+	if err != nil {
+		return err
+	}
+	coordinatorAddr := fmt.Sprintf("%s:%d", replicaName(jaxJob.Name, kubeflowv1.JAXJobReplicaTypeWorker, 0), coordinatorPort)
+	numProcesses := getTotalReplicas(jaxJob)
+
+	for i := range podTemplateSpec.Spec.Containers {
+		// Initialize the environment variables.
+		if len(podTemplateSpec.Spec.Containers[i].Env) == 0 {
+			podTemplateSpec.Spec.Containers[i].Env = make([]corev1.EnvVar, 0)
+		}
+		// Set PYTHONUNBUFFERED to true, to disable output buffering.
+		podTemplateSpec.Spec.Containers[i].Env = append(podTemplateSpec.Spec.Containers[i].Env, corev1.EnvVar{
+			Name:  "PYTHONUNBUFFERED",
+			Value: "1",
+		})
+		podTemplateSpec.Spec.Containers[i].Env = append(podTemplateSpec.Spec.Containers[i].Env, corev1.EnvVar{
+			Name:  EnvCoordinatorAddress,
+			Value: coordinatorAddr,
+		})
+		podTemplateSpec.Spec.Containers[i].Env = append(podTemplateSpec.Spec.Containers[i].Env, corev1.EnvVar{
+			Name:  EnvNumProcesses,
+			Value: strconv.Itoa(int(numProcesses)),
+		})
+		podTemplateSpec.Spec.Containers[i].Env = append(podTemplateSpec.Spec.Containers[i].Env, corev1.EnvVar{
+			Name:  EnvProcessID,
+			Value: strconv.Itoa(processID),
+		})
+	}
+
+	return nil
+}
+
+func getTotalReplicas(job *kubeflowv1.JAXJob) int32 {
+	jobReplicas := int32(0)
+	for _, r := range job.Spec.JAXReplicaSpecs {
+		if r.Replicas == nil {
+			continue
+		}
+		jobReplicas += *r.Replicas
+	}
+	return jobReplicas
+}
+
+func replicaName(jobName string, rtype kubeflowv1.ReplicaType, index int) string {
+	n := jobName + "-" + strings.ToLower(string(rtype)) + "-" + strconv.Itoa(index)
+	return strings.Replace(n, "/", "-", -1)
+}
+
+// getPortFromJAXJob gets the coordinator port of the jax container.
+func getPortFromJAXJob(job *kubeflowv1.JAXJob, rtype kubeflowv1.ReplicaType) (int32, error) {
+	spec, ok := job.Spec.JAXReplicaSpecs[rtype]
+	if !ok {
+		return -1, fmt.Errorf("replica type %s not found", rtype)
+	}
+	for _, container := range spec.Template.Spec.Containers {
+		if container.Name == kubeflowv1.JAXJobDefaultContainerName {
+			for _, port := range container.Ports {
+				if port.Name == kubeflowv1.JAXJobDefaultPortName {
+					return port.ContainerPort, nil
+				}
+			}
+		}
+	}
+	return -1, fmt.Errorf("port not found")
+}
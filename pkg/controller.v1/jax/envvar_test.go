@@ -0,0 +1,98 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package jax
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestSetPodEnv(t *testing.T) {
+	job := &kubeflowv1.JAXJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: kubeflowv1.JAXJobSpec{
+			JAXReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+				kubeflowv1.JAXJobReplicaTypeWorker: {
+					Replicas: ptr.To[int32](2),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:  kubeflowv1.JAXJobDefaultContainerName,
+								Image: "test-image",
+								Ports: []corev1.ContainerPort{{
+									Name:          kubeflowv1.JAXJobDefaultPortName,
+									ContainerPort: kubeflowv1.JAXJobDefaultPort,
+								}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		index   string
+		wantEnv []corev1.EnvVar
+		wantErr bool
+	}{
+		"coordinator worker": {
+			index: "0",
+			wantEnv: []corev1.EnvVar{
+				{Name: "PYTHONUNBUFFERED", Value: "1"},
+				{Name: EnvCoordinatorAddress, Value: "test-worker-0:6666"},
+				{Name: EnvNumProcesses, Value: "2"},
+				{Name: EnvProcessID, Value: "0"},
+			},
+		},
+		"second worker": {
+			index: "1",
+			wantEnv: []corev1.EnvVar{
+				{Name: "PYTHONUNBUFFERED", Value: "1"},
+				{Name: EnvCoordinatorAddress, Value: "test-worker-0:6666"},
+				{Name: EnvNumProcesses, Value: "2"},
+				{Name: EnvProcessID, Value: "1"},
+			},
+		},
+		"invalid index": {
+			index:   "worker",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			podTemplate := job.Spec.JAXReplicaSpecs[kubeflowv1.JAXJobReplicaTypeWorker].Template.DeepCopy()
+			err := setPodEnv(job, podTemplate, "worker", tc.index)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("setPodEnv() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantEnv, podTemplate.Spec.Containers[0].Env); diff != "" {
+				t.Errorf("Unexpected env (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jax
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common/framework"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+const (
+	controllerName = "jaxjob-controller"
+)
+
+// NewReconciler creates a JAXJob Reconciler
+func NewReconciler(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) *JAXJobReconciler {
+	r := &JAXJobReconciler{}
+	r.Reconciler = framework.NewReconciler[*kubeflowv1.JAXJob](mgr, r, framework.Info{
+		Kind:                     kubeflowv1.JAXJobKind,
+		Singular:                 kubeflowv1.JAXJobSingular,
+		FrameworkName:            kubeflowv1.JAXJobFrameworkName,
+		ControllerName:           controllerName,
+		DefaultContainerName:     kubeflowv1.JAXJobDefaultContainerName,
+		DefaultContainerPortName: kubeflowv1.JAXJobDefaultPortName,
+	}, gangSchedulingSetupFunc)
+	return r
+}
+
+// JAXJobReconciler reconciles a JAXJob object
+type JAXJobReconciler struct {
+	*framework.Reconciler[*kubeflowv1.JAXJob]
+}
+
+//+kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups=scheduling.volcano.sh,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func (r *JAXJobReconciler) NewJob() *kubeflowv1.JAXJob {
+	return &kubeflowv1.JAXJob{}
+}
+
+func (r *JAXJobReconciler) GetReplicaSpecs(job *kubeflowv1.JAXJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+	return job.Spec.JAXReplicaSpecs
+}
+
+func (r *JAXJobReconciler) GetRunPolicy(job *kubeflowv1.JAXJob) *kubeflowv1.RunPolicy {
+	return &job.Spec.RunPolicy
+}
+
+func (r *JAXJobReconciler) GetJobStatus(job *kubeflowv1.JAXJob) *kubeflowv1.JobStatus {
+	return &job.Status
+}
+
+func (r *JAXJobReconciler) ValidateJob(job *kubeflowv1.JAXJob) error {
+	return kubeflowv1.ValidateV1JAXJob(job)
+}
+
+// ReconcileServices creates the headless service of worker 0 only, which serves the JAX coordinator.
+// The other workers reach the coordinator and never need to be addressed by name.
+func (r *JAXJobReconciler) ReconcileServices(job metav1.Object, services []*corev1.Service,
+	rtype kubeflowv1.ReplicaType, spec *kubeflowv1.ReplicaSpec) error {
+	if rtype != kubeflowv1.JAXJobReplicaTypeWorker {
+		return nil
+	}
+	coordinatorSpec := spec.DeepCopy()
+	coordinatorSpec.Replicas = ptr.To[int32](1)
+	return r.JobController.ReconcileServices(job, services, rtype, coordinatorSpec)
+}
+
+// UpdateJobConditions updates the job conditions based on the status of the workers.
+func (r *JAXJobReconciler) UpdateJobConditions(jaxJob *kubeflowv1.JAXJob,
+	replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
+	jobStatus *kubeflowv1.JobStatus) error {
+	for rtype, spec := range replicas {
+		status := jobStatus.ReplicaStatuses[rtype]
+
+		succeeded := status.Succeeded
+		expected := *(spec.Replicas) - succeeded
+		running := status.Active
+		failed := status.Failed
+		specReplicas := *spec.Replicas
+
+		logrus.Infof("JAXJob=%s, ReplicaType=%s expected=%d, running=%d, succeeded=%d, failed=%d, Replicas=%d",
+			jaxJob.Name, rtype, expected, running, succeeded, failed, specReplicas)
+
+		if rtype == kubeflowv1.JAXJobReplicaTypeWorker {
+			// The job is finished when all the workers are succeeded, unless the success policy decides it.
+			if expected == 0 && jaxJob.Spec.RunPolicy.SuccessPolicy == nil {
+				msg := fmt.Sprintf("JAXJob %s/%s successfully completed.", jaxJob.Namespace, jaxJob.Name)
+				r.Recorder.Event(jaxJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobSucceededReason), msg)
+				if jobStatus.CompletionTime == nil {
+					now := metav1.Now()
+					jobStatus.CompletionTime = &now
+				}
+				commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobSucceeded, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobSucceededReason), msg)
+				trainingoperatorcommon.SuccessfulJobsCounterInc(jaxJob.Namespace, r.GetFrameworkName())
+				return nil
+			} else if running > 0 {
+				msg := fmt.Sprintf("JAXJob %s/%s is running.", jaxJob.Namespace, jaxJob.Name)
+				commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobRunning, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobRunningReason), msg)
+			}
+		}
+
+		if failed > 0 && (specReplicas > succeeded+running) {
+			if spec.RestartPolicy != kubeflowv1.RestartPolicyNever {
+				msg := fmt.Sprintf("JAXJob %s is restarting because %d %s replica(s) failed.", jaxJob.Name, failed, rtype)
+				r.Recorder.Event(jaxJob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobRestartingReason), msg)
+				commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobRestarting, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobRestartingReason), msg)
+				trainingoperatorcommon.RestartedJobsCounterInc(jaxJob.Namespace, r.GetFrameworkName())
+			} else {
+				msg := fmt.Sprintf("JAXJob %s is failed because %d %s replica(s) failed.", jaxJob.Name, failed, rtype)
+				r.Recorder.Event(jaxJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobFailedReason), msg)
+				if jobStatus.CompletionTime == nil {
+					now := metav1.Now()
+					jobStatus.CompletionTime = &now
+				}
+				commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobFailed, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.JAXJobKind, commonutil.JobFailedReason), msg)
+				trainingoperatorcommon.FailedJobsCounterInc(jaxJob.Namespace, r.GetFrameworkName())
+			}
+		}
+	}
+	return nil
+}
+
+// SetClusterSpec sets the JAX distributed environment variables for the pod.
+func (r *JAXJobReconciler) SetClusterSpec(job interface{}, podTemplate *corev1.PodTemplateSpec, rtype, index string) error {
+	return setPodEnv(job, podTemplate, rtype, index)
+}
+
+// IsMasterRole returns true for worker 0, which runs the JAX coordinator.
+func (r *JAXJobReconciler) IsMasterRole(replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
+	rtype kubeflowv1.ReplicaType, index int) bool {
+	return rtype == kubeflowv1.JAXJobReplicaTypeWorker && index == 0
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// discoverHostsUpdateTimeAnnotation is the annotation of the ConfigMap with the last time discover_hosts.sh changed.
+const discoverHostsUpdateTimeAnnotation = "training.kubeflow.org/discover-hosts-update-time"
+
+// discoverHostsSyncPeriod is the time the kubelet takes at most to sync the ConfigMap volume of the launcher,
+// i.e. its sync frequency and the TTL of its ConfigMap cache, both of one minute by default.
+var discoverHostsSyncPeriod = 2 * time.Minute
+
+// isElastic checks whether the workers of the MPIJob can be scaled while it's running.
+func isElastic(mpiJob *kubeflowv1.MPIJob) bool {
+	return mpiJob.Spec.ElasticPolicy != nil
+}
+
+// workerIndex returns the replica index of a worker Pod, or -1 if it has none.
+func workerIndex(pod *corev1.Pod) int {
+	index, err := strconv.Atoi(pod.Labels[kubeflowv1.ReplicaIndexLabel])
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// isHostDiscovered checks whether discover_hosts.sh of the ConfigMap lists the pod.
+func isHostDiscovered(configMap *corev1.ConfigMap, mpiJob *kubeflowv1.MPIJob, podName string) bool {
+	prefix := fmt.Sprintf("echo %s:", hostName(mpiJob, podName))
+	for _, line := range strings.Split(configMap.Data[discoverHostsScriptName], "\n") {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// setDiscoverHostsUpdateTime annotates the ConfigMap with the time discover_hosts.sh changed from the previous ConfigMap.
+func setDiscoverHostsUpdateTime(configMap, previous *corev1.ConfigMap) {
+	updateTime, ok := previous.Annotations[discoverHostsUpdateTimeAnnotation]
+	if configMap.Data[discoverHostsScriptName] != previous.Data[discoverHostsScriptName] {
+		updateTime, ok = time.Now().UTC().Format(time.RFC3339), true
+	}
+	if ok {
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, discoverHostsUpdateTimeAnnotation, updateTime)
+	}
+}
+
+// durationUntilDiscoverHostsSynced returns the time left until the last update of discover_hosts.sh is synced
+// into the launcher, or -1 if it's synced.
+func durationUntilDiscoverHostsSynced(configMap *corev1.ConfigMap) time.Duration {
+	updateTime, err := time.Parse(time.RFC3339, configMap.Annotations[discoverHostsUpdateTimeAnnotation])
+	if err != nil {
+		return -1
+	}
+	remaining := time.Until(updateTime.Add(discoverHostsSyncPeriod))
+	if remaining <= 0 {
+		return -1
+	}
+	return remaining
+}
+
+// durationUntilWorkerDrain returns the time left until the workers removed from discover_hosts.sh of an elastic
+// MPIJob can be drained, or -1 if there is nothing to wait for.
+func (jc *MPIJobReconciler) durationUntilWorkerDrain(mpiJob *kubeflowv1.MPIJob) (time.Duration, error) {
+	if !isElastic(mpiJob) {
+		return -1, nil
+	}
+	configMap := &corev1.ConfigMap{}
+	NamespacedName := types.NamespacedName{Namespace: mpiJob.Namespace, Name: mpiJob.Name + configSuffix}
+	if err := jc.Get(context.Background(), NamespacedName, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return -1, nil
+		}
+		return -1, err
+	}
+	return durationUntilDiscoverHostsSynced(configMap), nil
+}
+
+// drainScaledDownWorkers deletes the workers of an elastic MPIJob beyond its worker replicas, one at a
+// time and from the highest index. A worker is deleted once discover_hosts.sh doesn't list it anymore
+// and the launcher synced it, so that Horovod removes it from the job before it's terminated.
+func (jc *MPIJobReconciler) drainScaledDownWorkers(mpiJob *kubeflowv1.MPIJob, pods []corev1.Pod, workerReplicas int32) error {
+	var scaledDown []*corev1.Pod
+	for i := range pods {
+		if workerIndex(&pods[i]) < int(workerReplicas) {
+			continue
+		}
+		// Wait for the worker being drained to be deleted.
+		if pods[i].DeletionTimestamp != nil {
+			return nil
+		}
+		scaledDown = append(scaledDown, &pods[i])
+	}
+	if len(scaledDown) == 0 {
+		return nil
+	}
+	sort.Slice(scaledDown, func(i, j int) bool {
+		return workerIndex(scaledDown[i]) > workerIndex(scaledDown[j])
+	})
+	pod := scaledDown[0]
+
+	configMap := &corev1.ConfigMap{}
+	NamespacedName := types.NamespacedName{Namespace: mpiJob.Namespace, Name: mpiJob.Name + configSuffix}
+	if err := jc.Get(context.Background(), NamespacedName, configMap); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if isHostDiscovered(configMap, mpiJob, pod.Name) {
+		logrus.Infof("MPIJob %s/%s waits for discover_hosts.sh to be updated to drain the worker %s", mpiJob.Namespace, mpiJob.Name, pod.Name)
+		return nil
+	}
+	if t := durationUntilDiscoverHostsSynced(configMap); t >= 0 {
+		logrus.Infof("MPIJob %s/%s waits %v for the launcher to sync discover_hosts.sh to drain the worker %s", mpiJob.Namespace, mpiJob.Name, t, pod.Name)
+		return nil
+	}
+	err := jc.KubeClientSet.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	jc.Recorder.Eventf(mpiJob, corev1.EventTypeNormal, "SuccessfulDeletePod", "Drained worker pod: %v", pod.Name)
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestIsHostDiscovered(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		Data: map[string]string{
+			discoverHostsScriptName: "#!/bin/sh\necho test-worker-0:1\necho test-worker-10:1",
+		},
+	}
+	cases := map[string]struct {
+		launcherMode kubeflowv1.MPIJobLauncherMode
+		podName      string
+		want         bool
+	}{
+		"discovered worker": {
+			launcherMode: kubeflowv1.MPIJobLauncherModeKubectlExec,
+			podName:      "test-worker-0",
+			want:         true,
+		},
+		"worker with a name prefixing a discovered worker": {
+			launcherMode: kubeflowv1.MPIJobLauncherModeKubectlExec,
+			podName:      "test-worker-1",
+		},
+		"worker discovered by its host name": {
+			launcherMode: kubeflowv1.MPIJobLauncherModeSSH,
+			podName:      "test-worker-0",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := &kubeflowv1.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: metav1.NamespaceDefault},
+				Spec:       kubeflowv1.MPIJobSpec{LauncherMode: ptr.To(tc.launcherMode)},
+			}
+			if got := isHostDiscovered(configMap, mpiJob, tc.podName); got != tc.want {
+				t.Errorf("Unexpected discovered: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDurationUntilDiscoverHostsSynced(t *testing.T) {
+	previous := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				discoverHostsUpdateTimeAnnotation: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string]string{
+			discoverHostsScriptName: "#!/bin/sh\necho test-worker-0:1\necho test-worker-1:1",
+		},
+	}
+	if got := durationUntilDiscoverHostsSynced(previous); got != -1 {
+		t.Errorf("Unexpected duration of a synced update: \nwant: %v\ngot: %v\n", -1, got)
+	}
+
+	unchanged := &corev1.ConfigMap{Data: map[string]string{
+		discoverHostsScriptName: previous.Data[discoverHostsScriptName],
+		hostfileName:            "test-worker-0 slots=1\n",
+	}}
+	setDiscoverHostsUpdateTime(unchanged, previous)
+	if diff := cmp.Diff(previous.Annotations, unchanged.Annotations); len(diff) != 0 {
+		t.Errorf("Unexpected annotations of an unchanged discover_hosts.sh (-want,+got):\n%s", diff)
+	}
+
+	changed := &corev1.ConfigMap{Data: map[string]string{
+		discoverHostsScriptName: "#!/bin/sh\necho test-worker-0:1",
+	}}
+	setDiscoverHostsUpdateTime(changed, previous)
+	if got := durationUntilDiscoverHostsSynced(changed); got <= discoverHostsSyncPeriod-time.Minute || got > discoverHostsSyncPeriod {
+		t.Errorf("Unexpected duration of a new update: \nwant: %v\ngot: %v\n", discoverHostsSyncPeriod, got)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package mpi
+
+import (
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+)
+
+// ReconcileHPA reconciles the HorizontalPodAutoscaler scaling the workers of the elastic MPIJob.
+func (jc *MPIJobReconciler) ReconcileHPA(mpiJob *kubeflowv1.MPIJob) error {
+	var policy *common.HPAPolicy
+	if elasticPolicy := mpiJob.Spec.ElasticPolicy; elasticPolicy != nil {
+		policy = &common.HPAPolicy{
+			MinReplicas: elasticPolicy.MinReplicas,
+			MaxReplicas: elasticPolicy.MaxReplicas,
+			Metrics:     elasticPolicy.Metrics,
+		}
+	}
+	return common.ReconcileHPA(jc.Client, jc.Scheme, mpiJob, jc.GetAPIGroupVersionKind(), &mpiJob.Spec.RunPolicy, policy)
+}
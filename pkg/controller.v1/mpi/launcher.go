@@ -0,0 +1,315 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpi
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/core"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	trainutil "github.com/kubeflow/training-operator/pkg/util/train"
+)
+
+// launcherState is the state of the launcher of an MPIJob. The launcher is a batch/v1 Job,
+// or a Pod for the MPIJobs which were started before the launcher ran as a Job.
+type launcherState struct {
+	succeeded bool
+	failed    bool
+	running   bool
+	// reason and message explain why the launcher failed.
+	reason  string
+	message string
+	// restarts is the number of times the launcher pod was retried.
+	restarts int32
+}
+
+func (s launcherState) finished() bool {
+	return s.succeeded || s.failed
+}
+
+// launcherJobState returns the state of the launcher Job from its conditions.
+func launcherJobState(job *batchv1.Job) launcherState {
+	state := launcherState{
+		running: ptr.Deref(job.Status.Ready, 0) > 0,
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			state.succeeded = true
+		case batchv1.JobFailed:
+			state.failed = true
+			state.reason = c.Reason
+			state.message = c.Message
+		}
+	}
+	if state.finished() {
+		state.running = false
+	}
+	// The last failed pod of a failed launcher is not retried.
+	state.restarts = job.Status.Failed
+	if state.failed && state.restarts > 0 {
+		state.restarts--
+	}
+	return state
+}
+
+// launcherPodState returns the state of a launcher Pod from its phase.
+func launcherPodState(pod *corev1.Pod) launcherState {
+	return launcherState{
+		succeeded: isPodSucceeded(pod),
+		failed:    isPodFailed(pod),
+		running:   isPodRunning(pod),
+		reason:    pod.Status.Reason,
+		message:   pod.Status.Message,
+	}
+}
+
+// getLauncherJob gets the launcher Job controlled by this MPIJob.
+func (jc *MPIJobReconciler) getLauncherJob(mpiJob *kubeflowv1.MPIJob) (*batchv1.Job, error) {
+	launcher := &batchv1.Job{}
+	NamespacedName := types.NamespacedName{Namespace: mpiJob.Namespace, Name: mpiJob.Name + launcherSuffix}
+	err := jc.Get(context.Background(), NamespacedName, launcher)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// If the launcher is not controlled by this MPIJob resource, we should log
+	// a warning to the event recorder and return.
+	if !metav1.IsControlledBy(launcher, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, launcher.Name, launcher.Kind)
+		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return launcher, fmt.Errorf(msg)
+	}
+	return launcher, nil
+}
+
+// getLauncherPod gets the launcher Pod controlled by this MPIJob. The launcher was a Pod
+// before it ran as a Job, such launchers are kept until their MPIJob finishes.
+func (jc *MPIJobReconciler) getLauncherPod(mpiJob *kubeflowv1.MPIJob) (*corev1.Pod, error) {
+	launcher := &corev1.Pod{}
+	NamespacedName := types.NamespacedName{Namespace: mpiJob.Namespace, Name: mpiJob.Name + launcherSuffix}
+	err := jc.Get(context.Background(), NamespacedName, launcher)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		// If an error occurs during Get, we'll requeue the item so we can
+		// attempt processing again later. This could have been caused by a
+		// temporary network failure, or any other transient reason.
+		return nil, err
+	}
+
+	// If the launcher is not controlled by this MPIJob resource, we should log
+	// a warning to the event recorder and return.
+	if !metav1.IsControlledBy(launcher, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, launcher.Name, launcher.Kind)
+		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return launcher, fmt.Errorf(msg)
+	}
+	return launcher, nil
+}
+
+// newLauncherJob creates a new launcher Job for an MPIJob resource. The pods of the Job
+// are the launcher pods created by newLauncher, the Job controller restarts them
+// within the backoff limit of the MPIJob.
+func (jc *MPIJobReconciler) newLauncherJob(mpiJob *kubeflowv1.MPIJob, kubectlDeliveryImage string, isGPULauncher bool) *batchv1.Job {
+	launcher := jc.newLauncher(mpiJob, kubectlDeliveryImage, isGPULauncher)
+	spec := mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher]
+
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      launcher.Labels,
+			Annotations: launcher.Annotations,
+		},
+		Spec: launcher.Spec,
+	}
+	common.ApplyRestartScope(&mpiJob.Spec.RunPolicy, mpiJob.Status.RestartGeneration, &template)
+	// The pods of a Job can't be restarted always.
+	if template.Spec.RestartPolicy == corev1.RestartPolicyAlways {
+		template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            launcher.Name,
+			Namespace:       launcher.Namespace,
+			Labels:          launcher.Labels,
+			OwnerReferences: launcher.OwnerReferences,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(launcherBackoffLimit(mpiJob.Spec.RunPolicy, spec)),
+			Template:     template,
+		},
+	}
+	if template.Spec.RestartPolicy == corev1.RestartPolicyNever {
+		job.Spec.PodFailurePolicy = newLauncherPodFailurePolicy(spec)
+	}
+	return job
+}
+
+// isStaleLauncherJob returns whether the launcher Job of an MPIJob with RestartScope Job was created
+// for a previous restart generation.
+func isStaleLauncherJob(launcher *batchv1.Job, runPolicy *kubeflowv1.RunPolicy, restartGeneration int32) bool {
+	if runPolicy.RestartScope == nil || *runPolicy.RestartScope != kubeflowv1.RestartScopeJob {
+		return false
+	}
+	return common.RestartGeneration(launcher.Spec.Template.Labels) != restartGeneration
+}
+
+// launcherBackoffLimit returns the backoff limit of the launcher Job. Without a backoff limit in the
+// RunPolicy, a failed launcher pod fails the MPIJob unless the restart policy restarts it.
+func launcherBackoffLimit(runPolicy kubeflowv1.RunPolicy, spec *kubeflowv1.ReplicaSpec) int32 {
+	if runPolicy.BackoffLimit != nil {
+		return *runPolicy.BackoffLimit
+	}
+	if spec.RestartPolicy == kubeflowv1.RestartPolicyNever {
+		return 0
+	}
+	return math.MaxInt32
+}
+
+// newLauncherPodFailurePolicy creates the pod failure policy of the launcher Job. The pods disrupted by
+// a drain or an eviction are recreated without counting towards the backoff limit. With the ExitCode
+// restart policy, the pods exiting with a permanent error fail the Job.
+func newLauncherPodFailurePolicy(spec *kubeflowv1.ReplicaSpec) *batchv1.PodFailurePolicy {
+	policy := &batchv1.PodFailurePolicy{
+		Rules: []batchv1.PodFailurePolicyRule{
+			{
+				Action: batchv1.PodFailurePolicyActionIgnore,
+				OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+					{
+						Type:   corev1.DisruptionTarget,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		},
+	}
+	if spec.RestartPolicy == kubeflowv1.RestartPolicyExitCode {
+		var permanentExitCodes []int32
+		for exitCode := int32(1); !trainutil.IsRetryableExitCode(exitCode); exitCode++ {
+			permanentExitCodes = append(permanentExitCodes, exitCode)
+		}
+		policy.Rules = append(policy.Rules, batchv1.PodFailurePolicyRule{
+			Action: batchv1.PodFailurePolicyActionFailJob,
+			OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				Operator: batchv1.PodFailurePolicyOnExitCodesOpIn,
+				Values:   permanentExitCodes,
+			},
+		})
+	}
+	return policy
+}
+
+// syncLauncherJob suspends the launcher Job once the MPIJob is suspended, and deletes it
+// according to the CleanPodPolicy once the MPIJob is finished. The Job is resumed with the MPIJob
+// when its pods are reconciled.
+func (jc *MPIJobReconciler) syncLauncherJob(mpiJob *kubeflowv1.MPIJob) error {
+	launcher, err := jc.getLauncherJob(mpiJob)
+	if err != nil || launcher == nil || launcher.DeletionTimestamp != nil {
+		return err
+	}
+
+	if commonutil.IsFinished(mpiJob.Status) {
+		cleanPodPolicy := ptr.Deref(mpiJob.Spec.RunPolicy.CleanPodPolicy, kubeflowv1.CleanPodPolicyNone)
+		if cleanPodPolicy == kubeflowv1.CleanPodPolicyAll ||
+			(cleanPodPolicy == kubeflowv1.CleanPodPolicyRunning && !launcherJobState(launcher).finished()) {
+			err = jc.Delete(context.Background(), launcher, client.PropagationPolicy(metav1.DeletePropagationBackground))
+			return client.IgnoreNotFound(err)
+		}
+		return nil
+	}
+
+	if commonutil.IsSuspended(mpiJob.Status) && trainutil.IsJobSuspended(&mpiJob.Spec.RunPolicy) &&
+		!ptr.Deref(launcher.Spec.Suspend, false) {
+		launcher = launcher.DeepCopy()
+		launcher.Spec.Suspend = ptr.To(true)
+		return jc.Update(context.Background(), launcher)
+	}
+	return nil
+}
+
+// resumeLauncherJob resumes the launcher Job suspended with the MPIJob.
+func (jc *MPIJobReconciler) resumeLauncherJob(launcher *batchv1.Job) error {
+	if !ptr.Deref(launcher.Spec.Suspend, false) {
+		return nil
+	}
+	launcher = launcher.DeepCopy()
+	launcher.Spec.Suspend = ptr.To(false)
+	return jc.Update(context.Background(), launcher)
+}
+
+// getLauncherJobPods gets the pods of the launcher Job.
+func (jc *MPIJobReconciler) getLauncherJobPods(launcher *batchv1.Job) ([]*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	err := jc.List(context.Background(), podList,
+		client.MatchingLabels(launcher.Spec.Template.Labels), client.InNamespace(launcher.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	var pods []*corev1.Pod
+	for i := range podList.Items {
+		if metav1.IsControlledBy(&podList.Items[i], launcher) {
+			pods = append(pods, &podList.Items[i])
+		}
+	}
+	return pods, nil
+}
+
+// GetCheckpointPodsForJob returns the pods of the launcher Job, the launcher has the master role but
+// its pods aren't returned by GetPodsForJob.
+func (jc *MPIJobReconciler) GetCheckpointPodsForJob(job interface{}) ([]*corev1.Pod, error) {
+	mpiJob, ok := job.(*kubeflowv1.MPIJob)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a type of MPIJob", job)
+	}
+	launcher, err := jc.getLauncherJob(mpiJob)
+	if err != nil || launcher == nil {
+		return nil, err
+	}
+	return jc.getLauncherJobPods(launcher)
+}
+
+// launcherFailureDetails returns the details of the failure of the launcher pods, the pods
+// of the launcher Job aren't replicas of the MPIJob.
+func (jc *MPIJobReconciler) launcherFailureDetails(launcher *batchv1.Job, legacyLauncher *corev1.Pod,
+	replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec) (*kubeflowv1.FailureDetails, error) {
+	pods := []*corev1.Pod{legacyLauncher}
+	if launcher != nil {
+		var err error
+		if pods, err = jc.getLauncherJobPods(launcher); err != nil {
+			return nil, err
+		}
+	}
+	return core.GetFailureDetails(pods, replicas), nil
+}
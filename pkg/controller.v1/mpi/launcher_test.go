@@ -0,0 +1,267 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpi
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/common/util"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+func TestLauncherJobState(t *testing.T) {
+	cases := map[string]struct {
+		job  *batchv1.Job
+		want launcherState
+	}{
+		"pending": {
+			job: &batchv1.Job{Status: batchv1.JobStatus{Active: 1, Ready: ptr.To[int32](0)}},
+		},
+		"running": {
+			job:  &batchv1.Job{Status: batchv1.JobStatus{Active: 1, Ready: ptr.To[int32](1)}},
+			want: launcherState{running: true},
+		},
+		"running after restarts": {
+			job:  &batchv1.Job{Status: batchv1.JobStatus{Active: 1, Failed: 2, Ready: ptr.To[int32](1)}},
+			want: launcherState{running: true, restarts: 2},
+		},
+		"complete": {
+			job: &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}}},
+			want: launcherState{succeeded: true},
+		},
+		"failed while terminating the pods": {
+			job: &batchv1.Job{Status: batchv1.JobStatus{Failed: 4, Ready: ptr.To[int32](1), Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+			}}},
+			want: launcherState{failed: true, reason: "BackoffLimitExceeded", message: "Job has reached the specified backoff limit", restarts: 3},
+		},
+		"suspended": {
+			job: &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobSuspended, Status: corev1.ConditionTrue},
+			}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := launcherJobState(tc.job); got != tc.want {
+				t.Errorf("Unexpected launcher state: \nwant: %+v\ngot: %+v\n", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLauncherBackoffLimit(t *testing.T) {
+	cases := map[string]struct {
+		backoffLimit  *int32
+		restartPolicy kubeflowv1.RestartPolicy
+		want          int32
+	}{
+		"backoff limit of the MPIJob": {
+			backoffLimit:  ptr.To[int32](3),
+			restartPolicy: kubeflowv1.RestartPolicyNever,
+			want:          3,
+		},
+		"the failed launcher isn't restarted": {
+			restartPolicy: kubeflowv1.RestartPolicyNever,
+			want:          0,
+		},
+		"the failed launcher is restarted": {
+			restartPolicy: kubeflowv1.RestartPolicyOnFailure,
+			want:          math.MaxInt32,
+		},
+		"the launcher is restarted on retryable exit codes": {
+			restartPolicy: kubeflowv1.RestartPolicyExitCode,
+			want:          math.MaxInt32,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			runPolicy := kubeflowv1.RunPolicy{BackoffLimit: tc.backoffLimit}
+			spec := &kubeflowv1.ReplicaSpec{RestartPolicy: tc.restartPolicy}
+			if got := launcherBackoffLimit(runPolicy, spec); got != tc.want {
+				t.Errorf("Unexpected backoff limit: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsStaleLauncherJob(t *testing.T) {
+	newLauncher := func(labels map[string]string) *batchv1.Job {
+		return &batchv1.Job{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: labels}}}}
+	}
+	cases := map[string]struct {
+		restartScope *kubeflowv1.RestartScope
+		launcher     *batchv1.Job
+		want         bool
+	}{
+		"pod restart scope": {
+			launcher: newLauncher(map[string]string{kubeflowv1.RestartGenerationLabel: "0"}),
+		},
+		"launcher of the current generation": {
+			restartScope: ptr.To(kubeflowv1.RestartScopeJob),
+			launcher:     newLauncher(map[string]string{kubeflowv1.RestartGenerationLabel: "1"}),
+		},
+		"launcher of a previous generation": {
+			restartScope: ptr.To(kubeflowv1.RestartScopeJob),
+			launcher:     newLauncher(map[string]string{kubeflowv1.RestartGenerationLabel: "0"}),
+			want:         true,
+		},
+		"launcher without generation": {
+			restartScope: ptr.To(kubeflowv1.RestartScopeJob),
+			launcher:     newLauncher(nil),
+			want:         true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			runPolicy := &kubeflowv1.RunPolicy{RestartScope: tc.restartScope}
+			if got := isStaleLauncherJob(tc.launcher, runPolicy, 1); got != tc.want {
+				t.Errorf("Unexpected stale launcher: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewLauncherPodFailurePolicy(t *testing.T) {
+	policy := newLauncherPodFailurePolicy(&kubeflowv1.ReplicaSpec{RestartPolicy: kubeflowv1.RestartPolicyNever})
+	if len(policy.Rules) != 1 || policy.Rules[0].Action != batchv1.PodFailurePolicyActionIgnore {
+		t.Errorf("Expected the disrupted pods to be ignored, got: %+v", policy.Rules)
+	}
+
+	policy = newLauncherPodFailurePolicy(&kubeflowv1.ReplicaSpec{RestartPolicy: kubeflowv1.RestartPolicyExitCode})
+	if len(policy.Rules) != 2 || policy.Rules[1].Action != batchv1.PodFailurePolicyActionFailJob {
+		t.Fatalf("Expected the permanent exit codes to fail the Job, got: %+v", policy.Rules)
+	}
+	exitCodes := policy.Rules[1].OnExitCodes.Values
+	if exitCodes[0] != 1 || exitCodes[len(exitCodes)-1] != 127 {
+		t.Errorf("Unexpected permanent exit codes: \nwant: 1-127\ngot: %v-%v\n", exitCodes[0], exitCodes[len(exitCodes)-1])
+	}
+}
+
+// The launcher pods are owned by the launcher Job, the checkpoint of a suspended MPIJob waits for them.
+func TestSuspendWaitsForLauncherCheckpoint(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := kubeflowv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	mpiJob := &kubeflowv1.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "mpijob-uid"},
+		Spec: kubeflowv1.MPIJobSpec{
+			MPIReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+				kubeflowv1.MPIJobReplicaTypeLauncher: {Replicas: ptr.To[int32](1)},
+				kubeflowv1.MPIJobReplicaTypeWorker:   {Replicas: ptr.To[int32](1)},
+			},
+			RunPolicy: kubeflowv1.RunPolicy{
+				Suspend:             ptr.To(true),
+				GracefulTermination: &kubeflowv1.GracefulTermination{},
+			},
+		},
+	}
+	commonutil.UpdateJobConditions(&mpiJob.Status, kubeflowv1.JobRunning, corev1.ConditionTrue, "Running", "")
+
+	jc := &MPIJobReconciler{Scheme: scheme, recorder: record.NewFakeRecorder(10), Log: log.Log}
+	podControl := &control.FakePodControl{}
+	jc.JobController = common.JobController{
+		Controller:     jc,
+		Expectations:   expectation.NewControllerExpectations(),
+		WorkQueue:      &util.FakeWorkQueue{},
+		Recorder:       jc.recorder,
+		PodControl:     podControl,
+		ServiceControl: &control.FakeServiceControl{},
+	}
+	launcherLabels := defaultLauncherLabels(jc.GenLabels(mpiJob.Name))
+	launcherLabels[kubeflowv1.JobRoleLabel] = "master"
+	launcher := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            mpiJob.Name + launcherSuffix,
+			Namespace:       mpiJob.Namespace,
+			UID:             "launcher-uid",
+			OwnerReferences: []metav1.OwnerReference{*jc.GenOwnerReference(mpiJob)},
+		},
+		Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: launcherLabels}}},
+	}
+	launcherPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      launcher.Name + "-abcde",
+			Namespace: mpiJob.Namespace,
+			Labels:    launcherLabels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "batch/v1", Kind: "Job", Name: launcher.Name, UID: launcher.UID, Controller: ptr.To(true),
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	workerLabels := jc.GenLabels(mpiJob.Name)
+	workerLabels[kubeflowv1.ReplicaTypeLabel] = "worker"
+	workerLabels[kubeflowv1.ReplicaIndexLabel] = "0"
+	worker := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            mpiJob.Name + workerSuffix + "-0",
+			Namespace:       mpiJob.Namespace,
+			Labels:          workerLabels,
+			OwnerReferences: []metav1.OwnerReference{*jc.GenOwnerReference(mpiJob)},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	jc.Client = fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mpiJob, launcher, launcherPod, worker).
+		WithStatusSubresource(&kubeflowv1.MPIJob{}).Build()
+
+	if err := jc.ReconcileJobs(mpiJob, mpiJob.Spec.MPIReplicaSpecs, mpiJob.Status, &mpiJob.Spec.RunPolicy); err != nil {
+		t.Fatalf("ReconcileJobs() error = %v", err)
+	}
+	if len(podControl.DeletePodName) != 0 {
+		t.Errorf("Unexpected deleted pods during the checkpoint: %v", podControl.DeletePodName)
+	}
+	if len(podControl.Patches) != 2 {
+		t.Errorf("Unexpected pod patches: \nwant: 2\ngot: %v\n", len(podControl.Patches))
+	}
+	got := &kubeflowv1.MPIJob{}
+	if err := jc.Get(context.Background(), client.ObjectKeyFromObject(mpiJob), got); err != nil {
+		t.Fatal(err)
+	}
+	checkpointing := false
+	for _, condition := range got.Status.Conditions {
+		checkpointing = checkpointing || condition.Type == kubeflowv1.JobCheckpointing && condition.Status == corev1.ConditionTrue
+	}
+	if !checkpointing {
+		t.Errorf("Expected the MPIJob to wait for the checkpoint, got conditions %v", got.Status.Conditions)
+	}
+	if commonutil.IsSuspended(got.Status) {
+		t.Errorf("Unexpected suspended MPIJob during the checkpoint")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -79,11 +80,7 @@ const (
 
 // initializeMPIJobStatuses initializes the ReplicaStatuses for MPIJob.
 func initializeMPIJobStatuses(mpiJob *kubeflowv1.MPIJob, rType kubeflowv1.ReplicaType) {
-	if mpiJob.Status.ReplicaStatuses == nil {
-		mpiJob.Status.ReplicaStatuses = make(map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus)
-	}
-
-	mpiJob.Status.ReplicaStatuses[rType] = &kubeflowv1.ReplicaStatus{}
+	core.InitializeReplicaStatuses(&mpiJob.Status, rType)
 }
 
 // updateMPIJobConditions updates the conditions of the given mpiJob.
@@ -173,10 +170,6 @@ func filterOutCondition(conditions []kubeflowv1.JobCondition, condType kubeflowv
 	return newConditions
 }
 
-func isPodFinished(j *corev1.Pod) bool {
-	return isPodSucceeded(j) || isPodFailed(j)
-}
-
 func isPodFailed(p *corev1.Pod) bool {
 	return p.Status.Phase == corev1.PodFailed
 }
@@ -260,9 +253,5 @@ func workerSelector(genericLabels map[string]string) (labels.Selector, error) {
 // initializeReplicaStatuses initializes the ReplicaStatuses for replica.
 // originally from pkg/controller.v1/tensorflow/status.go (deleted)
 func initializeReplicaStatuses(jobStatus *kubeflowv1.JobStatus, rtype kubeflowv1.ReplicaType) {
-	if jobStatus.ReplicaStatuses == nil {
-		jobStatus.ReplicaStatuses = make(map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus)
-	}
-
-	jobStatus.ReplicaStatuses[rtype] = &kubeflowv1.ReplicaStatus{}
+	core.InitializeReplicaStatuses(jobStatus, rtype)
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -38,6 +39,7 @@ import (
 	kubeclientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -57,6 +59,7 @@ import (
 	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	"github.com/kubeflow/training-operator/pkg/core"
 	commonutil "github.com/kubeflow/training-operator/pkg/util"
 )
 
@@ -90,8 +93,9 @@ func NewReconciler(mgr manager.Manager, gangSchedulingSetupFunc common.GangSched
 		KubeClientSet:               kubeClientSet,
 		PriorityClassLister:         priorityClassInformer.Lister(),
 		PriorityClassInformerSynced: priorityClassInformer.Informer().HasSynced,
-		PodControl:                  control.RealPodControl{KubeClient: kubeClientSet, Recorder: r.recorder},
+		PodControl:                  control.RealPodControl{KubeClient: kubeClientSet, Recorder: r.recorder, Config: cfg},
 		ServiceControl:              control.RealServiceControl{KubeClient: kubeClientSet, Recorder: r.recorder},
+		JobReader:                   mgr.GetClient(),
 	}
 
 	gangSchedulingSetupFunc(&r.JobController)
@@ -118,6 +122,10 @@ type MPIJobReconciler struct {
 //+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=list;watch;create;update
 //+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=rolebindings,verbs=list;watch;create;update
 //+kubebuilder:rbac:groups="",resources=pods/exec,verbs=create
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;create
+//+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;delete
 //+kubebuilder:rbac:groups=scheduling.volcano.sh,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
@@ -132,9 +140,25 @@ func (jc *MPIJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	err := jc.Get(ctx, req.NamespacedName, mpijob)
 	if err != nil {
 		logger.Info(err.Error(), "unable to fetch MPIJob", req.NamespacedName.String())
+		if errors.IsNotFound(err) {
+			trainingoperatorcommon.ActiveJobsGaugeDelete(req.NamespacedName.String(), jc.GetFrameworkName())
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Merge the training runtime before the job is validated, the job may leave the runtime's fields unset.
+	if err = common.ApplyTrainingRuntime(jc.Client, jc.apiReader, mpijob, jc.GetAPIGroupVersionKind(),
+		mpijob.Spec.MPIReplicaSpecs, &mpijob.Spec.RunPolicy); err != nil {
+		logger.Error(err, "MPIJob failed to resolve the training runtime")
+		jc.Recorder.Eventf(mpijob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobFailedRuntimeReason),
+			"MPIJob failed to resolve the training runtime because %s", err)
+		return ctrl.Result{}, err
+	}
+	if mpijob.Spec.RunPolicy.RuntimeRef != nil {
+		// The job isn't defaulted at admission when it references a training runtime.
+		jc.Scheme.Default(mpijob)
+	}
+
 	if err = kubeflowv1.ValidateV1MpiJobSpec(&mpijob.Spec); err != nil {
 		logger.Error(err, "MPIJob failed validation")
 		jc.Recorder.Eventf(mpijob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobFailedValidationReason),
@@ -160,19 +184,40 @@ func (jc *MPIJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	mpijob.Spec.CleanPodPolicy = cleanPolicyDefined
 	mpijob.Spec.RunPolicy.CleanPodPolicy = cleanPolicyDefined
 
+	// The pods of the launcher Job are not controlled by the MPIJob,
+	// the Job is suspended and cleaned up with the MPIJob instead.
+	if err = jc.syncLauncherJob(mpijob); err != nil {
+		logrus.Warnf("Sync launcher Job of MPIJob error %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if err = jc.ReconcileHPA(mpijob); err != nil {
+		logrus.Warnf("Reconcile HPA of MPIJob error %v", err)
+		return ctrl.Result{}, err
+	}
+
 	// Use common to reconcile the job related pod and service
 	// MPIJob needs not service
-	err = jc.ReconcileJobs(mpijob, mpijob.Spec.MPIReplicaSpecs, mpijob.Status, &mpijob.Spec.RunPolicy)
+	err = jc.ReconcileJobs(mpijob, mpijob.Spec.MPIReplicaSpecs, mpijob.Status, runPolicyForMPIJob(mpijob))
 	if err != nil {
 		logrus.Warnf("Reconcile MPIJob error %v", err)
 		return ctrl.Result{}, err
 	}
 
-	t, err := util.DurationUntilExpireTime(&mpijob.Spec.RunPolicy, mpijob.Status)
+	t, err := util.DurationUntilRequeue(&mpijob.Spec.RunPolicy, mpijob.Spec.MPIReplicaSpecs, mpijob.Status)
 	if err != nil {
 		logrus.Warnf("Reconcile MPIJob Job error %v", err)
 		return ctrl.Result{}, err
 	}
+	// Requeue an elastic job to drain its scaled down workers once the launcher synced discover_hosts.sh.
+	drain, err := jc.durationUntilWorkerDrain(mpijob)
+	if err != nil {
+		logrus.Warnf("Reconcile MPIJob Job error %v", err)
+		return ctrl.Result{}, err
+	}
+	if drain >= 0 && (t < 0 || drain < t) {
+		t = drain
+	}
 	if t >= 0 {
 		return ctrl.Result{Requeue: true, RequeueAfter: t}, nil
 	}
@@ -214,6 +259,10 @@ func (jc *MPIJobReconciler) SetupWithManager(mgr ctrl.Manager, controllerThreads
 	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Pod{}), eventHandler, predicates); err != nil {
 		return err
 	}
+	// inject watching for job related launcher Job
+	if err = c.Watch(source.Kind(mgr.GetCache(), &batchv1.Job{}), eventHandler, genericPredicates); err != nil {
+		return err
+	}
 	// inject watching for job related ConfigMap
 	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.ConfigMap{}), eventHandler, genericPredicates); err != nil {
 		return err
@@ -249,6 +298,10 @@ func (jc *MPIJobReconciler) SetupWithManager(mgr ctrl.Manager, controllerThreads
 			return err
 		}
 	}
+	// inject watching for the jobs the MPIJobs depend on
+	if err = common.WatchDependencies(mgr, c, kubeflowv1.MPIJobKind); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -338,6 +391,7 @@ func (jc *MPIJobReconciler) ReconcilePods(
 	if !ok {
 		return fmt.Errorf("%v is not a type of MPIJob", mpiJob)
 	}
+	runPolicy := runPolicyForMPIJob(mpiJob)
 
 	// first set StartTime.
 	if jobStatus.StartTime == nil {
@@ -347,15 +401,44 @@ func (jc *MPIJobReconciler) ReconcilePods(
 
 	initializeReplicaStatuses(jobStatus, rtype)
 
-	// Get the launcher Job for this MPIJob.
-	launcher, err := jc.getLauncherJob(mpiJob)
+	// The MPIJobs started before the launcher ran as a Job keep their launcher Pod.
+	legacyLauncher, err := jc.getLauncherPod(mpiJob)
 	if err != nil {
 		return err
 	}
 
+	var launcher *batchv1.Job
+	if legacyLauncher == nil {
+		// Get the launcher Job for this MPIJob.
+		launcher, err = jc.getLauncherJob(mpiJob)
+		if err != nil {
+			return err
+		}
+		// The launcher Job of a previous restart generation is replaced once it is deleted.
+		if launcher != nil && isStaleLauncherJob(launcher, runPolicy, jobStatus.RestartGeneration) {
+			if launcher.DeletionTimestamp == nil {
+				err = jc.Delete(context.Background(), launcher, client.PropagationPolicy(metav1.DeletePropagationBackground))
+				return client.IgnoreNotFound(err)
+			}
+			return nil
+		}
+	}
+
+	var state *launcherState
+	if launcher != nil {
+		state = ptr.To(launcherJobState(launcher))
+	} else if legacyLauncher != nil {
+		state = ptr.To(launcherPodState(legacyLauncher))
+	}
+	if state != nil && state.failed && jobStatus.FailureDetails == nil {
+		if jobStatus.FailureDetails, err = jc.launcherFailureDetails(launcher, legacyLauncher, replicas); err != nil {
+			return err
+		}
+	}
+
 	var worker []*corev1.Pod
 	// We're done if the launcher either succeeded or failed.
-	done := launcher != nil && isPodFinished(launcher)
+	done := state != nil && state.finished()
 
 	if !done {
 		workerSpec := mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeWorker]
@@ -365,9 +448,13 @@ func (jc *MPIJobReconciler) ReconcilePods(
 		}
 		isGPULauncher := isGPULauncher(mpiJob)
 
-		// Get the launcher ServiceAccount for this MPIJob.
-		if sa, err := jc.getOrCreateLauncherServiceAccount(mpiJob); sa == nil || err != nil {
-			return err
+		sshMode := isSSHLauncherMode(mpiJob)
+
+		if !sshMode {
+			// Get the launcher ServiceAccount for this MPIJob.
+			if sa, err := jc.getOrCreateLauncherServiceAccount(mpiJob); sa == nil || err != nil {
+				return err
+			}
 		}
 
 		// Get the ConfigMap for this MPIJob.
@@ -375,14 +462,26 @@ func (jc *MPIJobReconciler) ReconcilePods(
 			return err
 		}
 
-		// Get the launcher Role for this MPIJob.
-		if r, err := jc.getOrCreateLauncherRole(mpiJob, workerReplicas); r == nil || err != nil {
-			return err
-		}
+		if sshMode {
+			// Get the SSH keypair Secret for this MPIJob.
+			if secret, err := jc.getOrCreateSSHAuthSecret(mpiJob); secret == nil || err != nil {
+				return err
+			}
 
-		// Get the launcher RoleBinding for this MPIJob.
-		if rb, err := jc.getLauncherRoleBinding(mpiJob); rb == nil || err != nil {
-			return err
+			// Get the headless Service for this MPIJob.
+			if svc, err := jc.getOrCreateService(mpiJob); svc == nil || err != nil {
+				return err
+			}
+		} else {
+			// Get the launcher Role for this MPIJob.
+			if r, err := jc.getOrCreateLauncherRole(mpiJob, workerReplicas); r == nil || err != nil {
+				return err
+			}
+
+			// Get the launcher RoleBinding for this MPIJob.
+			if rb, err := jc.getLauncherRoleBinding(mpiJob); rb == nil || err != nil {
+				return err
+			}
 		}
 
 		worker, err = jc.getOrCreateWorker(mpiJob)
@@ -390,30 +489,47 @@ func (jc *MPIJobReconciler) ReconcilePods(
 			return err
 		}
 
-		if launcher == nil {
-			launcher, err = jc.KubeClientSet.CoreV1().Pods(mpiJob.Namespace).Create(context.Background(), jc.newLauncher(mpiJob, ctlrconfig.Config.MPIKubectlDeliveryImage, isGPULauncher), metav1.CreateOptions{})
+		var pendingDependency string
+		if state == nil {
+			pendingDependency, err = jc.PendingStartupDependency(runPolicy, kubeflowv1.MPIJobReplicaTypeLauncher, pods, replicas)
 			if err != nil {
-				jc.Recorder.Eventf(mpiJob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobFailedReason), "launcher pod created failed: %v", err)
+				return err
+			}
+		}
+
+		if state == nil && len(pendingDependency) != 0 {
+			logrus.Infof("MPIJob %s/%s waits for the startup dependencies of the launcher: %s", mpiJob.Namespace, mpiJob.Name, pendingDependency)
+		} else if state == nil {
+			launcher, err = jc.KubeClientSet.BatchV1().Jobs(mpiJob.Namespace).Create(context.Background(), jc.newLauncherJob(mpiJob, ctlrconfig.Config.MPIKubectlDeliveryImage, isGPULauncher), metav1.CreateOptions{})
+			if err != nil {
+				jc.Recorder.Eventf(mpiJob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobFailedReason), "launcher job created failed: %v", err)
 				return err
 			} else {
-				jc.Recorder.Eventf(mpiJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobRunningReason), "launcher pod created success: %v", launcher.Name)
+				jc.Recorder.Eventf(mpiJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobRunningReason), "launcher job created success: %v", launcher.Name)
+			}
+			state = ptr.To(launcherJobState(launcher))
+		} else if launcher != nil {
+			// The launcher Job was suspended with the MPIJob.
+			if err = jc.resumeLauncherJob(launcher); err != nil {
+				return err
 			}
 		}
 	}
 
 	// Finally, we update the status block of the MPIJob resource to reflect the
 	// current state of the world.
-	err = jc.updateMPIJobStatus(mpiJob, launcher, worker)
+	err = jc.updateMPIJobStatus(mpiJob, state, worker)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launcher *corev1.Pod, worker []*corev1.Pod) error {
+func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launcher *launcherState, worker []*corev1.Pod) error {
 	if launcher != nil {
 		initializeMPIJobStatuses(mpiJob, kubeflowv1.MPIJobReplicaTypeLauncher)
-		if isPodSucceeded(launcher) {
+		mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeLauncher].Restarts = launcher.restarts
+		if launcher.succeeded {
 			mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeLauncher].Succeeded = 1
 			msg := fmt.Sprintf("MPIJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
 			jc.Recorder.Event(mpiJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.MPIJobPlural, commonutil.JobSucceededReason), msg)
@@ -425,10 +541,13 @@ func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launch
 			if err != nil {
 				return err
 			}
-		} else if isPodFailed(launcher) {
+		} else if launcher.failed {
 			mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeLauncher].Failed = 1
 			msg := fmt.Sprintf("MPIJob %s/%s has failed", mpiJob.Namespace, mpiJob.Name)
-			reason := launcher.Status.Reason
+			if len(launcher.message) != 0 {
+				msg = fmt.Sprintf("%s: %s", msg, launcher.message)
+			}
+			reason := launcher.reason
 			if reason == "" {
 				reason = commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobFailedReason)
 			}
@@ -445,8 +564,9 @@ func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launch
 				return err
 			}
 
-		} else if isPodRunning(launcher) {
+		} else if launcher.running {
 			mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeLauncher].Active = 1
+			mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeLauncher].Ready = 1
 		}
 	}
 
@@ -456,6 +576,10 @@ func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launch
 	)
 
 	initializeMPIJobStatuses(mpiJob, kubeflowv1.MPIJobReplicaTypeWorker)
+	// The selector of the workers is the selector of the scale subresource.
+	mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeWorker].Selector = metav1.FormatLabelSelector(&metav1.LabelSelector{
+		MatchLabels: defaultWorkerLabels(jc.GenLabels(mpiJob.Name)),
+	})
 	for i := 0; i < len(worker); i++ {
 		switch worker[i].Status.Phase {
 		case corev1.PodFailed:
@@ -469,6 +593,7 @@ func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launch
 			running += 1
 			mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeWorker].Active += 1
 		}
+		core.UpdateReplicaPodStatus(mpiJob.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeWorker], worker[i])
 	}
 	if evict > 0 {
 		msg := fmt.Sprintf("%d/%d workers are evicted", evict, len(worker))
@@ -478,7 +603,7 @@ func (jc *MPIJobReconciler) updateMPIJobStatus(mpiJob *kubeflowv1.MPIJob, launch
 		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobEvict, msg)
 	}
 
-	if launcher != nil && launcher.Status.Phase == corev1.PodRunning && running == len(worker) {
+	if launcher != nil && launcher.running && running == len(worker) {
 		msg := fmt.Sprintf("MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
 		err := updateMPIJobConditions(mpiJob, kubeflowv1.JobRunning, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobRunningReason), msg)
 		if err != nil {
@@ -581,8 +706,8 @@ func (jc *MPIJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubefl
 				msg := fmt.Sprintf("MPIJob %s is running.", mpiJob.Name)
 				commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobRunning, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobRunningReason), msg)
 			}
-			// when launcher is succeed, the job is finished.
-			if expected == 0 {
+			// when launcher is succeed, the job is finished, unless the success policy decides it.
+			if expected == 0 && mpiJob.Spec.RunPolicy.SuccessPolicy == nil {
 				msg := fmt.Sprintf("MPIJob %s is successfully completed.", mpiJob.Name)
 				logrus.Info(msg)
 				jc.Recorder.Event(mpiJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.MPIJobKind, commonutil.JobSucceededReason), msg)
@@ -651,31 +776,6 @@ func (jc *MPIJobReconciler) UpdateJobStatusInApiServer(job interface{}, jobStatu
 	return nil
 }
 
-// getLauncherJob gets the launcher Job controlled by this MPIJob.
-func (jc *MPIJobReconciler) getLauncherJob(mpiJob *kubeflowv1.MPIJob) (*corev1.Pod, error) {
-	launcher := &corev1.Pod{}
-	NamespacedName := types.NamespacedName{Namespace: mpiJob.Namespace, Name: mpiJob.Name + launcherSuffix}
-	err := jc.Get(context.Background(), NamespacedName, launcher)
-	if errors.IsNotFound(err) {
-		return nil, nil
-	}
-	if err != nil {
-		// If an error occurs during Get, we'll requeue the item so we can
-		// attempt processing again later. This could have been caused by a
-		// temporary network failure, or any other transient reason.
-		return nil, err
-	}
-
-	// If the launcher is not controlled by this MPIJob resource, we should log
-	// a warning to the event recorder and return.
-	if !metav1.IsControlledBy(launcher, mpiJob) {
-		msg := fmt.Sprintf(MessageResourceExists, launcher.Name, launcher.Kind)
-		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
-		return launcher, fmt.Errorf(msg)
-	}
-	return launcher, nil
-}
-
 // getOrCreateConfigMap gets the ConfigMap controlled by this MPIJob, or creates
 // one if it doesn't exist.
 func (jc *MPIJobReconciler) getOrCreateConfigMap(mpiJob *kubeflowv1.MPIJob, workerReplicas int32, isGPULauncher bool) (*corev1.ConfigMap, error) {
@@ -711,6 +811,9 @@ func (jc *MPIJobReconciler) getOrCreateConfigMap(mpiJob *kubeflowv1.MPIJob, work
 
 	// If the ConfigMap is changed, update it
 	if !reflect.DeepEqual(cm.Data, newCM.Data) {
+		if isElastic(mpiJob) {
+			setDiscoverHostsUpdateTime(newCM, cm)
+		}
 		cm, err = jc.KubeClientSet.CoreV1().ConfigMaps(mpiJob.Namespace).Update(context.Background(), newCM, metav1.UpdateOptions{})
 		if err != nil {
 			return nil, err
@@ -849,7 +952,11 @@ func (jc *MPIJobReconciler) getOrCreateWorker(mpiJob *kubeflowv1.MPIJob) ([]*cor
 	if err != nil {
 		return nil, err
 	}
-	if len(podlist.Items) > int(*workerReplicas) {
+	if len(podlist.Items) > int(*workerReplicas) && isElastic(mpiJob) {
+		if err = jc.drainScaledDownWorkers(mpiJob, podlist.Items, *workerReplicas); err != nil {
+			return nil, err
+		}
+	} else if len(podlist.Items) > int(*workerReplicas) {
 		for _, pod := range podlist.Items {
 			indexStr, ok := pod.Labels[kubeflowv1.ReplicaIndexLabel]
 			if !ok {
@@ -932,43 +1039,61 @@ func (jc *MPIJobReconciler) newWorker(mpiJob *kubeflowv1.MPIJob, name string) *c
 		podSpec.Labels[key] = value
 	}
 	setRestartPolicy(podSpec, mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeWorker])
+	if err := common.ApplyAdmission(mpiJob, string(kubeflowv1.MPIJobReplicaTypeWorker), podSpec); err != nil {
+		klog.Errorf("Failed to apply the admission to the worker pod: %v", err)
+	}
+	jc.ApplyTopologyPolicy(&mpiJob.Spec.RunPolicy, mpiJob, mpiJob.Spec.MPIReplicaSpecs, string(kubeflowv1.MPIJobReplicaTypeWorker), podSpec)
+	common.ApplyRestartScope(&mpiJob.Spec.RunPolicy, mpiJob.Status.RestartGeneration, podSpec)
 	logger := commonutil.LoggerForReplica(mpiJob, strings.ToLower(string(kubeflowv1.MPIJobReplicaTypeLauncher)))
 	if len(podSpec.Spec.Containers) == 0 {
 		klog.Errorln("Worker pod does not have any containers in its spec")
 		return nil
 	}
 	container := podSpec.Spec.Containers[0]
-	if len(container.Command) == 0 {
-		container.Command = []string{"sleep"}
-		container.Args = []string{"365d"}
-	}
+	if isSSHLauncherMode(mpiJob) {
+		if len(container.Command) == 0 {
+			container.Command = []string{"/usr/sbin/sshd"}
+			container.Args = []string{"-De"}
+		}
+		container.VolumeMounts = append(container.VolumeMounts, sshAuthVolumeMount(mpiJob))
+		podSpec.Spec.Containers[0] = container
+		podSpec.Spec.Volumes = append(podSpec.Spec.Volumes, sshAuthVolume(mpiJob))
+		// The workers are resolved by the launcher through the headless Service.
+		podSpec.Spec.Hostname = name
+		podSpec.Spec.Subdomain = mpiJob.Name
+	} else {
+		if len(container.Command) == 0 {
+			container.Command = []string{"sleep"}
+			container.Args = []string{"365d"}
+		}
 
-	// We need the kubexec.sh script here because Open MPI checks for the path
-	// in every rank.
-	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-		Name:      configVolumeName,
-		MountPath: configMountPath,
-	})
-	podSpec.Spec.Containers[0] = container
+		// We need the kubexec.sh script here because Open MPI checks for the path
+		// in every rank.
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      configVolumeName,
+			MountPath: configMountPath,
+		})
+		podSpec.Spec.Containers[0] = container
 
-	scriptMode := int32(0555)
-	podSpec.Spec.Volumes = append(podSpec.Spec.Volumes, corev1.Volume{
-		Name: configVolumeName,
-		VolumeSource: corev1.VolumeSource{
-			ConfigMap: &corev1.ConfigMapVolumeSource{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: mpiJob.Name + configSuffix,
-				},
-				Items: []corev1.KeyToPath{
-					{
-						Key:  kubexecScriptName,
-						Path: kubexecScriptName,
-						Mode: &scriptMode,
+		scriptMode := int32(0555)
+		podSpec.Spec.Volumes = append(podSpec.Spec.Volumes, corev1.Volume{
+			Name: configVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: mpiJob.Name + configSuffix,
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  kubexecScriptName,
+							Path: kubexecScriptName,
+							Mode: &scriptMode,
+						},
 					},
 				},
 			},
-		},
-	})
+		})
+	}
 
 	// if gang-scheduling is enabled:
 	// 1. if user has specified other scheduler, we report a warning without overriding any fields.
@@ -1033,47 +1158,17 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 		jc.PodGroupControl.DecoratePodTemplateSpec(podSpec, mpiJob, rt)
 	}
 
-	if len(mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher].Template.Spec.ServiceAccountName) == 0 {
-		podSpec.Spec.ServiceAccountName = launcherName
+	sshMode := isSSHLauncherMode(mpiJob)
+	if sshMode {
+		// The launcher is resolved through the headless Service when it's in the hostfile.
+		podSpec.Spec.Hostname = launcherName
+		podSpec.Spec.Subdomain = mpiJob.Name
+	} else {
+		if len(mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher].Template.Spec.ServiceAccountName) == 0 {
+			podSpec.Spec.ServiceAccountName = launcherName
+		}
+		podSpec.Spec.InitContainers = append(podSpec.Spec.InitContainers, newKubectlDeliveryContainer(mpiJob, kubectlDeliveryImage))
 	}
-
-	podSpec.Spec.InitContainers = append(podSpec.Spec.InitContainers, corev1.Container{
-		Name:            kubectlDeliveryName,
-		Image:           kubectlDeliveryImage,
-		ImagePullPolicy: corev1.PullIfNotPresent,
-		Env: []corev1.EnvVar{
-			{
-				Name:  kubectlTargetDirEnv,
-				Value: kubectlMountPath,
-			},
-			{
-				Name:  "NAMESPACE",
-				Value: mpiJob.Namespace,
-			},
-		},
-		VolumeMounts: []corev1.VolumeMount{
-			{
-				Name:      kubectlVolumeName,
-				MountPath: kubectlMountPath,
-			},
-			{
-				Name:      configVolumeName,
-				MountPath: configMountPath,
-			},
-		},
-		Resources: corev1.ResourceRequirements{
-			Limits: corev1.ResourceList{
-				corev1.ResourceCPU:              resource.MustParse(initContainerCpu),
-				corev1.ResourceMemory:           resource.MustParse(initContainerMem),
-				corev1.ResourceEphemeralStorage: resource.MustParse(initContainerEphStorage),
-			},
-			Requests: corev1.ResourceList{
-				corev1.ResourceCPU:              resource.MustParse(initContainerCpu),
-				corev1.ResourceMemory:           resource.MustParse(initContainerMem),
-				corev1.ResourceEphemeralStorage: resource.MustParse(initContainerEphStorage),
-			},
-		},
-	})
 	if len(podSpec.Spec.Containers) == 0 {
 		klog.Errorln("Launcher pod does not have any containers in its spec")
 		msg := fmt.Sprintf(MessageResourceDoesNotExist, "Launcher")
@@ -1081,16 +1176,33 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 		return nil
 	}
 	container := podSpec.Spec.Containers[0]
+	rshAgent := fmt.Sprintf("%s/%s", configMountPath, kubexecScriptName)
+	if sshMode {
+		rshAgent = sshRshAgent
+	}
 	container.Env = append(container.Env,
 		corev1.EnvVar{
 			Name:  "OMPI_MCA_plm_rsh_agent",
-			Value: fmt.Sprintf("%s/%s", configMountPath, kubexecScriptName),
+			Value: rshAgent,
 		},
 		corev1.EnvVar{
 			Name:  "OMPI_MCA_orte_default_hostfile",
 			Value: fmt.Sprintf("%s/%s", configMountPath, hostfileName),
 		},
 	)
+	if sshMode {
+		container.Env = append(container.Env,
+			corev1.EnvVar{
+				Name:  "OMPI_MCA_plm_rsh_args",
+				Value: sshRshArgs,
+			},
+			// Keep the host names of the hostfile, the short names of the pods don't resolve.
+			corev1.EnvVar{
+				Name:  "OMPI_MCA_orte_keep_fqdn_hostnames",
+				Value: "true",
+			},
+		)
+	}
 
 	if !isGPULauncher {
 		container.Env = append(container.Env,
@@ -1110,14 +1222,18 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 	// Add default Intel MPI bootstrap variables if not provided by the user.
 	bootstrap, exec := hasIntelMPIBootstrapValues(container.Env)
 	if !bootstrap {
+		iMPIBootstrap := iMPIDefaultBootstrap
+		if sshMode {
+			iMPIBootstrap = sshRshAgent
+		}
 		container.Env = append(container.Env,
 			corev1.EnvVar{
 				Name:  "I_MPI_HYDRA_BOOTSTRAP",
-				Value: iMPIDefaultBootstrap,
+				Value: iMPIBootstrap,
 			},
 		)
 	}
-	if !exec {
+	if !exec && !sshMode {
 		container.Env = append(container.Env,
 			corev1.EnvVar{
 				Name:  "I_MPI_HYDRA_BOOTSTRAP_EXEC",
@@ -1126,15 +1242,24 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 		)
 	}
 
-	container.VolumeMounts = append(container.VolumeMounts,
-		corev1.VolumeMount{
-			Name:      kubectlVolumeName,
-			MountPath: kubectlMountPath,
-		},
-		corev1.VolumeMount{
-			Name:      configVolumeName,
-			MountPath: configMountPath,
-		})
+	if sshMode {
+		container.VolumeMounts = append(container.VolumeMounts,
+			sshAuthVolumeMount(mpiJob),
+			corev1.VolumeMount{
+				Name:      configVolumeName,
+				MountPath: configMountPath,
+			})
+	} else {
+		container.VolumeMounts = append(container.VolumeMounts,
+			corev1.VolumeMount{
+				Name:      kubectlVolumeName,
+				MountPath: kubectlMountPath,
+			},
+			corev1.VolumeMount{
+				Name:      configVolumeName,
+				MountPath: configMountPath,
+			})
+	}
 	podSpec.Spec.Containers[0] = container
 
 	// Submit a warning event if the user specifies restart policy for
@@ -1145,16 +1270,43 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, podTemplateRestartPolicyReason, errMsg)
 	}
 	setRestartPolicy(podSpec, mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher])
+	if err := common.ApplyAdmission(mpiJob, string(kubeflowv1.MPIJobReplicaTypeLauncher), podSpec); err != nil {
+		klog.Errorf("Failed to apply the admission to the launcher pod: %v", err)
+	}
 
 	scriptsMode := int32(0555)
 	hostfileMode := int32(0444)
-	podSpec.Spec.Volumes = append(podSpec.Spec.Volumes,
-		corev1.Volume{
-			Name: kubectlVolumeName,
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
+	configItems := []corev1.KeyToPath{
+		{
+			Key:  hostfileName,
+			Path: hostfileName,
+			Mode: &hostfileMode,
+		},
+		{
+			Key:  discoverHostsScriptName,
+			Path: discoverHostsScriptName,
+			Mode: &scriptsMode,
 		},
+	}
+	if sshMode {
+		podSpec.Spec.Volumes = append(podSpec.Spec.Volumes, sshAuthVolume(mpiJob))
+	} else {
+		configItems = append([]corev1.KeyToPath{
+			{
+				Key:  kubexecScriptName,
+				Path: kubexecScriptName,
+				Mode: &scriptsMode,
+			},
+		}, configItems...)
+		podSpec.Spec.Volumes = append(podSpec.Spec.Volumes,
+			corev1.Volume{
+				Name: kubectlVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+	}
+	podSpec.Spec.Volumes = append(podSpec.Spec.Volumes,
 		corev1.Volume{
 			Name: configVolumeName,
 			VolumeSource: corev1.VolumeSource{
@@ -1162,23 +1314,7 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 					LocalObjectReference: corev1.LocalObjectReference{
 						Name: mpiJob.Name + configSuffix,
 					},
-					Items: []corev1.KeyToPath{
-						{
-							Key:  kubexecScriptName,
-							Path: kubexecScriptName,
-							Mode: &scriptsMode,
-						},
-						{
-							Key:  hostfileName,
-							Path: hostfileName,
-							Mode: &hostfileMode,
-						},
-						{
-							Key:  discoverHostsScriptName,
-							Path: discoverHostsScriptName,
-							Mode: &scriptsMode,
-						},
-					},
+					Items: configItems,
 				},
 			},
 		})
@@ -1196,6 +1332,47 @@ func (jc *MPIJobReconciler) newLauncher(mpiJob *kubeflowv1.MPIJob, kubectlDelive
 	}
 }
 
+// newKubectlDeliveryContainer creates the init container delivering kubectl to the launcher.
+func newKubectlDeliveryContainer(mpiJob *kubeflowv1.MPIJob, kubectlDeliveryImage string) corev1.Container {
+	return corev1.Container{
+		Name:            kubectlDeliveryName,
+		Image:           kubectlDeliveryImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Env: []corev1.EnvVar{
+			{
+				Name:  kubectlTargetDirEnv,
+				Value: kubectlMountPath,
+			},
+			{
+				Name:  "NAMESPACE",
+				Value: mpiJob.Namespace,
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      kubectlVolumeName,
+				MountPath: kubectlMountPath,
+			},
+			{
+				Name:      configVolumeName,
+				MountPath: configMountPath,
+			},
+		},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse(initContainerCpu),
+				corev1.ResourceMemory:           resource.MustParse(initContainerMem),
+				corev1.ResourceEphemeralStorage: resource.MustParse(initContainerEphStorage),
+			},
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse(initContainerCpu),
+				corev1.ResourceMemory:           resource.MustParse(initContainerMem),
+				corev1.ResourceEphemeralStorage: resource.MustParse(initContainerEphStorage),
+			},
+		},
+	}
+}
+
 // getRunningWorkerPods get all worker Pods with Running phase controlled by this MPIJob.
 func (jc *MPIJobReconciler) getRunningWorkerPods(mpiJob *kubeflowv1.MPIJob) ([]*corev1.Pod, error) {
 	genericLabels := jc.GenLabels(mpiJob.GetName())
@@ -1210,10 +1387,15 @@ func (jc *MPIJobReconciler) getRunningWorkerPods(mpiJob *kubeflowv1.MPIJob) ([]*
 	if err != nil {
 		return nil, err
 	}
-	// Only running Pods should be included within the `discover_hosts.sh` script.
+	var workerReplicas int32
+	if workerSpec := mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeWorker]; workerSpec != nil && workerSpec.Replicas != nil {
+		workerReplicas = *workerSpec.Replicas
+	}
+	// Only running Pods should be included within the `discover_hosts.sh` script,
+	// the workers being scaled down are removed from it before they are deleted.
 	var podList []corev1.Pod
 	for idx, pod := range podFullList.Items {
-		if pod.Status.Phase == corev1.PodRunning {
+		if pod.Status.Phase == corev1.PodRunning && workerIndex(&podFullList.Items[idx]) < int(workerReplicas) {
 			podList = append(podList, podFullList.Items[idx])
 		}
 	}
@@ -1241,13 +1423,13 @@ shift
 	}
 	var buffer bytes.Buffer
 	if isGPULauncher {
-		buffer.WriteString(fmt.Sprintf("%s%s slots=%d\n", mpiJob.Name, launcherSuffix, slots))
+		buffer.WriteString(fmt.Sprintf("%s slots=%d\n", hostName(mpiJob, mpiJob.Name+launcherSuffix), slots))
 	}
 	for i := 0; i < int(workerReplicas); i++ {
-		buffer.WriteString(fmt.Sprintf("%s%s-%d slots=%d\n", mpiJob.Name, workerSuffix, i, slots))
+		buffer.WriteString(fmt.Sprintf("%s slots=%d\n", hostName(mpiJob, fmt.Sprintf("%s%s-%d", mpiJob.Name, workerSuffix, i)), slots))
 	}
 
-	return &corev1.ConfigMap{
+	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      mpiJob.Name + configSuffix,
 			Namespace: mpiJob.Namespace,
@@ -1259,10 +1441,14 @@ shift
 			},
 		},
 		Data: map[string]string{
-			hostfileName:      buffer.String(),
-			kubexecScriptName: kubexec,
+			hostfileName: buffer.String(),
 		},
 	}
+	// The processes are started without kubectl with the SSH launcher mode.
+	if !isSSHLauncherMode(mpiJob) {
+		cm.Data[kubexecScriptName] = kubexec
+	}
+	return cm
 }
 
 // updateDiscoverHostsInConfigMap updates the ConfigMap if the content of `discover_hosts.sh` changes.
@@ -1279,10 +1465,10 @@ func updateDiscoverHostsInConfigMap(configMap *corev1.ConfigMap, mpiJob *kubeflo
 
 	discoverHosts := "#!/bin/sh"
 	if isGPULauncher {
-		discoverHosts = fmt.Sprintf("%s\necho %s%s:%d\n", discoverHosts, mpiJob.Name, launcherSuffix, slots)
+		discoverHosts = fmt.Sprintf("%s\necho %s:%d\n", discoverHosts, hostName(mpiJob, mpiJob.Name+launcherSuffix), slots)
 	}
 	for _, p := range runningPods {
-		discoverHosts = fmt.Sprintf("%s\necho %s:%d", discoverHosts, p.Name, slots)
+		discoverHosts = fmt.Sprintf("%s\necho %s:%d", discoverHosts, hostName(mpiJob, p.Name), slots)
 	}
 
 	oldDiscoverHosts, exist := configMap.Data[discoverHostsScriptName]
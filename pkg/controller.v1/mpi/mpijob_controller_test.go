@@ -22,7 +22,10 @@ import (
 	common "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -164,9 +167,9 @@ var _ = Describe("MPIJob controller", func() {
 		})
 	})
 
-	Context("Test MPIJob with succeeded launcher Pod", func() {
+	Context("Test MPIJob with succeeded launcher Job", func() {
 		It("Should contains desired launcher ReplicaStatus", func() {
-			By("By marking a launcher pod with Phase Succeeded")
+			By("By marking a launcher Job as Complete")
 			ctx := context.Background()
 			startTime := metav1.Now()
 			completionTime := metav1.Now()
@@ -176,19 +179,20 @@ var _ = Describe("MPIJob controller", func() {
 			mpiJob := newMPIJobWithLauncher(jobName, ptr.To[int32](64), 1, gpuResourceName, &startTime, &completionTime)
 			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
 
-			launcher := reconciler.newLauncher(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
-			launcher.Status.Phase = corev1.PodSucceeded
+			launcher := reconciler.newLauncherJob(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
 
 			launcherKey := types.NamespacedName{
 				Namespace: metav1.NamespaceDefault,
 				Name:      launcher.GetName(),
 			}
 			Eventually(func() error {
-				launcherCreated := &corev1.Pod{}
+				launcherCreated := &batchv1.Job{}
 				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
 					return err
 				}
-				launcherCreated.Status.Phase = corev1.PodSucceeded
+				launcherCreated.Status.StartTime = &startTime
+				launcherCreated.Status.CompletionTime = &completionTime
+				launcherCreated.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
 				return testK8sClient.Status().Update(ctx, launcherCreated)
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
@@ -208,9 +212,9 @@ var _ = Describe("MPIJob controller", func() {
 		})
 	})
 
-	Context("Test MPIJob with failed launcher Pod", func() {
+	Context("Test MPIJob with failed launcher Job", func() {
 		It("Should contains desired launcher ReplicaStatus", func() {
-			By("By marking a launcher pod with Phase Failed")
+			By("By marking a launcher Job as Failed")
 			ctx := context.Background()
 			startTime := metav1.Now()
 			completionTime := metav1.Now()
@@ -220,17 +224,18 @@ var _ = Describe("MPIJob controller", func() {
 			mpiJob := newMPIJobWithLauncher(jobName, ptr.To[int32](64), 1, gpuResourceName, &startTime, &completionTime)
 			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
 
-			launcher := reconciler.newLauncher(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
+			launcher := reconciler.newLauncherJob(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
 			launcherKey := types.NamespacedName{
 				Namespace: metav1.NamespaceDefault,
 				Name:      launcher.GetName(),
 			}
 			Eventually(func() error {
-				launcherCreated := &corev1.Pod{}
+				launcherCreated := &batchv1.Job{}
 				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
 					return err
 				}
-				launcherCreated.Status.Phase = corev1.PodFailed
+				launcherCreated.Status.StartTime = &startTime
+				launcherCreated.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"}}
 				return testK8sClient.Status().Update(ctx, launcherCreated)
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
@@ -250,9 +255,67 @@ var _ = Describe("MPIJob controller", func() {
 		})
 	})
 
-	Context("Test MPIJob with succeeded launcher pod", func() {
+	Context("Test MPIJob with a launcher Pod created before the launcher Job", func() {
+		It("Should keep reconciling the launcher Pod", func() {
+			By("By creating a launcher Pod for an MPIJob")
+			ctx := context.Background()
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			jobName := "test-launcher-pod-migration"
+
+			mpiJob := newMPIJobWithLauncher(jobName, ptr.To[int32](1), 1, gpuResourceName, &startTime, &completionTime)
+			launcher := reconciler.newLauncher(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
+			// The MPIJob doesn't exist yet, the owner of the launcher is set once it's created.
+			launcher.OwnerReferences[0].UID = "unknown"
+			Expect(testK8sClient.Create(ctx, launcher)).Should(Succeed())
+			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
+
+			launcherKey := types.NamespacedName{
+				Namespace: metav1.NamespaceDefault,
+				Name:      launcher.GetName(),
+			}
+			Eventually(func() error {
+				launcherCreated := &corev1.Pod{}
+				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
+					return err
+				}
+				launcherCreated.OwnerReferences[0].UID = mpiJob.UID
+				return testK8sClient.Update(ctx, launcherCreated)
+			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+			Eventually(func() error {
+				launcherCreated := &corev1.Pod{}
+				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
+					return err
+				}
+				launcherCreated.Status.Phase = corev1.PodRunning
+				return testK8sClient.Status().Update(ctx, launcherCreated)
+			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+
+			created := &kubeflowv1.MPIJob{}
+			launcherStatus := &common.ReplicaStatus{
+				Active:    1,
+				Succeeded: 0,
+				Failed:    0,
+			}
+			Eventually(func() bool {
+				err := testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName}, created)
+				if err != nil {
+					return false
+				}
+				return ReplicaStatusMatch(created.Status.ReplicaStatuses, kubeflowv1.MPIJobReplicaTypeLauncher, launcherStatus)
+			}, testutil.Timeout, testutil.Interval).Should(BeTrue())
+
+			By("Checking the launcher Job is not created")
+			Consistently(func() bool {
+				return errors.IsNotFound(testK8sClient.Get(ctx, launcherKey, &batchv1.Job{}))
+			}, testutil.ConsistentDuration, testutil.Interval).Should(BeTrue())
+		})
+	})
+
+	Context("Test MPIJob with succeeded launcher Job", func() {
 		It("Should contain desired ReplicaStatuses for worker", func() {
-			By("By marking the launcher Pod as Succeeded")
+			By("By marking the launcher Job as Complete")
 			ctx := context.Background()
 			startTime := metav1.Now()
 			completionTime := metav1.Now()
@@ -262,19 +325,20 @@ var _ = Describe("MPIJob controller", func() {
 			mpiJob := newMPIJobWithLauncher(jobName, ptr.To[int32](64), 1, gpuResourceName, &startTime, &completionTime)
 			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
 
-			launcher := reconciler.newLauncher(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
-			launcher.Status.Phase = corev1.PodSucceeded
+			launcher := reconciler.newLauncherJob(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
 
 			launcherKey := types.NamespacedName{
 				Namespace: metav1.NamespaceDefault,
 				Name:      launcher.GetName(),
 			}
 			Eventually(func() error {
-				launcherCreated := &corev1.Pod{}
+				launcherCreated := &batchv1.Job{}
 				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
 					return err
 				}
-				launcherCreated.Status.Phase = corev1.PodSucceeded
+				launcherCreated.Status.StartTime = &startTime
+				launcherCreated.Status.CompletionTime = &completionTime
+				launcherCreated.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
 				return testK8sClient.Status().Update(ctx, launcherCreated)
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
@@ -294,9 +358,9 @@ var _ = Describe("MPIJob controller", func() {
 		})
 	})
 
-	Context("Test MPIJob with Running launcher Pod and Pending worker Pods", func() {
+	Context("Test MPIJob with Running launcher Job and Pending worker Pods", func() {
 		It("Should contain desired ReplicaStatuses", func() {
-			By("By marking an active launcher pod and pending worker pods")
+			By("By marking an active launcher Job and pending worker pods")
 
 			ctx := context.Background()
 			startTime := metav1.Now()
@@ -308,17 +372,19 @@ var _ = Describe("MPIJob controller", func() {
 			mpiJob := newMPIJobWithLauncher(jobName, &replicas, 1, gpuResourceName, &startTime, &completionTime)
 			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
 
-			launcher := reconciler.newLauncher(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
+			launcher := reconciler.newLauncherJob(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
 			launcherKey := types.NamespacedName{
 				Namespace: metav1.NamespaceDefault,
 				Name:      launcher.GetName(),
 			}
 			Eventually(func() error {
-				launcherCreated := &corev1.Pod{}
+				launcherCreated := &batchv1.Job{}
 				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
 					return err
 				}
-				launcherCreated.Status.Phase = corev1.PodRunning
+				launcherCreated.Status.StartTime = &startTime
+				launcherCreated.Status.Active = 1
+				launcherCreated.Status.Ready = ptr.To[int32](1)
 				return testK8sClient.Status().Update(ctx, launcherCreated)
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
@@ -366,9 +432,9 @@ var _ = Describe("MPIJob controller", func() {
 		})
 	})
 
-	Context("Test MPIJob with Running launcher Pod and Running worker Pods", func() {
+	Context("Test MPIJob with Running launcher Job and Running worker Pods", func() {
 		It("Should contain desired ReplicaStatuses", func() {
-			By("By creating an active launcher pod and active worker pods")
+			By("By creating an active launcher Job and active worker pods")
 
 			ctx := context.Background()
 			startTime := metav1.Now()
@@ -380,17 +446,19 @@ var _ = Describe("MPIJob controller", func() {
 			mpiJob := newMPIJob(jobName, &replicas, 1, gpuResourceName, &startTime, &completionTime)
 			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
 
-			launcher := reconciler.newLauncher(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
+			launcher := reconciler.newLauncherJob(mpiJob, "kubectl-delivery", isGPULauncher(mpiJob))
 			launcherKey := types.NamespacedName{
 				Namespace: metav1.NamespaceDefault,
 				Name:      launcher.GetName(),
 			}
 			Eventually(func() error {
-				launcherCreated := &corev1.Pod{}
+				launcherCreated := &batchv1.Job{}
 				if err := testK8sClient.Get(ctx, launcherKey, launcherCreated); err != nil {
 					return err
 				}
-				launcherCreated.Status.Phase = corev1.PodRunning
+				launcherCreated.Status.StartTime = &startTime
+				launcherCreated.Status.Active = 1
+				launcherCreated.Status.Ready = ptr.To[int32](1)
 				return testK8sClient.Status().Update(ctx, launcherCreated)
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
@@ -557,7 +625,7 @@ var _ = Describe("MPIJob controller", func() {
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
 			Eventually(func() string {
-				launcherCreated := &corev1.Pod{}
+				launcherCreated := &batchv1.Job{}
 
 				launcherKey := types.NamespacedName{
 					Namespace: metav1.NamespaceDefault,
@@ -568,7 +636,7 @@ var _ = Describe("MPIJob controller", func() {
 					return ""
 				}
 
-				return launcherCreated.Spec.ServiceAccountName
+				return launcherCreated.Spec.Template.Spec.ServiceAccountName
 			}, testutil.Timeout, testutil.Interval).Should(Equal(launcherSaName))
 		})
 	})
@@ -726,6 +794,167 @@ var _ = Describe("MPIJob controller", func() {
 		})
 	})
 
+	Context("Test MPIJob with the SSH launcher mode", func() {
+		It("Should create the SSH keypair and the launcher without kubectl", func() {
+			By("By creating an MPIJob with the SSH launcher mode")
+			jobName := "test-ssh-launcher"
+
+			ctx := context.Background()
+			mpiJob := newMPIJob(jobName, ptr.To[int32](2), 1, gpuResourceName, nil, nil)
+			mpiJob.Spec.LauncherMode = ptr.To(kubeflowv1.MPIJobLauncherModeSSH)
+			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
+
+			By("Checking the SSH keypair Secret and the headless Service are created")
+			Eventually(func() error {
+				secret := &corev1.Secret{}
+				return testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + sshAuthSecretSuffix}, secret)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+			svc := &corev1.Service{}
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName}, svc)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+			Expect(svc.Spec.ClusterIP).Should(Equal(corev1.ClusterIPNone))
+
+			By("Checking the workers mount the SSH keypair and the launcher waits for them")
+			for i := 0; i < 2; i++ {
+				workerKey := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: fmt.Sprintf("%s%s-%d", jobName, workerSuffix, i)}
+				Eventually(func() error {
+					workerCreated := &corev1.Pod{}
+					if err := testK8sClient.Get(ctx, workerKey, workerCreated); err != nil {
+						return err
+					}
+					Expect(workerCreated.Spec.Subdomain).Should(Equal(jobName))
+					Expect(workerCreated.Spec.Containers[0].VolumeMounts).Should(ContainElement(corev1.VolumeMount{
+						Name:      sshAuthVolumeName,
+						MountPath: kubeflowv1.MPIJobDefaultSSHAuthMountPath,
+					}))
+					Expect(testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + launcherSuffix}, &batchv1.Job{})).
+						Should(Satisfy(errors.IsNotFound))
+					workerCreated.Status.Phase = corev1.PodRunning
+					return testK8sClient.Status().Update(ctx, workerCreated)
+				}, testutil.Timeout, testutil.Interval).Should(Succeed())
+			}
+
+			By("Checking the launcher starts the processes over SSH")
+			launcher := &batchv1.Job{}
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + launcherSuffix}, launcher)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+			Expect(launcher.Spec.Template.Spec.InitContainers).Should(BeEmpty())
+			Expect(launcher.Spec.Template.Spec.ServiceAccountName).ShouldNot(Equal(jobName + launcherSuffix))
+			Expect(launcher.Spec.Template.Spec.Containers[0].Env).Should(ContainElements(
+				corev1.EnvVar{Name: "OMPI_MCA_plm_rsh_agent", Value: sshRshAgent},
+				corev1.EnvVar{Name: "I_MPI_HYDRA_BOOTSTRAP", Value: sshRshAgent},
+			))
+
+			By("Checking the launcher Role is not created")
+			Expect(testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + launcherSuffix}, &rbacv1.Role{})).
+				Should(Satisfy(errors.IsNotFound))
+
+			By("Checking the hostfile lists the host names of the workers")
+			cm := &corev1.ConfigMap{}
+			Expect(testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + configSuffix}, cm)).Should(Succeed())
+			Expect(cm.Data).ShouldNot(HaveKey(kubexecScriptName))
+			Expect(cm.Data[hostfileName]).Should(ContainSubstring(fmt.Sprintf("%s%s-0.%s.%s.svc slots=1", jobName, workerSuffix, jobName, metav1.NamespaceDefault)))
+		})
+	})
+
+	Context("Test elastic MPIJob", func() {
+		It("Should create the HPA and drain the scaled down workers in reverse index order", func() {
+			By("By creating an elastic MPIJob with metrics")
+			jobName := "test-elastic"
+
+			ctx := context.Background()
+			mpiJob := newMPIJob(jobName, ptr.To[int32](3), 1, gpuResourceName, nil, nil)
+			mpiJob.Spec.ElasticPolicy = &kubeflowv1.MPIJobElasticPolicy{
+				MinReplicas: ptr.To[int32](1),
+				MaxReplicas: ptr.To[int32](4),
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{
+								Type:               autoscalingv2.UtilizationMetricType,
+								AverageUtilization: ptr.To[int32](80),
+							},
+						},
+					},
+				},
+			}
+			Expect(testK8sClient.Create(ctx, mpiJob)).Should(Succeed())
+
+			By("Checking the HPA scales the workers of the MPIJob")
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName}, hpa)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+			Expect(hpa.Spec.ScaleTargetRef.Kind).Should(Equal(kubeflowv1.MPIJobKind))
+			Expect(hpa.Spec.MinReplicas).Should(Equal(ptr.To[int32](1)))
+			Expect(hpa.Spec.MaxReplicas).Should(Equal(int32(4)))
+
+			By("By marking the workers as Running")
+			for i := 0; i < 3; i++ {
+				workerKey := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: fmt.Sprintf("%s%s-%d", jobName, workerSuffix, i)}
+				Eventually(func() error {
+					workerCreated := &corev1.Pod{}
+					if err := testK8sClient.Get(ctx, workerKey, workerCreated); err != nil {
+						return err
+					}
+					workerCreated.Status.Phase = corev1.PodRunning
+					return testK8sClient.Status().Update(ctx, workerCreated)
+				}, testutil.Timeout, testutil.Interval).Should(Succeed())
+			}
+			configMapKey := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + configSuffix}
+			Eventually(func() string {
+				cm := &corev1.ConfigMap{}
+				if err := testK8sClient.Get(ctx, configMapKey, cm); err != nil {
+					return ""
+				}
+				return cm.Data[discoverHostsScriptName]
+			}, testutil.Timeout, testutil.Interval).Should(ContainSubstring(jobName + workerSuffix + "-2"))
+
+			By("By scaling the workers down to 1")
+			Eventually(func() error {
+				created := &kubeflowv1.MPIJob{}
+				if err := testK8sClient.Get(ctx, client.ObjectKeyFromObject(mpiJob), created); err != nil {
+					return err
+				}
+				created.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeWorker].Replicas = ptr.To[int32](1)
+				return testK8sClient.Update(ctx, created)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+
+			By("Checking the workers are removed from discover_hosts.sh and deleted")
+			Eventually(func() string {
+				cm := &corev1.ConfigMap{}
+				if err := testK8sClient.Get(ctx, configMapKey, cm); err != nil {
+					return ""
+				}
+				return cm.Data[discoverHostsScriptName]
+			}, testutil.Timeout, testutil.Interval).Should(Equal(fmt.Sprintf("#!/bin/sh\necho %s%s-0:1", jobName, workerSuffix)))
+			for _, i := range []int{2, 1} {
+				workerKey := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: fmt.Sprintf("%s%s-%d", jobName, workerSuffix, i)}
+				Eventually(func() bool {
+					return errors.IsNotFound(testK8sClient.Get(ctx, workerKey, &corev1.Pod{}))
+				}, testutil.Timeout, testutil.Interval).Should(BeTrue())
+			}
+			Expect(testK8sClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: jobName + workerSuffix + "-0"}, &corev1.Pod{})).
+				Should(Succeed())
+
+			By("Checking the selector of the workers is in the status")
+			Eventually(func() string {
+				created := &kubeflowv1.MPIJob{}
+				if err := testK8sClient.Get(ctx, client.ObjectKeyFromObject(mpiJob), created); err != nil {
+					return ""
+				}
+				if status := created.Status.ReplicaStatuses[kubeflowv1.MPIJobReplicaTypeWorker]; status != nil {
+					return status.Selector
+				}
+				return ""
+			}, testutil.Timeout, testutil.Interval).Should(ContainSubstring(kubeflowv1.ReplicaTypeLabel + "=worker"))
+		})
+	})
+
 	Context("Test launcher's Intel MPI handling", func() {
 		It("Should create a launcher job with Intel MPI env variables", func() {
 			By("By creating MPIJobs with and without preset env variables")
@@ -853,7 +1082,7 @@ var _ = Describe("MPIJob controller", func() {
 			Expect(testK8sClient.Create(ctx, job)).Should(Succeed())
 
 			created := &kubeflowv1.MPIJob{}
-			launcherPod := &corev1.Pod{}
+			launcherJob := &batchv1.Job{}
 			workerPod := &corev1.Pod{}
 
 			By("Checking created MPIJob")
@@ -867,11 +1096,11 @@ var _ = Describe("MPIJob controller", func() {
 				return created.Status.StartTime
 			}, testutil.ConsistentDuration, testutil.Interval).Should(BeNil())
 
-			By("Checking if the pods aren't created")
+			By("Checking if the launcher Job and the pods aren't created")
 			Consistently(func() bool {
-				errLauncherPod := testK8sClient.Get(ctx, launcherKey, launcherPod)
+				errLauncherJob := testK8sClient.Get(ctx, launcherKey, launcherJob)
 				errWorkerPod := testK8sClient.Get(ctx, worker0Key, workerPod)
-				return errors.IsNotFound(errLauncherPod) && errors.IsNotFound(errWorkerPod)
+				return errors.IsNotFound(errLauncherJob) && errors.IsNotFound(errWorkerPod)
 			}, testutil.ConsistentDuration, testutil.Interval).Should(BeTrue())
 
 			By("Checking if the MPIJob has suspended condition")
@@ -899,7 +1128,7 @@ var _ = Describe("MPIJob controller", func() {
 			Expect(testK8sClient.Create(ctx, job)).Should(Succeed())
 
 			created := &kubeflowv1.MPIJob{}
-			launcherPod := &corev1.Pod{}
+			launcherJob := &batchv1.Job{}
 			workerPod := &corev1.Pod{}
 
 			// We'll need to retry getting this newly created MPIJob, given that creation may not immediately happen.
@@ -916,18 +1145,20 @@ var _ = Describe("MPIJob controller", func() {
 				return startTimeBeforeSuspended
 			}, testutil.Timeout, testutil.Interval).ShouldNot(BeNil())
 
-			By("Checking the created pods")
+			By("Checking the created launcher Job and pods")
 			Eventually(func() bool {
-				errLauncher := testK8sClient.Get(ctx, launcherKey, launcherPod)
+				errLauncher := testK8sClient.Get(ctx, launcherKey, launcherJob)
 				errWorker := testK8sClient.Get(ctx, worker0Key, workerPod)
 				return errLauncher == nil && errWorker == nil
 			}, testutil.Timeout, testutil.Interval).Should(BeTrue())
 
-			By("Updating the Pod's phase with Running")
+			By("Updating the launcher Job's and the Pod's status with Running")
 			Eventually(func() error {
-				Expect(testK8sClient.Get(ctx, launcherKey, launcherPod)).Should(Succeed())
-				launcherPod.Status.Phase = corev1.PodRunning
-				return testK8sClient.Status().Update(ctx, launcherPod)
+				Expect(testK8sClient.Get(ctx, launcherKey, launcherJob)).Should(Succeed())
+				launcherJob.Status.StartTime = ptr.To(metav1.Now())
+				launcherJob.Status.Active = 1
+				launcherJob.Status.Ready = ptr.To[int32](1)
+				return testK8sClient.Status().Update(ctx, launcherJob)
 			}, testutil.Timeout, testutil.Interval).Should(Succeed())
 			Eventually(func() error {
 				Expect(testK8sClient.Get(ctx, worker0Key, workerPod)).Should(Succeed())
@@ -961,16 +1192,16 @@ var _ = Describe("MPIJob controller", func() {
 				return testK8sClient.Update(ctx, created)
 			}, testutil.Timeout, testutil.Interval).Should(Succeed())
 
-			By("Checking if the pods are removed")
+			By("Checking if the pods are removed and the launcher Job is suspended")
 			Eventually(func() bool {
-				errLauncher := testK8sClient.Get(ctx, launcherKey, launcherPod)
+				Expect(testK8sClient.Get(ctx, launcherKey, launcherJob)).Should(Succeed())
 				errWorker := testK8sClient.Get(ctx, worker0Key, workerPod)
-				return errors.IsNotFound(errLauncher) && errors.IsNotFound(errWorker)
+				return ptr.Deref(launcherJob.Spec.Suspend, false) && errors.IsNotFound(errWorker)
 			}, testutil.Timeout, testutil.Interval).Should(BeTrue())
 			Consistently(func() bool {
-				errLauncherPod := testK8sClient.Get(ctx, launcherKey, launcherPod)
+				Expect(testK8sClient.Get(ctx, launcherKey, launcherJob)).Should(Succeed())
 				errWorkerPod := testK8sClient.Get(ctx, worker0Key, workerPod)
-				return errors.IsNotFound(errLauncherPod) && errors.IsNotFound(errWorkerPod)
+				return ptr.Deref(launcherJob.Spec.Suspend, false) && errors.IsNotFound(errWorkerPod)
 			}, testutil.ConsistentDuration, testutil.Interval).Should(BeTrue())
 
 			By("Checking if the MPIJob has a suspended condition")
@@ -1018,19 +1249,21 @@ var _ = Describe("MPIJob controller", func() {
 				return created.Status.StartTime
 			}, testutil.Timeout, testutil.Interval).ShouldNot(BeNil())
 
-			By("Check if the pods are created")
-			Eventually(func() error {
-				return testK8sClient.Get(ctx, launcherKey, launcherPod)
-			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+			By("Check if the launcher Job is resumed and the pods are created")
+			Eventually(func() bool {
+				Expect(testK8sClient.Get(ctx, launcherKey, launcherJob)).Should(Succeed())
+				return ptr.Deref(launcherJob.Spec.Suspend, false)
+			}, testutil.Timeout, testutil.Interval).Should(BeFalse())
 			Eventually(func() error {
 				return testK8sClient.Get(ctx, worker0Key, workerPod)
 			}, testutil.Timeout, testutil.Interval).Should(BeNil())
 
-			By("Updating Pod's condition with Running")
+			By("Updating the launcher Job's and the Pod's status with Running")
 			Eventually(func() error {
-				Expect(testK8sClient.Get(ctx, launcherKey, launcherPod)).Should(Succeed())
-				launcherPod.Status.Phase = corev1.PodRunning
-				return testK8sClient.Status().Update(ctx, launcherPod)
+				Expect(testK8sClient.Get(ctx, launcherKey, launcherJob)).Should(Succeed())
+				launcherJob.Status.Active = 1
+				launcherJob.Status.Ready = ptr.To[int32](1)
+				return testK8sClient.Status().Update(ctx, launcherJob)
 			}, testutil.Timeout, testutil.Interval).Should(Succeed())
 			Eventually(func() error {
 				Expect(testK8sClient.Get(ctx, worker0Key, workerPod)).Should(Succeed())
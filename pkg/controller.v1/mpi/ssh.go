@@ -0,0 +1,240 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpi
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+const (
+	sshAuthSecretSuffix   = "-ssh"
+	sshAuthVolumeName     = "ssh-auth"
+	sshPublicKey          = "ssh-publickey"
+	sshPrivateKeyFile     = "id_ecdsa"
+	sshPublicKeyFile      = sshPrivateKeyFile + ".pub"
+	sshAuthorizedKeysFile = "authorized_keys"
+	sshPublicKeyType      = "ecdsa-sha2-nistp521"
+	sshCurveName          = "nistp521"
+	sshRshAgent           = "ssh"
+	// sshRshArgs skips the host key verification, the host keys of the workers are unknown to the launcher.
+	sshRshArgs = "-o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null"
+)
+
+// isSSHLauncherMode checks whether the launcher starts the MPI processes over SSH.
+func isSSHLauncherMode(mpiJob *kubeflowv1.MPIJob) bool {
+	return mpiJob.Spec.LauncherMode != nil && *mpiJob.Spec.LauncherMode == kubeflowv1.MPIJobLauncherModeSSH
+}
+
+// runPolicyForMPIJob returns the RunPolicy of the MPIJob. With the SSH launcher mode, mpirun fails unless
+// the SSH servers of all the workers are up, so the launcher depends on the workers to be Running.
+func runPolicyForMPIJob(mpiJob *kubeflowv1.MPIJob) *kubeflowv1.RunPolicy {
+	if !isSSHLauncherMode(mpiJob) {
+		return &mpiJob.Spec.RunPolicy
+	}
+	runPolicy := mpiJob.Spec.RunPolicy.DeepCopy()
+	if runPolicy.StartupPolicy == nil {
+		runPolicy.StartupPolicy = &kubeflowv1.StartupPolicy{}
+	}
+	runPolicy.StartupPolicy.Dependencies = append(runPolicy.StartupPolicy.Dependencies, kubeflowv1.ReplicaStartupDependency{
+		ReplicaType: kubeflowv1.MPIJobReplicaTypeLauncher,
+		DependsOn:   []kubeflowv1.ReplicaType{kubeflowv1.MPIJobReplicaTypeWorker},
+		Condition:   kubeflowv1.ReplicaStartupConditionRunning,
+	})
+	return runPolicy
+}
+
+// hostName returns the name the pod is reachable with from the launcher. With the SSH launcher mode
+// the pods are resolved through the headless Service of the job, with kubectl exec by their name.
+func hostName(mpiJob *kubeflowv1.MPIJob, podName string) string {
+	if !isSSHLauncherMode(mpiJob) {
+		return podName
+	}
+	return fmt.Sprintf("%s.%s.%s.svc", podName, mpiJob.Name, mpiJob.Namespace)
+}
+
+// getOrCreateSSHAuthSecret gets the Secret with the SSH keypair of this MPIJob, or creates one with a new
+// keypair if it doesn't exist. The keypair is never rotated since the running pods mount it.
+func (jc *MPIJobReconciler) getOrCreateSSHAuthSecret(mpiJob *kubeflowv1.MPIJob) (*corev1.Secret, error) {
+	secret, err := jc.KubeClientSet.CoreV1().Secrets(mpiJob.Namespace).Get(context.Background(), mpiJob.Name+sshAuthSecretSuffix, metav1.GetOptions{})
+	// If the Secret doesn't exist, we'll create it.
+	if errors.IsNotFound(err) {
+		secret, err = newSSHAuthSecret(mpiJob)
+		if err != nil {
+			return nil, err
+		}
+		secret, err = jc.KubeClientSet.CoreV1().Secrets(mpiJob.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	// If the Secret is not controlled by this MPIJob resource, we
+	// should log a warning to the event recorder and return.
+	if !metav1.IsControlledBy(secret, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, secret.Name, secret.Kind)
+		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return secret, nil
+}
+
+// getOrCreateService gets the headless Service which gives the launcher and the workers of this
+// MPIJob their host names, or creates one if it doesn't exist.
+func (jc *MPIJobReconciler) getOrCreateService(mpiJob *kubeflowv1.MPIJob) (*corev1.Service, error) {
+	svc, err := jc.KubeClientSet.CoreV1().Services(mpiJob.Namespace).Get(context.Background(), mpiJob.Name, metav1.GetOptions{})
+	// If the Service doesn't exist, we'll create it.
+	if errors.IsNotFound(err) {
+		svc, err = jc.KubeClientSet.CoreV1().Services(mpiJob.Namespace).Create(context.Background(), jc.newService(mpiJob), metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	// If the Service is not controlled by this MPIJob resource, we
+	// should log a warning to the event recorder and return.
+	if !metav1.IsControlledBy(svc, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, svc.Name, svc.Kind)
+		jc.Recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return svc, nil
+}
+
+// newService creates a new headless Service selecting the launcher and the workers of an MPIJob.
+func (jc *MPIJobReconciler) newService(mpiJob *kubeflowv1.MPIJob) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mpiJob.Name,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflowv1.MPIJobSchemeGroupVersionKind),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:                corev1.ClusterIPNone,
+			Selector:                 jc.GenLabels(mpiJob.Name),
+			PublishNotReadyAddresses: true,
+		},
+	}
+}
+
+// newSSHAuthSecret creates a new Secret with a generated SSH keypair for an MPIJob resource.
+func newSSHAuthSecret(mpiJob *kubeflowv1.MPIJob) (*corev1.Secret, error) {
+	privateKey, publicKey, err := generateSSHKeypair()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the SSH keypair: %v", err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mpiJob.Name + sshAuthSecretSuffix,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflowv1.MPIJobSchemeGroupVersionKind),
+			},
+		},
+		Type: corev1.SecretTypeSSHAuth,
+		Data: map[string][]byte{
+			corev1.SSHAuthPrivateKey: privateKey,
+			sshPublicKey:             publicKey,
+		},
+	}, nil
+}
+
+// generateSSHKeypair generates an ECDSA keypair, the private key is PEM encoded and
+// the public key is in the authorized_keys format of OpenSSH.
+func generateSSHKeypair() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+	pub, err := key.PublicKey.ECDH()
+	if err != nil {
+		return nil, nil, err
+	}
+	// The wire format of the key is described in RFC 5656, section 3.1.
+	var wire bytes.Buffer
+	for _, field := range [][]byte{[]byte(sshPublicKeyType), []byte(sshCurveName), pub.Bytes()} {
+		_ = binary.Write(&wire, binary.BigEndian, uint32(len(field)))
+		wire.Write(field)
+	}
+	publicKey := []byte(fmt.Sprintf("%s %s\n", sshPublicKeyType, base64.StdEncoding.EncodeToString(wire.Bytes())))
+	return privateKey, publicKey, nil
+}
+
+// sshAuthVolume returns the volume with the SSH keypair of an MPIJob. The public key is also
+// the only authorized key so that the launcher can log in to the workers.
+func sshAuthVolume(mpiJob *kubeflowv1.MPIJob) corev1.Volume {
+	mode := int32(0600)
+	return corev1.Volume{
+		Name: sshAuthVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  mpiJob.Name + sshAuthSecretSuffix,
+				DefaultMode: &mode,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  corev1.SSHAuthPrivateKey,
+						Path: sshPrivateKeyFile,
+					},
+					{
+						Key:  sshPublicKey,
+						Path: sshPublicKeyFile,
+					},
+					{
+						Key:  sshPublicKey,
+						Path: sshAuthorizedKeysFile,
+					},
+				},
+			},
+		},
+	}
+}
+
+// sshAuthVolumeMount returns the mount of the SSH keypair volume.
+func sshAuthVolumeMount(mpiJob *kubeflowv1.MPIJob) corev1.VolumeMount {
+	mountPath := mpiJob.Spec.SSHAuthMountPath
+	if len(mountPath) == 0 {
+		mountPath = kubeflowv1.MPIJobDefaultSSHAuthMountPath
+	}
+	return corev1.VolumeMount{
+		Name:      sshAuthVolumeName,
+		MountPath: mountPath,
+	}
+}
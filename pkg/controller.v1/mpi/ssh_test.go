@@ -0,0 +1,116 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpi
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestGenerateSSHKeypair(t *testing.T) {
+	privateKey, publicKey, err := generateSSHKeypair()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	block, _ := pem.Decode(privateKey)
+	if block == nil || block.Type != "EC PRIVATE KEY" {
+		t.Fatalf("Unexpected private key: %s", privateKey)
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing the private key: %v", err)
+	}
+	pub, err := key.PublicKey.ECDH()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	fields := strings.Fields(string(publicKey))
+	if len(fields) != 2 || fields[0] != sshPublicKeyType {
+		t.Fatalf("Unexpected public key: %s", publicKey)
+	}
+	wire, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		t.Fatalf("Unexpected error decoding the public key: %v", err)
+	}
+	var got [][]byte
+	for r := bytes.NewReader(wire); r.Len() > 0; {
+		var length uint32
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+			t.Fatalf("Unexpected error reading the public key: %v", err)
+		}
+		field := make([]byte, length)
+		if _, err := r.Read(field); err != nil {
+			t.Fatalf("Unexpected error reading the public key: %v", err)
+		}
+		got = append(got, field)
+	}
+	want := [][]byte{[]byte(sshPublicKeyType), []byte(sshCurveName), pub.Bytes()}
+	if len(got) != len(want) {
+		t.Fatalf("Unexpected number of fields in the public key: \nwant: %v\ngot: %v\n", len(want), len(got))
+	}
+	for i := range want {
+		if !bytes.Equal(got[i], want[i]) {
+			t.Errorf("Unexpected field %d of the public key: \nwant: %v\ngot: %v\n", i, want[i], got[i])
+		}
+	}
+	if _, err := ecdh.P521().NewPublicKey(got[2]); err != nil {
+		t.Errorf("Unexpected error parsing the public point: %v", err)
+	}
+}
+
+func TestRunPolicyForMPIJob(T *testing.T) {
+	workerDependency := kubeflowv1.ReplicaStartupDependency{
+		ReplicaType: kubeflowv1.MPIJobReplicaTypeLauncher,
+		DependsOn:   []kubeflowv1.ReplicaType{kubeflowv1.MPIJobReplicaTypeWorker},
+		Condition:   kubeflowv1.ReplicaStartupConditionRunning,
+	}
+	cases := map[string]struct {
+		launcherMode *kubeflowv1.MPIJobLauncherMode
+		want         *kubeflowv1.StartupPolicy
+	}{
+		"kubectl exec launcher mode": {
+			launcherMode: ptr.To(kubeflowv1.MPIJobLauncherModeKubectlExec),
+		},
+		"ssh launcher mode": {
+			launcherMode: ptr.To(kubeflowv1.MPIJobLauncherModeSSH),
+			want:         &kubeflowv1.StartupPolicy{Dependencies: []kubeflowv1.ReplicaStartupDependency{workerDependency}},
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			mpiJob := &kubeflowv1.MPIJob{Spec: kubeflowv1.MPIJobSpec{LauncherMode: tc.launcherMode}}
+			runPolicy := runPolicyForMPIJob(mpiJob)
+			if diff := cmp.Diff(tc.want, runPolicy.StartupPolicy); len(diff) != 0 {
+				t.Errorf("Unexpected startup policy (-want,+got):\n%s", diff)
+			}
+			if mpiJob.Spec.RunPolicy.StartupPolicy != nil {
+				t.Errorf("Unexpected change of the MPIJob startup policy: %v", mpiJob.Spec.RunPolicy.StartupPolicy)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	"github.com/kubeflow/training-operator/pkg/config"
@@ -76,6 +77,9 @@ var _ = BeforeSuite(func() {
 	// Set Default kubectl delivery image
 	config.Config.MPIKubectlDeliveryImage = config.MPIKubectlDeliveryImageDefault
 
+	// The launchers of the tests don't run, the workers are drained without waiting for the kubelet.
+	discoverHostsSyncPeriod = time.Second
+
 	//+kubebuilder:scaffold:scheme
 
 	testK8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
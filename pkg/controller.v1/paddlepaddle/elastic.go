@@ -0,0 +1,122 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package paddle
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	"github.com/kubeflow/training-operator/pkg/util/labels"
+	trainutil "github.com/kubeflow/training-operator/pkg/util/train"
+)
+
+// replicaEnvNames are the environment variables that depend on the number of replicas.
+var replicaEnvNames = []string{EnvNumNodes, EnvTrainerNum}
+
+// ReconcileElasticPods deletes the pods of an elastic PaddleJob whose replica dependent environment
+// variables are outdated, e.g. after the HPA scaled the workers. ReconcilePods recreates them with
+// regenerated PADDLE_NNODES and PADDLE_TRAINER_NUM values.
+func (r *PaddleJobReconciler) ReconcileElasticPods(paddleJob *kubeflowv1.PaddleJob) error {
+	if paddleJob.Spec.ElasticPolicy == nil || trainutil.IsJobSuspended(&paddleJob.Spec.RunPolicy) ||
+		commonutil.IsFinished(paddleJob.Status) {
+		return nil
+	}
+	logger := r.Log.WithValues(kubeflowv1.PaddleJobSingular, paddleJob.Name)
+
+	jobKey, err := common.KeyFunc(paddleJob)
+	if err != nil {
+		return err
+	}
+	pods, err := r.GetPodsForJob(paddleJob)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		outdated, err := isPodEnvOutdated(paddleJob, pod)
+		if err != nil {
+			return err
+		}
+		if !outdated {
+			continue
+		}
+		logger.Info("Restarting pod to regenerate replica environment variables", "pod", pod.Name)
+		if err := r.PodControl.DeletePod(pod.Namespace, pod.Name, paddleJob); err != nil {
+			return err
+		}
+		// Deletion is expected
+		rt := pod.Labels[kubeflowv1.ReplicaTypeLabel]
+		r.Expectations.RaiseExpectations(expectation.GenExpectationPodsKey(jobKey, rt), 0, 1)
+	}
+	return nil
+}
+
+// isPodEnvOutdated checks whether the replica dependent environment variables of the pod differ from
+// the ones generated for the current spec of the PaddleJob.
+func isPodEnvOutdated(paddleJob *kubeflowv1.PaddleJob, pod *corev1.Pod) (bool, error) {
+	rt, ok := pod.Labels[kubeflowv1.ReplicaTypeLabel]
+	if !ok {
+		return false, nil
+	}
+	index, err := labels.ReplicaIndex(pod.Labels)
+	if err != nil {
+		return false, nil
+	}
+	var spec *kubeflowv1.ReplicaSpec
+	for rType, s := range paddleJob.Spec.PaddleReplicaSpecs {
+		if strings.EqualFold(string(rType), rt) {
+			spec = s
+		}
+	}
+	// Pods out of the replica range are deleted by ReconcilePods.
+	if spec == nil || spec.Replicas == nil || index >= int(*spec.Replicas) {
+		return false, nil
+	}
+
+	expected := spec.Template.DeepCopy()
+	if err := setPodEnv(paddleJob, expected, rt, strconv.Itoa(index)); err != nil {
+		return false, err
+	}
+	for _, container := range expected.Spec.Containers {
+		for _, current := range pod.Spec.Containers {
+			if current.Name != container.Name {
+				continue
+			}
+			for _, name := range replicaEnvNames {
+				if getEnvValue(container.Env, name) != getEnvValue(current.Env, name) {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+func getEnvValue(envs []corev1.EnvVar, name string) string {
+	for _, env := range envs {
+		if env.Name == name {
+			return env.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package paddle
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestIsPodEnvOutdated(t *testing.T) {
+	newJob := func(workers int32) *kubeflowv1.PaddleJob {
+		return &kubeflowv1.PaddleJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: kubeflowv1.PaddleJobSpec{
+				ElasticPolicy: &kubeflowv1.PaddleElasticPolicy{},
+				PaddleReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+					kubeflowv1.PaddleJobReplicaTypeWorker: {
+						Replicas: ptr.To[int32](workers),
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{
+									Name:  kubeflowv1.PaddleJobDefaultContainerName,
+									Image: "test-image",
+								}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newPod := func(job *kubeflowv1.PaddleJob, index string) *corev1.Pod {
+		spec := job.Spec.PaddleReplicaSpecs[kubeflowv1.PaddleJobReplicaTypeWorker]
+		template := spec.Template.DeepCopy()
+		if err := setPodEnv(job, template, "worker", index); err != nil {
+			t.Fatal(err)
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					kubeflowv1.ReplicaTypeLabel:  "worker",
+					kubeflowv1.ReplicaIndexLabel: index,
+				},
+			},
+			Spec: template.Spec,
+		}
+	}
+
+	testCases := map[string]struct {
+		job  *kubeflowv1.PaddleJob
+		pod  *corev1.Pod
+		want bool
+	}{
+		"replicas are unchanged": {
+			job:  newJob(2),
+			pod:  newPod(newJob(2), "1"),
+			want: false,
+		},
+		"workers are scaled up": {
+			job:  newJob(3),
+			pod:  newPod(newJob(2), "1"),
+			want: true,
+		},
+		"workers are scaled down": {
+			job:  newJob(2),
+			pod:  newPod(newJob(3), "1"),
+			want: true,
+		},
+		"pod is out of the replica range": {
+			job:  newJob(2),
+			pod:  newPod(newJob(3), "2"),
+			want: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isPodEnvOutdated(tc.job, tc.pod)
+			if err != nil {
+				t.Fatalf("isPodEnvOutdated() error = %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("isPodEnvOutdated() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package paddle
+
+import (
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+)
+
+// ReconcileHPA reconciles the HorizontalPodAutoscaler scaling the workers of the elastic PaddleJob.
+func (r *PaddleJobReconciler) ReconcileHPA(paddleJob *kubeflowv1.PaddleJob) error {
+	var policy *common.HPAPolicy
+	if elasticPolicy := paddleJob.Spec.ElasticPolicy; elasticPolicy != nil {
+		policy = &common.HPAPolicy{
+			MinReplicas: elasticPolicy.MinReplicas,
+			MaxReplicas: elasticPolicy.MaxReplicas,
+			Metrics:     elasticPolicy.Metrics,
+		}
+	}
+	return common.ReconcileHPA(r.Client, r.Scheme, paddleJob, r.GetAPIGroupVersionKind(), &paddleJob.Spec.RunPolicy, policy)
+}
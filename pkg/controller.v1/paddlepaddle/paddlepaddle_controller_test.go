@@ -20,8 +20,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -431,6 +433,143 @@ var _ = Describe("PaddleJob controller", func() {
 			Expect(created.Status.StartTime).ShouldNot(Equal(startTimeBeforeSuspended))
 		})
 	})
+
+	Context("When creating the elastic PaddleJob", func() {
+		const name = "elastic-paddle-job"
+		var (
+			ctx         = context.Background()
+			ns          *corev1.Namespace
+			job         *kubeflowv1.PaddleJob
+			jobKey      types.NamespacedName
+			worker0Key  types.NamespacedName
+			minReplicas = int32(1)
+			maxReplicas = int32(3)
+		)
+		BeforeEach(func() {
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "elastic-paddle-test-",
+				},
+			}
+			Expect(testK8sClient.Create(ctx, ns)).Should(Succeed())
+
+			job = newPaddleJobForTest(name, ns.Name)
+			jobKey = client.ObjectKeyFromObject(job)
+			worker0Key = types.NamespacedName{
+				Name:      fmt.Sprintf("%s-worker-0", name),
+				Namespace: ns.Name,
+			}
+			job.Spec.ElasticPolicy = &kubeflowv1.PaddleElasticPolicy{
+				MinReplicas: &minReplicas,
+				MaxReplicas: &maxReplicas,
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{
+								Type:         autoscalingv2.UtilizationMetricType,
+								AverageValue: resource.NewQuantity(80, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			}
+			job.Spec.PaddleReplicaSpecs = map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+				kubeflowv1.PaddleJobReplicaTypeWorker: {
+					Replicas: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Image: "test-image",
+									Name:  kubeflowv1.PaddleJobDefaultContainerName,
+									Ports: []corev1.ContainerPort{
+										{
+											Name:          kubeflowv1.PaddleJobDefaultPortName,
+											ContainerPort: expectedPort,
+											Protocol:      corev1.ProtocolTCP,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+		AfterEach(func() {
+			Expect(testK8sClient.Delete(ctx, job)).Should(Succeed())
+			Expect(testK8sClient.Delete(ctx, ns)).Should(Succeed())
+		})
+		It("Should create the HPA and regenerate the environment variables after scaling", func() {
+			By("By creating a new PaddleJob")
+			Expect(testK8sClient.Create(ctx, job)).Should(Succeed())
+
+			created := &kubeflowv1.PaddleJob{}
+			pod := &corev1.Pod{}
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+
+			By("Checking if the HPA and the worker are created")
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, jobKey, hpa)
+			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+			Expect(hpa.Spec.MinReplicas).To(Equal(&minReplicas))
+			Expect(hpa.Spec.MaxReplicas).To(Equal(maxReplicas))
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, worker0Key, pod)
+			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+			Expect(pod.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name:  EnvNumNodes,
+				Value: "1",
+			}))
+
+			By("Scaling the workers")
+			Eventually(func() error {
+				Expect(testK8sClient.Get(ctx, jobKey, created)).Should(Succeed())
+				created.Spec.PaddleReplicaSpecs[kubeflowv1.PaddleJobReplicaTypeWorker].Replicas = ptr.To[int32](2)
+				return testK8sClient.Update(ctx, created)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+
+			By("Checking if the worker is recreated with the regenerated environment variables")
+			Eventually(func() []corev1.EnvVar {
+				if err := testK8sClient.Get(ctx, worker0Key, pod); err != nil {
+					return nil
+				}
+				return pod.Spec.Containers[0].Env
+			}, testutil.Timeout, testutil.Interval).Should(ContainElement(corev1.EnvVar{
+				Name:  EnvNumNodes,
+				Value: "2",
+			}))
+		})
+		It("Should delete HPA once the PaddleJob is suspended", func() {
+			By("By creating a new PaddleJob")
+			Expect(testK8sClient.Create(ctx, job)).Should(Succeed())
+
+			created := &kubeflowv1.PaddleJob{}
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+
+			By("Checking if the PaddleJob and HPA are created")
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, jobKey, created)
+			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+			Eventually(func() error {
+				return testK8sClient.Get(ctx, jobKey, hpa)
+			}, testutil.Timeout, testutil.Interval).Should(BeNil())
+
+			By("Suspending PaddleJob")
+			Eventually(func() error {
+				Expect(testK8sClient.Get(ctx, jobKey, created)).Should(Succeed())
+				created.Spec.RunPolicy.Suspend = ptr.To(true)
+				return testK8sClient.Update(ctx, created)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+
+			By("Checking if the HPA is deleted")
+			Eventually(func() bool {
+				return errors.IsNotFound(testK8sClient.Get(ctx, jobKey, hpa))
+			}, testutil.Timeout, testutil.Interval).Should(BeTrue())
+		})
+	})
 })
 
 func newPaddleJobForTest(name, namespace string) *kubeflowv1.PaddleJob {
@@ -144,6 +144,14 @@ func (e ElasticEnvVarGenerator) generateEnvNnodes(job *kubeflowv1.PyTorchJob) (*
 }
 
 func (e ElasticEnvVarGenerator) generateEnvRDZVEndpoint(job *kubeflowv1.PyTorchJob) (*corev1.EnvVar, error) {
+	// The rendezvous store managed by the operator is reached through its Service.
+	if hasRDZVStore(job) {
+		return &corev1.EnvVar{
+			Name:  EnvRDZVEndpoint,
+			Value: fmt.Sprintf("%s:%d", rdzvStoreName(job), rdzvStorePort(job.Spec.ElasticPolicy)),
+		}, nil
+	}
+
 	var err error
 	host := ""
 	if job.Spec.ElasticPolicy.RDZVHost == nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
@@ -109,6 +110,42 @@ func TestElasticGenerate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "With ElasticPolicy and RDZVStore",
+			job: &kubeflowv1.PyTorchJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubeflowv1.PyTorchJobSpec{
+					ElasticPolicy: &kubeflowv1.ElasticPolicy{
+						MinReplicas: ptr.To[int32](1),
+						MaxReplicas: ptr.To[int32](3),
+						RDZVBackend: &backendC10D,
+						RDZVStore:   &kubeflowv1.RDZVStore{},
+					},
+					PyTorchReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+						kubeflowv1.PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+			expectedErr: nil,
+			expected: []corev1.EnvVar{
+				{
+					Name:  EnvRDZVBackend,
+					Value: "c10d",
+				},
+				{
+					Name:  EnvRDZVEndpoint,
+					Value: "test-rdzv:29400",
+				},
+				{
+					Name:  EnvNnodes,
+					Value: "1:3",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -119,6 +119,17 @@ func setInitContainer(obj interface{}, podTemplate *corev1.PodTemplateSpec,
 		return nil
 	}
 
+	if config.Config.PyTorchInitContainerDisabled {
+		logger.V(1).Info("The init container is disabled, skip setting init container")
+		return nil
+	}
+
+	// The workers with startup dependencies are only created once the dependencies are met.
+	if hasStartupDependencies(&pytorchJob.Spec.RunPolicy, kubeflowv1.PyTorchJobReplicaTypeWorker) {
+		logger.V(1).Info("The startup of the workers is ordered by the startupPolicy, skip setting init container")
+		return nil
+	}
+
 	// Set the init container only if the master is specified and the current
 	// rtype is worker.
 	if rtype == strings.ToLower(string(kubeflowv1.PyTorchJobReplicaTypeWorker)) {
@@ -134,3 +145,16 @@ func setInitContainer(obj interface{}, podTemplate *corev1.PodTemplateSpec,
 	}
 	return nil
 }
+
+// hasStartupDependencies checks whether the startup of the replica type depends on other replica types.
+func hasStartupDependencies(runPolicy *kubeflowv1.RunPolicy, rtype kubeflowv1.ReplicaType) bool {
+	if runPolicy.StartupPolicy == nil {
+		return false
+	}
+	for _, dependency := range runPolicy.StartupPolicy.Dependencies {
+		if dependency.ReplicaType == rtype && len(dependency.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -93,6 +93,35 @@ func TestInitContainer(t *testing.T) {
 			expected:    0,
 			exepctedErr: nil,
 		},
+		{
+			job: &kubeflowv1.PyTorchJob{
+				Spec: kubeflowv1.PyTorchJobSpec{
+					RunPolicy: kubeflowv1.RunPolicy{
+						StartupPolicy: &kubeflowv1.StartupPolicy{
+							Dependencies: []kubeflowv1.ReplicaStartupDependency{
+								{
+									ReplicaType: kubeflowv1.PyTorchJobReplicaTypeWorker,
+									DependsOn:   []kubeflowv1.ReplicaType{kubeflowv1.PyTorchJobReplicaTypeMaster},
+									Condition:   kubeflowv1.ReplicaStartupConditionReady,
+								},
+							},
+						},
+					},
+					PyTorchReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+						kubeflowv1.PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](1),
+						},
+						kubeflowv1.PyTorchJobReplicaTypeMaster: {
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+			rtype:       kubeflowv1.PyTorchJobReplicaTypeWorker,
+			index:       "0",
+			expected:    0,
+			exepctedErr: nil,
+		},
 	}
 
 	for _, t := range testCases {
@@ -15,44 +15,18 @@
 package pytorch
 
 import (
-	"context"
 	"fmt"
-	"strings"
-	"time"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
-	"github.com/kubeflow/training-operator/pkg/common/util"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
-	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
-	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common/framework"
 	commonutil "github.com/kubeflow/training-operator/pkg/util"
 
-	"github.com/go-logr/logr"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
-	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/client-go/informers"
-	kubeclientset "k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/record"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
-	"sigs.k8s.io/controller-runtime/pkg/source"
-	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
-	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
 const (
@@ -61,46 +35,21 @@ const (
 
 // NewReconciler creates a PyTorchJob Reconciler
 func NewReconciler(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) *PyTorchJobReconciler {
-	r := &PyTorchJobReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		recorder:  mgr.GetEventRecorderFor(controllerName),
-		apiReader: mgr.GetAPIReader(),
-		Log:       log.Log,
-	}
-
-	// Create clients
-	cfg := mgr.GetConfig()
-	kubeClientSet := kubeclientset.NewForConfigOrDie(cfg)
-	sharedInformers := informers.NewSharedInformerFactory(kubeClientSet, 0)
-	priorityClassInformer := sharedInformers.Scheduling().V1().PriorityClasses()
-
-	// Initialize common job controller
-	r.JobController = common.JobController{
-		Controller:                  r,
-		Expectations:                expectation.NewControllerExpectations(),
-		WorkQueue:                   &util.FakeWorkQueue{},
-		Recorder:                    r.recorder,
-		KubeClientSet:               kubeClientSet,
-		PriorityClassLister:         priorityClassInformer.Lister(),
-		PriorityClassInformerSynced: priorityClassInformer.Informer().HasSynced,
-		PodControl:                  control.RealPodControl{KubeClient: kubeClientSet, Recorder: r.recorder},
-		ServiceControl:              control.RealServiceControl{KubeClient: kubeClientSet, Recorder: r.recorder},
-	}
-
-	gangSchedulingSetupFunc(&r.JobController)
-
+	r := &PyTorchJobReconciler{}
+	r.Reconciler = framework.NewReconciler[*kubeflowv1.PyTorchJob](mgr, r, framework.Info{
+		Kind:                     kubeflowv1.PyTorchJobKind,
+		Singular:                 kubeflowv1.PyTorchJobSingular,
+		FrameworkName:            kubeflowv1.PyTorchJobFrameworkName,
+		ControllerName:           controllerName,
+		DefaultContainerName:     kubeflowv1.PyTorchJobDefaultContainerName,
+		DefaultContainerPortName: kubeflowv1.PyTorchJobDefaultPortName,
+	}, gangSchedulingSetupFunc)
 	return r
 }
 
 // PyTorchJobReconciler reconciles a PyTorchJob object
 type PyTorchJobReconciler struct {
-	common.JobController
-	client.Client
-	Scheme    *runtime.Scheme
-	Log       logr.Logger
-	recorder  record.EventRecorder
-	apiReader client.Reader
+	*framework.Reconciler[*kubeflowv1.PyTorchJob]
 }
 
 //+kubebuilder:rbac:groups=kubeflow.org,resources=pytorchjobs,verbs=get;list;watch;create;update;patch;delete
@@ -113,271 +62,40 @@ type PyTorchJobReconciler struct {
 //+kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// the PyTorchJob object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.8.3/pkg/reconcile
-func (r *PyTorchJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
-	logger := r.Log.WithValues(kubeflowv1.PyTorchJobSingular, req.NamespacedName)
-
-	pytorchjob := &kubeflowv1.PyTorchJob{}
-	err := r.Get(ctx, req.NamespacedName, pytorchjob)
-	if err != nil {
-		logger.Info(err.Error(), "unable to fetch PyTorchJob", req.NamespacedName.String())
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
-	if err = kubeflowv1.ValidateV1PyTorchJob(pytorchjob); err != nil {
-		logger.Error(err, "PyTorchJob failed validation")
-		r.Recorder.Eventf(pytorchjob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.PyTorchJobKind, commonutil.JobFailedValidationReason),
-			"PyTorchJob failed validation because %s", err)
-		return ctrl.Result{}, err
-	}
-
-	// Check if reconciliation is needed
-	jobKey, err := common.KeyFunc(pytorchjob)
-	if err != nil {
-		utilruntime.HandleError(fmt.Errorf("couldn't get jobKey for job object %#v: %v", pytorchjob, err))
-	}
-
-	replicaTypes := util.GetReplicaTypes(pytorchjob.Spec.PyTorchReplicaSpecs)
-	needReconcile := util.SatisfiedExpectations(r.Expectations, jobKey, replicaTypes)
-
-	if !needReconcile || pytorchjob.GetDeletionTimestamp() != nil {
-		logger.Info("reconcile cancelled, job does not need to do reconcile or has been deleted",
-			"sync", needReconcile, "deleted", pytorchjob.GetDeletionTimestamp() != nil)
-		return ctrl.Result{}, nil
-	}
-
-	// Set default priorities to pytorch job
-	r.Scheme.Default(pytorchjob)
-
-	err = r.ReconcileHPA(pytorchjob)
-	if err != nil {
-		logger.Error(err, "Reconcile PyTorchJob HPA error")
-		return ctrl.Result{}, err
-	}
-	// Use common to reconcile the job related pod and service
-	err = r.ReconcileJobs(pytorchjob, pytorchjob.Spec.PyTorchReplicaSpecs, pytorchjob.Status, &pytorchjob.Spec.RunPolicy)
-	if err != nil {
-		logger.Error(err, "Reconcile PyTorchJob error")
-		return ctrl.Result{}, err
-	}
-	t, err := util.DurationUntilExpireTime(&pytorchjob.Spec.RunPolicy, pytorchjob.Status)
-	if err != nil {
-		logrus.Warnf("Reconcile PyTorchJob error %v", err)
-		return ctrl.Result{}, err
-	}
-	if t >= 0 {
-		return ctrl.Result{Requeue: true, RequeueAfter: t}, nil
-	}
-
-	return ctrl.Result{}, nil
-}
-
-// SetupWithManager sets up the controller with the Manager.
-func (r *PyTorchJobReconciler) SetupWithManager(mgr ctrl.Manager, controllerThreads int) error {
-	c, err := controller.New(r.ControllerName(), mgr, controller.Options{
-		Reconciler:              r,
-		MaxConcurrentReconciles: controllerThreads,
-	})
-	if err != nil {
-		return err
-	}
-
-	// using onOwnerCreateFunc is easier to set defaults
-	if err = c.Watch(source.Kind(mgr.GetCache(), &kubeflowv1.PyTorchJob{}), &handler.EnqueueRequestForObject{},
-		predicate.Funcs{CreateFunc: r.onOwnerCreateFunc()},
-	); err != nil {
-		return err
-	}
-
-	// eventHandler for owned object
-	eventHandler := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &kubeflowv1.PyTorchJob{}, handler.OnlyControllerOwner())
-	predicates := predicate.Funcs{
-		CreateFunc: util.OnDependentCreateFunc(r.Expectations),
-		UpdateFunc: util.OnDependentUpdateFunc(&r.JobController),
-		DeleteFunc: util.OnDependentDeleteFunc(r.Expectations),
-	}
-	// Create generic predicates
-	genericPredicates := predicate.Funcs{
-		CreateFunc: util.OnDependentCreateFuncGeneric(r.Expectations),
-		UpdateFunc: util.OnDependentUpdateFuncGeneric(&r.JobController),
-		DeleteFunc: util.OnDependentDeleteFuncGeneric(r.Expectations),
-	}
-	// inject watching for job related pod
-	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Pod{}), eventHandler, predicates); err != nil {
-		return err
-	}
-	// inject watching for job related service
-	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Service{}), eventHandler, predicates); err != nil {
-		return err
-	}
-	// skip watching volcano PodGroup if volcano PodGroup is not installed
-	if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: v1beta1.GroupName, Kind: "PodGroup"},
-		v1beta1.SchemeGroupVersion.Version); err == nil {
-		// inject watching for job related volcano PodGroup
-		if err = c.Watch(source.Kind(mgr.GetCache(), &v1beta1.PodGroup{}), eventHandler, genericPredicates); err != nil {
-			return err
-		}
-	}
-	// skip watching scheduler-plugins PodGroup if scheduler-plugins PodGroup is not installed
-	if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: schedulerpluginsv1alpha1.SchemeGroupVersion.Group, Kind: "PodGroup"},
-		schedulerpluginsv1alpha1.SchemeGroupVersion.Version); err == nil {
-		// inject watching for job related scheduler-plugins PodGroup
-		if err = c.Watch(source.Kind(mgr.GetCache(), &schedulerpluginsv1alpha1.PodGroup{}), eventHandler, genericPredicates); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (r *PyTorchJobReconciler) ControllerName() string {
-	return controllerName
+func (r *PyTorchJobReconciler) NewJob() *kubeflowv1.PyTorchJob {
+	return &kubeflowv1.PyTorchJob{}
 }
 
-func (r *PyTorchJobReconciler) GetAPIGroupVersionKind() schema.GroupVersionKind {
-	return kubeflowv1.GroupVersion.WithKind(kubeflowv1.PyTorchJobKind)
+func (r *PyTorchJobReconciler) GetReplicaSpecs(job *kubeflowv1.PyTorchJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+	return job.Spec.PyTorchReplicaSpecs
 }
 
-func (r *PyTorchJobReconciler) GetAPIGroupVersion() schema.GroupVersion {
-	return kubeflowv1.GroupVersion
+func (r *PyTorchJobReconciler) GetRunPolicy(job *kubeflowv1.PyTorchJob) *kubeflowv1.RunPolicy {
+	return &job.Spec.RunPolicy
 }
 
-func (r *PyTorchJobReconciler) GetGroupNameLabelValue() string {
-	return kubeflowv1.GroupVersion.Group
+func (r *PyTorchJobReconciler) GetJobStatus(job *kubeflowv1.PyTorchJob) *kubeflowv1.JobStatus {
+	return &job.Status
 }
 
-func (r *PyTorchJobReconciler) GetFrameworkName() string {
-	return kubeflowv1.PyTorchJobFrameworkName
+func (r *PyTorchJobReconciler) ValidateJob(job *kubeflowv1.PyTorchJob) error {
+	return kubeflowv1.ValidateV1PyTorchJob(job)
 }
 
-func (r *PyTorchJobReconciler) GetJobFromInformerCache(namespace, name string) (metav1.Object, error) {
-	job := &kubeflowv1.PyTorchJob{}
-	err := r.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, job)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			logrus.Error(err, "pytorch job not found", "namespace", namespace, "name", name)
-		} else {
-			logrus.Error(err, "failed to get job from api-server", "namespace", namespace, "name", name)
-		}
-		return nil, err
-	}
-	return job, nil
-}
-
-func (r *PyTorchJobReconciler) GetJobFromAPIClient(namespace, name string) (metav1.Object, error) {
-	job := &kubeflowv1.PyTorchJob{}
-
-	err := r.apiReader.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, job)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			logrus.Error(err, "pytorch job not found", "namespace", namespace, "name", name)
-		} else {
-			logrus.Error(err, "failed to get job from api-server", "namespace", namespace, "name", name)
-		}
-		return nil, err
-	}
-	return job, nil
-}
-
-func (r *PyTorchJobReconciler) GetPodsForJob(obj interface{}) ([]*corev1.Pod, error) {
-	job, err := meta.Accessor(obj)
-	if err != nil {
-		return nil, err
-	}
-
-	// List all pods to include those that don't match the selector anymore
-	// but have a ControllerRef pointing to this controller.
-	podlist := &corev1.PodList{}
-	err = r.List(context.Background(), podlist, client.MatchingLabels(r.GenLabels(job.GetName())), client.InNamespace(job.GetNamespace()))
-	if err != nil {
-		return nil, err
-	}
-
-	return util.JobControlledPodList(podlist.Items, job), nil
-}
-
-func (r *PyTorchJobReconciler) GetServicesForJob(obj interface{}) ([]*corev1.Service, error) {
-	job, err := meta.Accessor(obj)
-	if err != nil {
-		return nil, err
-	}
-
-	// List all pods to include those that don't match the selector anymore
-	// but have a ControllerRef pointing to this controller.
-	serviceList := &corev1.ServiceList{}
-	err = r.List(context.Background(), serviceList, client.MatchingLabels(r.GenLabels(job.GetName())), client.InNamespace(job.GetNamespace()))
-	if err != nil {
-		return nil, err
-	}
-
-	ret := util.ConvertServiceList(serviceList.Items)
-	return ret, nil
-}
-
-func (r *PyTorchJobReconciler) DeleteJob(job interface{}) error {
-	pytorchjob, ok := job.(*kubeflowv1.PyTorchJob)
-	if !ok {
-		return fmt.Errorf("%+v is not a type of PyTorchJob", job)
-	}
-	if err := r.Delete(context.Background(), pytorchjob); err != nil {
-		r.recorder.Eventf(pytorchjob, corev1.EventTypeWarning, control.FailedDeletePodReason, "Error deleting: %v", err)
-		logrus.Error(err, "failed to delete job", "namespace", pytorchjob.Namespace, "name", pytorchjob.Name)
+// ReconcileResources reconciles the HPA and the rendezvous store of the elastic PyTorchJob.
+func (r *PyTorchJobReconciler) ReconcileResources(job *kubeflowv1.PyTorchJob) error {
+	if err := r.ReconcileHPA(job); err != nil {
 		return err
 	}
-	r.recorder.Eventf(pytorchjob, corev1.EventTypeNormal, control.SuccessfulDeletePodReason, "Deleted job: %v", pytorchjob.Name)
-	logrus.Info("job deleted", "namespace", pytorchjob.Namespace, "name", pytorchjob.Name)
-	trainingoperatorcommon.DeletedJobsCounterInc(pytorchjob.Namespace, r.GetFrameworkName())
-	return nil
+	return r.ReconcileRDZVStore(job)
 }
 
-func (r *PyTorchJobReconciler) GenLabelSelector(jobName string,
-	rtype kubeflowv1.ReplicaType) *metav1.LabelSelector {
-	labels := r.GenLabels(jobName)
-	labels[kubeflowv1.ReplicaTypeLabel] = strings.ToLower(string(rtype))
-
-	return &metav1.LabelSelector{
-		MatchLabels: labels,
-	}
-}
-
-// UpdateJobStatus updates the job status and job conditions
-func (r *PyTorchJobReconciler) UpdateJobStatus(job interface{},
+// UpdateJobConditions updates the job conditions based on the status of the master or the workers.
+func (r *PyTorchJobReconciler) UpdateJobConditions(pytorchjob *kubeflowv1.PyTorchJob,
 	replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
 	jobStatus *kubeflowv1.JobStatus) error {
-	pytorchjob, ok := job.(*kubeflowv1.PyTorchJob)
-	if !ok {
-		return fmt.Errorf("%+v is not a type of PyTorchJob", job)
-	}
-	pytorchjobKey, err := common.KeyFunc(pytorchjob)
-	if err != nil {
-		utilruntime.HandleError(fmt.Errorf("couldn't get key for pytorchjob object %#v: %v", pytorchjob, err))
-		return err
-	}
-
-	logger := commonutil.LoggerForJob(pytorchjob)
-
-	// Set StartTime.
-	if jobStatus.StartTime == nil {
-		now := metav1.Now()
-		jobStatus.StartTime = &now
-		// enqueue a sync to check if job past ActiveDeadlineSeconds
-		if pytorchjob.Spec.RunPolicy.ActiveDeadlineSeconds != nil {
-			logger.Infof("Job with ActiveDeadlineSeconds will sync after %d seconds", *pytorchjob.Spec.RunPolicy.ActiveDeadlineSeconds)
-			r.WorkQueue.AddAfter(pytorchjobKey, time.Duration(*pytorchjob.Spec.RunPolicy.ActiveDeadlineSeconds)*time.Second)
-		}
-	}
-
 	for rtype, spec := range replicas {
 		status := jobStatus.ReplicaStatuses[rtype]
-		// Generate the label selector.
-		status.Selector = metav1.FormatLabelSelector(r.GenLabelSelector(pytorchjob.Name, rtype))
 
 		succeeded := status.Succeeded
 		expected := *(spec.Replicas) - succeeded
@@ -394,8 +112,8 @@ func (r *PyTorchJobReconciler) UpdateJobStatus(job interface{},
 					msg := fmt.Sprintf("PyTorchJob %s is running.", pytorchjob.Name)
 					commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobRunning, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.PyTorchJobKind, commonutil.JobRunningReason), msg)
 				}
-				// when master is succeed, the job is finished.
-				if expected == 0 {
+				// when master is succeed, the job is finished, unless the success policy decides it.
+				if expected == 0 && pytorchjob.Spec.RunPolicy.SuccessPolicy == nil {
 					msg := fmt.Sprintf("PyTorchJob %s is successfully completed.", pytorchjob.Name)
 					logrus.Info(msg)
 					r.Recorder.Event(pytorchjob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.PyTorchJobKind, commonutil.JobSucceededReason), msg)
@@ -410,14 +128,14 @@ func (r *PyTorchJobReconciler) UpdateJobStatus(job interface{},
 			}
 		} else {
 			if rtype == kubeflowv1.PyTorchJobReplicaTypeWorker {
-				// TODO(gaocegege): Support SuccessPolicy
-				// Leave a succeeded condition for the following two cases:
+				// Unless RunPolicy.SuccessPolicy is set, leave a succeeded condition for the following two cases:
 				// 1. If all workers are succeeded.
 				// 2. If `ElasticPolicy` is not nil and any worker has completed.
-				if expected == 0 || (pytorchjob.Spec.ElasticPolicy != nil && succeeded > 0) {
+				if pytorchjob.Spec.RunPolicy.SuccessPolicy == nil &&
+					(expected == 0 || (pytorchjob.Spec.ElasticPolicy != nil && succeeded > 0)) {
 					msg := fmt.Sprintf("PyTorchJob %s/%s successfully completed.",
 						pytorchjob.Namespace, pytorchjob.Name)
-					r.recorder.Event(pytorchjob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.PyTorchJobKind, commonutil.JobSucceededReason), msg)
+					r.Recorder.Event(pytorchjob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.PyTorchJobKind, commonutil.JobSucceededReason), msg)
 					if jobStatus.CompletionTime == nil {
 						now := metav1.Now()
 						jobStatus.CompletionTime = &now
@@ -462,37 +180,6 @@ func ContainsMasterSpec(replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaS
 	return false
 }
 
-// UpdateJobStatusInApiServer updates the job status in to cluster.
-func (r *PyTorchJobReconciler) UpdateJobStatusInApiServer(job interface{}, jobStatus *kubeflowv1.JobStatus) error {
-	if jobStatus.ReplicaStatuses == nil {
-		jobStatus.ReplicaStatuses = map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus{}
-	}
-
-	pytorchjob, ok := job.(*kubeflowv1.PyTorchJob)
-	trainingoperatorcommon.ClearGeneratedFields(&pytorchjob.ObjectMeta)
-	if !ok {
-		return fmt.Errorf("%+v is not a type of PyTorchJob", job)
-	}
-
-	// Job status passed in differs with status in job, update in basis of the passed in one.
-	if !equality.Semantic.DeepEqual(&pytorchjob.Status, jobStatus) {
-		pytorchjob = pytorchjob.DeepCopy()
-		pytorchjob.Status = *jobStatus.DeepCopy()
-	}
-
-	result := r.Status().Update(context.Background(), pytorchjob)
-
-	if result != nil {
-		r.Log.WithValues("pytorchjob", types.NamespacedName{
-			Namespace: pytorchjob.GetNamespace(),
-			Name:      pytorchjob.GetName(),
-		})
-		return result
-	}
-
-	return nil
-}
-
 // SetClusterSpec sets the cluster spec and init container for the pod
 func (r *PyTorchJobReconciler) SetClusterSpec(job interface{}, podTemplate *corev1.PodTemplateSpec, rtype, index string) error {
 	if err := setPodEnv(job, podTemplate, rtype, index); err != nil {
@@ -512,27 +199,3 @@ func (r *PyTorchJobReconciler) IsMasterRole(replicas map[kubeflowv1.ReplicaType]
 	// else check if it is worker with index 0
 	return rtype == kubeflowv1.PyTorchJobReplicaTypeWorker && index == 0
 }
-
-func (r *PyTorchJobReconciler) GetDefaultContainerName() string {
-	return kubeflowv1.PyTorchJobDefaultContainerName
-}
-
-func (r *PyTorchJobReconciler) GetDefaultContainerPortName() string {
-	return kubeflowv1.PyTorchJobDefaultPortName
-}
-
-// onOwnerCreateFunc modify creation condition.
-func (r *PyTorchJobReconciler) onOwnerCreateFunc() func(event.CreateEvent) bool {
-	return func(e event.CreateEvent) bool {
-		pytorchjob, ok := e.Object.(*kubeflowv1.PyTorchJob)
-		if !ok {
-			return true
-		}
-		r.Scheme.Default(pytorchjob)
-		msg := fmt.Sprintf("PyTorchJob %s is created.", e.Object.GetName())
-		logrus.Info(msg)
-		trainingoperatorcommon.CreatedJobsCounterInc(pytorchjob.Namespace, r.GetFrameworkName())
-		commonutil.UpdateJobConditions(&pytorchjob.Status, kubeflowv1.JobCreated, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.PyTorchJobKind, commonutil.JobCreatedReason), msg)
-		return true
-	}
-}
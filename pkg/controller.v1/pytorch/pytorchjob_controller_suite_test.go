@@ -22,6 +22,7 @@ import (
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	"github.com/kubeflow/training-operator/pkg/config"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/util/testutil"
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
@@ -45,6 +46,7 @@ var (
 	testEnv       *envtest.Environment
 	testCtx       context.Context
 	testCancel    context.CancelFunc
+	testAdmission *testutil.FakeAdmissionController[*kubeflowv1.PyTorchJob]
 )
 
 func TestAPIs(t *testing.T) {
@@ -95,6 +97,11 @@ var _ = BeforeSuite(func() {
 
 	Expect(r.SetupWithManager(mgr, 1)).NotTo(gomega.HaveOccurred())
 
+	testAdmission = testutil.NewFakeAdmissionController(mgr.GetClient(),
+		func() *kubeflowv1.PyTorchJob { return &kubeflowv1.PyTorchJob{} },
+		func(job *kubeflowv1.PyTorchJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy })
+	Expect(testAdmission.SetupWithManager(mgr, "fake-admission")).NotTo(gomega.HaveOccurred())
+
 	go func() {
 		defer GinkgoRecover()
 		err = mgr.Start(testCtx)
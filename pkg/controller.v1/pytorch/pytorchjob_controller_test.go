@@ -249,6 +249,57 @@ var _ = Describe("PyTorchJob controller", func() {
 			}, testutil.IgnoreJobConditionsTimes))
 		})
 
+		It("Should create pods with the admission of the queue; Should delete them once the PyTorchJob is evicted", func() {
+			const queueName = "test-queue"
+			admission := map[kubeflowv1.ReplicaType]kubeflowv1.ReplicaAdmission{
+				kubeflowv1.PyTorchJobReplicaTypeWorker: {
+					NodeSelector: map[string]string{"flavor": "gpu"},
+					Tolerations: []corev1.Toleration{{
+						Key:      "nvidia.com/gpu",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					}},
+				},
+			}
+			testAdmission.OpenQueue(queueName, admission)
+			defer testAdmission.CloseQueue(queueName)
+
+			By("By creating a new PyTorchJob submitted to the queue")
+			job.Labels = map[string]string{kubeflowv1.QueueNameLabel: queueName}
+			job.Spec.RunPolicy.Suspend = ptr.To(true)
+			job.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].Replicas = ptr.To[int32](1)
+			Expect(testK8sClient.Create(ctx, job)).Should(Succeed())
+
+			By("Checking the pods are created with the admission")
+			masterPod := &corev1.Pod{}
+			workerPod := &corev1.Pod{}
+			Eventually(func() bool {
+				errMaster := testK8sClient.Get(ctx, masterKey, masterPod)
+				errWorker := testK8sClient.Get(ctx, worker0Key, workerPod)
+				return errMaster == nil && errWorker == nil
+			}, testutil.Timeout, testutil.Interval).Should(BeTrue())
+			Expect(workerPod.Spec.NodeSelector).Should(Equal(admission[kubeflowv1.PyTorchJobReplicaTypeWorker].NodeSelector))
+			Expect(workerPod.Spec.Tolerations).Should(ContainElement(admission[kubeflowv1.PyTorchJobReplicaTypeWorker].Tolerations[0]))
+			Expect(masterPod.Spec.NodeSelector).Should(BeEmpty())
+
+			By("Evicting the PyTorchJob from the closed queue")
+			testAdmission.CloseQueue(queueName)
+			Eventually(func() error {
+				return testAdmission.Evict(ctx, jobKey)
+			}, testutil.Timeout, testutil.Interval).Should(Succeed())
+
+			By("Checking the pods are removed and the templates are unchanged")
+			Eventually(func() bool {
+				errMaster := testK8sClient.Get(ctx, masterKey, masterPod)
+				errWorker := testK8sClient.Get(ctx, worker0Key, workerPod)
+				return errors.IsNotFound(errMaster) && errors.IsNotFound(errWorker)
+			}, testutil.Timeout, testutil.Interval).Should(BeTrue())
+			created := &kubeflowv1.PyTorchJob{}
+			Expect(testK8sClient.Get(ctx, jobKey, created)).Should(Succeed())
+			Expect(created.Annotations).ShouldNot(HaveKey(kubeflowv1.AdmissionAnnotation))
+			Expect(created.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].Template.Spec.NodeSelector).Should(BeEmpty())
+		})
+
 		It("Should delete resources after PyTorchJob is suspended; Should resume PyTorchJob after PyTorchJob is unsuspended", func() {
 			By("By creating a new PyTorchJob")
 			job.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].Replicas = ptr.To[int32](1)
@@ -0,0 +1,272 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pytorch
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	trainutil "github.com/kubeflow/training-operator/pkg/util/train"
+)
+
+const (
+	rdzvStoreSuffix = "-rdzv"
+	// rdzvStoreReplicaType is the replica type label of the rendezvous store. The store isn't labeled
+	// with the operator name, so it's not counted with the replicas of the job.
+	rdzvStoreReplicaType   = "rdzv"
+	rdzvStoreContainerName = "rdzv"
+	rdzvStorePortName      = "rdzv-port"
+	rdzvStoreDataVolume    = "rdzv-data"
+	rdzvStoreDataPath      = "/var/lib/etcd"
+
+	// defaultC10DStorePort is the default port of the C10d TCP store, as in torchrun.
+	defaultC10DStorePort int32 = 29400
+	// defaultEtcdStorePort is the default client port of etcd.
+	defaultEtcdStorePort int32 = 2379
+	// defaultEtcdStoreImage is the default image of the etcd store, the rendezvous
+	// of the etcd backends needs the v2 API which was removed in etcd 3.6.
+	defaultEtcdStoreImage = "quay.io/coreos/etcd:v3.4.33"
+
+	// c10dStoreScript serves a C10d TCP store until the pod is deleted.
+	c10dStoreScript = `import threading
+import torch.distributed as dist
+store = dist.TCPStore("0.0.0.0", %d, is_master=True, wait_for_workers=False)
+threading.Event().wait()
+`
+)
+
+// hasRDZVStore checks whether the rendezvous store of the PyTorchJob is managed by the operator.
+func hasRDZVStore(job *kubeflowv1.PyTorchJob) bool {
+	return job.Spec.ElasticPolicy != nil && job.Spec.ElasticPolicy.RDZVStore != nil
+}
+
+// rdzvStoreName returns the name of the pod and the Service of the rendezvous store.
+func rdzvStoreName(job *kubeflowv1.PyTorchJob) string {
+	return job.Name + rdzvStoreSuffix
+}
+
+// isEtcdBackend checks whether the rendezvous backend is one of the etcd backends.
+func isEtcdBackend(elasticPolicy *kubeflowv1.ElasticPolicy) bool {
+	backend := ptr.Deref(elasticPolicy.RDZVBackend, kubeflowv1.BackendC10D)
+	return backend == kubeflowv1.BackendETCD || backend == kubeflowv1.BackendETCDV2
+}
+
+// rdzvStorePort returns the port of the rendezvous store, the RDZVPort if it is set.
+func rdzvStorePort(elasticPolicy *kubeflowv1.ElasticPolicy) int32 {
+	if elasticPolicy.RDZVPort != nil {
+		return *elasticPolicy.RDZVPort
+	}
+	if isEtcdBackend(elasticPolicy) {
+		return defaultEtcdStorePort
+	}
+	return defaultC10DStorePort
+}
+
+func rdzvStoreLabels(job *kubeflowv1.PyTorchJob) map[string]string {
+	return map[string]string{
+		kubeflowv1.JobNameLabel:     job.Name,
+		kubeflowv1.ReplicaTypeLabel: rdzvStoreReplicaType,
+	}
+}
+
+// ReconcileRDZVStore reconciles the rendezvous store managed by the PyTorchJob. The store is
+// deleted when the job is suspended, and once the job is finished unless the CleanPodPolicy is None.
+// A store which was evicted is recreated.
+func (r *PyTorchJobReconciler) ReconcileRDZVStore(job *kubeflowv1.PyTorchJob) error {
+	if !hasRDZVStore(job) {
+		return nil
+	}
+	logger := r.Log.WithValues(kubeflowv1.PyTorchJobSingular, job.Name)
+
+	if commonutil.IsFinished(job.Status) {
+		if ptr.Deref(job.Spec.RunPolicy.CleanPodPolicy, kubeflowv1.CleanPodPolicyNone) == kubeflowv1.CleanPodPolicyNone {
+			return nil
+		}
+		return r.deleteRDZVStore(job)
+	}
+	if trainutil.IsJobSuspended(&job.Spec.RunPolicy) {
+		return r.deleteRDZVStore(job)
+	}
+
+	key := types.NamespacedName{Namespace: job.Namespace, Name: rdzvStoreName(job)}
+	svc := &corev1.Service{}
+	if err := r.Get(context.TODO(), key, svc); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		expected, err := newRDZVStoreService(job, r.Scheme)
+		if err != nil {
+			return err
+		}
+		logger.V(1).Info("Creating rendezvous store Service", "namespace", expected.Namespace, "name", expected.Name)
+		if err = r.Create(context.TODO(), expected); err != nil {
+			return err
+		}
+	} else if !metav1.IsControlledBy(svc, job) {
+		return fmt.Errorf("service %s already exists and is not managed by PyTorchJob %s", svc.Name, job.Name)
+	}
+
+	pod := &corev1.Pod{}
+	if err := r.Get(context.TODO(), key, pod); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		expected, err := newRDZVStorePod(job, r.Scheme)
+		if err != nil {
+			return err
+		}
+		logger.V(1).Info("Creating rendezvous store", "namespace", expected.Namespace, "name", expected.Name)
+		return r.Create(context.TODO(), expected)
+	}
+	if !metav1.IsControlledBy(pod, job) {
+		return fmt.Errorf("pod %s already exists and is not managed by PyTorchJob %s", pod.Name, job.Name)
+	}
+	// The containers of the store are always restarted, so the pod only fails when it's evicted.
+	if pod.DeletionTimestamp == nil && (pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded) {
+		logger.Info("Deleting the terminated rendezvous store", "pod", klog.KObj(pod), "reason", pod.Status.Reason)
+		return client.IgnoreNotFound(r.Delete(context.TODO(), pod))
+	}
+	return nil
+}
+
+// deleteRDZVStore deletes the pod and the Service of the rendezvous store.
+func (r *PyTorchJobReconciler) deleteRDZVStore(job *kubeflowv1.PyTorchJob) error {
+	meta := metav1.ObjectMeta{Namespace: job.Namespace, Name: rdzvStoreName(job)}
+	if err := r.Delete(context.TODO(), &corev1.Pod{ObjectMeta: meta}); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	return client.IgnoreNotFound(r.Delete(context.TODO(), &corev1.Service{ObjectMeta: meta}))
+}
+
+// newRDZVStoreService creates the Service giving the rendezvous store a stable endpoint.
+func newRDZVStoreService(job *kubeflowv1.PyTorchJob, scheme *runtime.Scheme) (*corev1.Service, error) {
+	port := rdzvStorePort(job.Spec.ElasticPolicy)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rdzvStoreName(job),
+			Namespace: job.Namespace,
+			Labels:    rdzvStoreLabels(job),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: rdzvStoreLabels(job),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       rdzvStorePortName,
+					Port:       port,
+					TargetPort: intstr.FromString(rdzvStorePortName),
+				},
+			},
+		},
+	}
+	if err := controllerruntime.SetControllerReference(job, svc, scheme); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+// newRDZVStorePod creates the pod of the rendezvous store, a C10d TCP store or a single-member etcd.
+func newRDZVStorePod(job *kubeflowv1.PyTorchJob, scheme *runtime.Scheme) (*corev1.Pod, error) {
+	elasticPolicy := job.Spec.ElasticPolicy
+	port := rdzvStorePort(elasticPolicy)
+
+	container := corev1.Container{
+		Name:  rdzvStoreContainerName,
+		Image: elasticPolicy.RDZVStore.Image,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          rdzvStorePortName,
+				ContainerPort: port,
+			},
+		},
+		Resources: elasticPolicy.RDZVStore.Resources,
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString(rdzvStorePortName)},
+			},
+		},
+	}
+	var volumes []corev1.Volume
+	if isEtcdBackend(elasticPolicy) {
+		if len(container.Image) == 0 {
+			container.Image = defaultEtcdStoreImage
+		}
+		container.Command = []string{
+			"etcd",
+			"--data-dir=" + rdzvStoreDataPath,
+			"--enable-v2",
+			fmt.Sprintf("--listen-client-urls=http://0.0.0.0:%d", port),
+			fmt.Sprintf("--advertise-client-urls=http://%s:%d", rdzvStoreName(job), port),
+		}
+		container.VolumeMounts = []corev1.VolumeMount{{Name: rdzvStoreDataVolume, MountPath: rdzvStoreDataPath}}
+		volumes = []corev1.Volume{{Name: rdzvStoreDataVolume, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+	} else {
+		if len(container.Image) == 0 {
+			image, err := getImageFromPyTorchJob(job, kubeflowv1.PyTorchJobReplicaTypeWorker)
+			if err != nil {
+				return nil, err
+			}
+			container.Image = image
+		}
+		container.Command = []string{"python", "-c", fmt.Sprintf(c10dStoreScript, port)}
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rdzvStoreName(job),
+			Namespace: job.Namespace,
+			Labels:    rdzvStoreLabels(job),
+		},
+		Spec: corev1.PodSpec{
+			Containers:    []corev1.Container{container},
+			Volumes:       volumes,
+			RestartPolicy: corev1.RestartPolicyAlways,
+		},
+	}
+	// The C10d store runs the image of the workers, which may be pulled with their secrets.
+	if spec := job.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker]; spec != nil {
+		pod.Spec.ImagePullSecrets = spec.Template.Spec.ImagePullSecrets
+	}
+	if err := controllerruntime.SetControllerReference(job, pod, scheme); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+// getImageFromPyTorchJob returns the image of the pytorch container of the replica type.
+func getImageFromPyTorchJob(job *kubeflowv1.PyTorchJob, rtype kubeflowv1.ReplicaType) (string, error) {
+	spec := job.Spec.PyTorchReplicaSpecs[rtype]
+	if spec == nil {
+		return "", fmt.Errorf("cannot find the %s spec", rtype)
+	}
+	for _, container := range spec.Template.Spec.Containers {
+		if container.Name == kubeflowv1.PyTorchJobDefaultContainerName {
+			return container.Image, nil
+		}
+	}
+	return "", fmt.Errorf("image not found")
+}
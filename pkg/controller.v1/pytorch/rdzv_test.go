@@ -0,0 +1,118 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pytorch
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestNewRDZVStorePod(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := kubeflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the kubeflow.org/v1 scheme: %v", err)
+	}
+
+	cases := map[string]struct {
+		elasticPolicy *kubeflowv1.ElasticPolicy
+		wantImage     string
+		wantCommand   string
+		wantPort      int32
+	}{
+		"c10d store with the image of the workers": {
+			elasticPolicy: &kubeflowv1.ElasticPolicy{
+				RDZVStore: &kubeflowv1.RDZVStore{},
+			},
+			wantImage:   "pytorch:latest",
+			wantCommand: "python",
+			wantPort:    defaultC10DStorePort,
+		},
+		"c10d store with a custom image and port": {
+			elasticPolicy: &kubeflowv1.ElasticPolicy{
+				RDZVBackend: ptr.To(kubeflowv1.BackendC10D),
+				RDZVPort:    ptr.To[int32](1234),
+				RDZVStore: &kubeflowv1.RDZVStore{
+					Image: "rdzv:latest",
+				},
+			},
+			wantImage:   "rdzv:latest",
+			wantCommand: "python",
+			wantPort:    1234,
+		},
+		"etcd store": {
+			elasticPolicy: &kubeflowv1.ElasticPolicy{
+				RDZVBackend: ptr.To(kubeflowv1.BackendETCDV2),
+				RDZVStore:   &kubeflowv1.RDZVStore{},
+			},
+			wantImage:   defaultEtcdStoreImage,
+			wantCommand: "etcd",
+			wantPort:    defaultEtcdStorePort,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := &kubeflowv1.PyTorchJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: kubeflowv1.PyTorchJobSpec{
+					ElasticPolicy: tc.elasticPolicy,
+					PyTorchReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+						kubeflowv1.PyTorchJobReplicaTypeWorker: {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  kubeflowv1.PyTorchJobDefaultContainerName,
+											Image: "pytorch:latest",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			pod, err := newRDZVStorePod(job, scheme)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if pod.Name != "test-rdzv" {
+				t.Errorf("Unexpected name: \nwant: %v\ngot: %v\n", "test-rdzv", pod.Name)
+			}
+			if !metav1.IsControlledBy(pod, job) {
+				t.Errorf("Expected the pod to be controlled by the PyTorchJob")
+			}
+			container := pod.Spec.Containers[0]
+			if container.Image != tc.wantImage {
+				t.Errorf("Unexpected image: \nwant: %v\ngot: %v\n", tc.wantImage, container.Image)
+			}
+			if container.Command[0] != tc.wantCommand {
+				t.Errorf("Unexpected command: \nwant: %v\ngot: %v\n", tc.wantCommand, container.Command[0])
+			}
+			if container.Ports[0].ContainerPort != tc.wantPort {
+				t.Errorf("Unexpected port: \nwant: %v\ngot: %v\n", tc.wantPort, container.Ports[0].ContainerPort)
+			}
+		})
+	}
+}
@@ -18,91 +18,46 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	trainingoperatorcommon "github.com/kubeflow/training-operator/pkg/common"
 	"github.com/kubeflow/training-operator/pkg/common/util"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common/framework"
 	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
-	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
 	commonutil "github.com/kubeflow/training-operator/pkg/util"
 
-	"github.com/go-logr/logr"
-	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
-	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/client-go/informers"
-	kubeclientset "k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/record"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
-	"sigs.k8s.io/controller-runtime/pkg/source"
-	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
-	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
 const (
-	FailedDeleteJobReason     = "FailedDeleteJob"
-	SuccessfulDeleteJobReason = "SuccessfulDeleteJob"
-
 	controllerName = "tfjob-controller"
 
 	// tfConfig is the environment variable name of TensorFlow cluster spec.
 	tfConfig = "TF_CONFIG"
 )
 
+// NewReconciler creates a TFJob Reconciler
 func NewReconciler(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) *TFJobReconciler {
-	r := &TFJobReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		recorder:  mgr.GetEventRecorderFor(controllerName),
-		apiReader: mgr.GetAPIReader(),
-		Log:       log.Log,
-	}
-
-	cfg := mgr.GetConfig()
-	kubeClientSet := kubeclientset.NewForConfigOrDie(cfg)
-	sharedInformers := informers.NewSharedInformerFactory(kubeClientSet, 0)
-	priorityClassInformer := sharedInformers.Scheduling().V1().PriorityClasses()
-
-	r.JobController = common.JobController{
-		Controller:                  r,
-		Expectations:                expectation.NewControllerExpectations(),
-		WorkQueue:                   &util.FakeWorkQueue{},
-		Recorder:                    r.recorder,
-		KubeClientSet:               kubeClientSet,
-		PriorityClassLister:         priorityClassInformer.Lister(),
-		PriorityClassInformerSynced: priorityClassInformer.Informer().HasSynced,
-		PodControl:                  control.RealPodControl{KubeClient: kubeClientSet, Recorder: r.recorder},
-		ServiceControl:              control.RealServiceControl{KubeClient: kubeClientSet, Recorder: r.recorder},
-	}
-
-	gangSchedulingSetupFunc(&r.JobController)
-
+	r := &TFJobReconciler{}
+	r.Reconciler = framework.NewReconciler[*kubeflowv1.TFJob](mgr, r, framework.Info{
+		Kind:                     kubeflowv1.TFJobKind,
+		Singular:                 kubeflowv1.TFJobSingular,
+		FrameworkName:            kubeflowv1.TFJobFrameworkName,
+		ControllerName:           controllerName,
+		DefaultContainerName:     kubeflowv1.TFJobDefaultContainerName,
+		DefaultContainerPortName: kubeflowv1.TFJobDefaultPortName,
+	}, gangSchedulingSetupFunc)
 	return r
 }
 
 // TFJobReconciler reconciles a TFJob object
 type TFJobReconciler struct {
-	common.JobController
-	client.Client
-	Scheme    *runtime.Scheme
-	recorder  record.EventRecorder
-	apiReader client.Reader
-	Log       logr.Logger
+	*framework.Reconciler[*kubeflowv1.TFJob]
 }
 
 //+kubebuilder:rbac:groups=kubeflow.org,resources=tfjobs,verbs=get;list;watch;create;update;patch;delete
@@ -114,161 +69,24 @@ type TFJobReconciler struct {
 //+kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-func (r *TFJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
-	logger := r.Log.WithValues(kubeflowv1.TFJobSingular, req.NamespacedName)
-
-	tfjob := &kubeflowv1.TFJob{}
-	err := r.Get(ctx, req.NamespacedName, tfjob)
-	if err != nil {
-		logger.Info(err.Error(), "unable to fetch TFJob", req.NamespacedName.String())
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
-	if err = kubeflowv1.ValidateV1TFJob(tfjob); err != nil {
-		logger.Error(err, "TFJob failed validation")
-		r.Recorder.Eventf(tfjob, corev1.EventTypeWarning, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobFailedValidationReason),
-			"TFJob failed validation because %s", err)
-		return ctrl.Result{}, err
-	}
-
-	// Check if reconciliation is needed
-	jobKey, err := common.KeyFunc(tfjob)
-	if err != nil {
-		utilruntime.HandleError(fmt.Errorf("couldn't get jobKey for job object %#v: %v", tfjob, err))
-	}
-
-	replicaTypes := util.GetReplicaTypes(tfjob.Spec.TFReplicaSpecs)
-	needReconcile := util.SatisfiedExpectations(r.Expectations, jobKey, replicaTypes)
-
-	if !needReconcile || tfjob.GetDeletionTimestamp() != nil {
-		logger.Info("reconcile cancelled, job does not need to do reconcile or has been deleted",
-			"sync", needReconcile, "deleted", tfjob.GetDeletionTimestamp() != nil)
-		return ctrl.Result{}, nil
-	}
-
-	// Set default priorities to tfjob
-	r.Scheme.Default(tfjob)
-
-	// Use common to reconcile the job related pod and service
-	err = r.ReconcileJobs(tfjob, tfjob.Spec.TFReplicaSpecs, tfjob.Status, &tfjob.Spec.RunPolicy)
-	if err != nil {
-		logrus.Warnf("Reconcile Tensorflow Job error %v", err)
-		return ctrl.Result{}, err
-	}
-
-	t, err := util.DurationUntilExpireTime(&tfjob.Spec.RunPolicy, tfjob.Status)
-	if err != nil {
-		logrus.Warnf("Reconcile Tensorflow Job error %v", err)
-		return ctrl.Result{}, err
-	}
-	if t >= 0 {
-		return ctrl.Result{Requeue: true, RequeueAfter: t}, nil
-	}
-
-	return ctrl.Result{}, nil
-}
-
-// SetupWithManager sets up the controller with the Manager.
-func (r *TFJobReconciler) SetupWithManager(mgr ctrl.Manager, controllerThreads int) error {
-	c, err := controller.New(r.ControllerName(), mgr, controller.Options{
-		Reconciler:              r,
-		MaxConcurrentReconciles: controllerThreads,
-	})
-	if err != nil {
-		return err
-	}
-
-	// using onOwnerCreateFunc is easier to set defaults
-	if err = c.Watch(source.Kind(mgr.GetCache(), &kubeflowv1.TFJob{}), &handler.EnqueueRequestForObject{},
-		predicate.Funcs{CreateFunc: r.onOwnerCreateFunc()},
-	); err != nil {
-		return err
-	}
-
-	// eventHandler for owned objects
-	eventHandler := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &kubeflowv1.TFJob{}, handler.OnlyControllerOwner())
-	predicates := predicate.Funcs{
-		CreateFunc: util.OnDependentCreateFunc(r.Expectations),
-		UpdateFunc: util.OnDependentUpdateFunc(&r.JobController),
-		DeleteFunc: util.OnDependentDeleteFunc(r.Expectations),
-	}
-	// Create generic predicates
-	genericPredicates := predicate.Funcs{
-		CreateFunc: util.OnDependentCreateFuncGeneric(r.Expectations),
-		UpdateFunc: util.OnDependentUpdateFuncGeneric(&r.JobController),
-		DeleteFunc: util.OnDependentDeleteFuncGeneric(r.Expectations),
-	}
-	// inject watching for job related pod
-	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Pod{}), eventHandler, predicates); err != nil {
-		return err
-	}
-	// inject watching for job related service
-	if err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Service{}), eventHandler, predicates); err != nil {
-		return err
-	}
-	// skip watching volcano PodGroup if volcano PodGroup is not installed
-	if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: v1beta1.GroupName, Kind: "PodGroup"},
-		v1beta1.SchemeGroupVersion.Version); err == nil {
-		// inject watching for job related volcano PodGroup
-		if err = c.Watch(source.Kind(mgr.GetCache(), &v1beta1.PodGroup{}), eventHandler, genericPredicates); err != nil {
-			return err
-		}
-	}
-	// skip watching scheduler-plugins PodGroup if scheduler-plugins PodGroup is not installed
-	if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: schedulerpluginsv1alpha1.SchemeGroupVersion.Group, Kind: "PodGroup"},
-		schedulerpluginsv1alpha1.SchemeGroupVersion.Version); err == nil {
-		// inject watching for job related scheduler-plugins PodGroup
-		if err = c.Watch(source.Kind(mgr.GetCache(), &schedulerpluginsv1alpha1.PodGroup{}), eventHandler, genericPredicates); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (r *TFJobReconciler) ControllerName() string {
-	return controllerName
-}
-
-func (r *TFJobReconciler) GetAPIGroupVersionKind() schema.GroupVersionKind {
-	return kubeflowv1.GroupVersion.WithKind(kubeflowv1.TFJobKind)
+func (r *TFJobReconciler) NewJob() *kubeflowv1.TFJob {
+	return &kubeflowv1.TFJob{}
 }
 
-func (r *TFJobReconciler) GetAPIGroupVersion() schema.GroupVersion {
-	return kubeflowv1.GroupVersion
+func (r *TFJobReconciler) GetReplicaSpecs(job *kubeflowv1.TFJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+	return job.Spec.TFReplicaSpecs
 }
 
-func (r *TFJobReconciler) GetGroupNameLabelValue() string {
-	return kubeflowv1.GroupVersion.Group
+func (r *TFJobReconciler) GetRunPolicy(job *kubeflowv1.TFJob) *kubeflowv1.RunPolicy {
+	return &job.Spec.RunPolicy
 }
 
-func (r *TFJobReconciler) GetFrameworkName() string {
-	return kubeflowv1.TFJobFrameworkName
+func (r *TFJobReconciler) GetJobStatus(job *kubeflowv1.TFJob) *kubeflowv1.JobStatus {
+	return &job.Status
 }
 
-func (r *TFJobReconciler) GetJobFromInformerCache(namespace, name string) (metav1.Object, error) {
-	tfjob := &kubeflowv1.TFJob{}
-	err := r.Get(context.Background(), types.NamespacedName{
-		Namespace: namespace, Name: name,
-	}, tfjob)
-	return tfjob, err
-}
-
-func (r *TFJobReconciler) GetJobFromAPIClient(namespace, name string) (metav1.Object, error) {
-	job := &kubeflowv1.TFJob{}
-
-	err := r.apiReader.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, job)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			logrus.Error(err, "tensorflow job not found", "namespace", namespace, "name", name)
-		} else {
-			logrus.Error(err, "failed to get job from api-server", "namespace", namespace, "name", name)
-		}
-		return nil, err
-	}
-	return job, nil
+func (r *TFJobReconciler) ValidateJob(job *kubeflowv1.TFJob) error {
+	return kubeflowv1.ValidateV1TFJob(job)
 }
 
 // GetPodsForJob returns the set of pods that this job should manage.
@@ -359,37 +177,8 @@ func (r *TFJobReconciler) GetServicesForJob(jobObject interface{}) ([]*corev1.Se
 	return cm.ClaimServices(services)
 }
 
-func (r *TFJobReconciler) DeleteJob(job interface{}) error {
-	tfJob, ok := job.(*kubeflowv1.TFJob)
-	if !ok {
-		return fmt.Errorf("%v is not a type of TFJob", tfJob)
-	}
-
-	log := commonutil.LoggerForJob(tfJob)
-	if err := r.Delete(context.Background(), tfJob); err != nil {
-		r.recorder.Eventf(tfJob, v1.EventTypeWarning, FailedDeleteJobReason, "Error deleting: %v", err)
-		log.Errorf("failed to delete job %s/%s, %v", tfJob.Namespace, tfJob.Name, err)
-		return err
-	}
-
-	r.recorder.Eventf(tfJob, v1.EventTypeNormal, SuccessfulDeleteJobReason, "Deleted job: %v", tfJob.Name)
-	log.Infof("job %s/%s has been deleted", tfJob.Namespace, tfJob.Name)
-	trainingoperatorcommon.DeletedJobsCounterInc(tfJob.Namespace, r.GetFrameworkName())
-	return nil
-}
-
-func (r *TFJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec, jobStatus *kubeflowv1.JobStatus) error {
-	tfJob, ok := job.(*kubeflowv1.TFJob)
-	if !ok {
-		return fmt.Errorf("%v is not a type of TFJob", tfJob)
-	}
-
-	tfJobKey, err := common.KeyFunc(tfJob)
-	if err != nil {
-		utilruntime.HandleError(fmt.Errorf("couldn't get key for tfjob object %#v: %v", tfJob, err))
-		return err
-	}
-
+// UpdateJobConditions updates the job conditions based on the status of the chief, master or workers.
+func (r *TFJobReconciler) UpdateJobConditions(tfJob *kubeflowv1.TFJob, replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec, jobStatus *kubeflowv1.JobStatus) error {
 	logger := commonutil.LoggerForJob(tfJob)
 
 	worker0Completed, err := r.IsWorker0Completed(tfJob, replicas)
@@ -398,18 +187,6 @@ func (r *TFJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubeflow
 		return err
 	}
 
-	// Set StartTime.
-	if jobStatus.StartTime == nil {
-		now := metav1.Now()
-		jobStatus.StartTime = &now
-		// enqueue a sync to check if job past ActiveDeadlineSeconds
-		if tfJob.Spec.RunPolicy.ActiveDeadlineSeconds != nil {
-			logger.Infof("Job with ActiveDeadlineSeconds will sync after %d seconds", *tfJob.Spec.RunPolicy.ActiveDeadlineSeconds)
-			// TODO(Jeffwan): requeue job key in reconciler scenarios
-			r.WorkQueue.AddAfter(tfJobKey, time.Duration(*tfJob.Spec.RunPolicy.ActiveDeadlineSeconds)*time.Second)
-		}
-	}
-
 	// For the situation that jobStatus has a restarting condition, and append a running condition,
 	// the restarting condition will be removed from jobStatus by kubeflowv1.filterOutCondition(),
 	// so we need to record the existing restarting condition for later use.
@@ -455,10 +232,10 @@ func (r *TFJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubeflow
 					msg := fmt.Sprintf("TFJob %s/%s is running.", tfJob.Namespace, tfJob.Name)
 					commonutil.UpdateJobConditions(jobStatus, kubeflowv1.JobRunning, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobRunningReason), msg)
 				}
-				if expected == 0 {
+				if expected == 0 && tfJob.Spec.RunPolicy.SuccessPolicy == nil {
 					msg := fmt.Sprintf("TFJob %s/%s successfully completed.",
 						tfJob.Namespace, tfJob.Name)
-					r.recorder.Event(tfJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobSucceededReason), msg)
+					r.Recorder.Event(tfJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobSucceededReason), msg)
 					if jobStatus.CompletionTime == nil {
 						now := metav1.Now()
 						jobStatus.CompletionTime = &now
@@ -469,13 +246,14 @@ func (r *TFJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubeflow
 			}
 		} else {
 			if rtype == kubeflowv1.TFJobReplicaTypeWorker {
-				// Leave a succeeded condition for the following two cases:
+				// Unless RunPolicy.SuccessPolicy is set, leave a succeeded condition for the following two cases:
 				// 1. If default success policy is used and worker 0 has completed.
 				// 2. If `SuccessPolicyAllWorkers` success policy is used and all workers are succeeded.
-				if expected == 0 || (worker0Completed && *tfJob.Spec.SuccessPolicy != kubeflowv1.SuccessPolicyAllWorkers) {
+				if tfJob.Spec.RunPolicy.SuccessPolicy == nil &&
+					(expected == 0 || (worker0Completed && *tfJob.Spec.SuccessPolicy != kubeflowv1.SuccessPolicyAllWorkers)) {
 					msg := fmt.Sprintf("TFJob %s/%s successfully completed.",
 						tfJob.Namespace, tfJob.Name)
-					r.recorder.Event(tfJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobSucceededReason), msg)
+					r.Recorder.Event(tfJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobSucceededReason), msg)
 					if jobStatus.CompletionTime == nil {
 						now := metav1.Now()
 						jobStatus.CompletionTime = &now
@@ -507,7 +285,7 @@ func (r *TFJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubeflow
 				}
 				msg := fmt.Sprintf("TFJob %s/%s has failed because %d %s replica(s) failed.",
 					tfJob.Namespace, tfJob.Name, failed, rtype)
-				r.recorder.Event(tfJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobFailedReason), msg)
+				r.Recorder.Event(tfJob, corev1.EventTypeNormal, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobFailedReason), msg)
 				if jobStatus.CompletionTime == nil {
 					now := metav1.Now()
 					jobStatus.CompletionTime = &now
@@ -526,39 +304,6 @@ func (r *TFJobReconciler) UpdateJobStatus(job interface{}, replicas map[kubeflow
 	return nil
 }
 
-func (r *TFJobReconciler) UpdateJobStatusInApiServer(job interface{}, jobStatus *kubeflowv1.JobStatus) error {
-	if jobStatus.ReplicaStatuses == nil {
-		jobStatus.ReplicaStatuses = map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus{}
-	}
-
-	tfJob, ok := job.(*kubeflowv1.TFJob)
-	if !ok {
-		return fmt.Errorf("%v is not a type of TFJob", tfJob)
-	}
-
-	startTime := time.Now()
-	logger := commonutil.LoggerForJob(tfJob)
-	defer func() {
-		logger.Infof("Finished updating TFJobs Status %q (%v)",
-			tfJob.Name, time.Since(startTime))
-	}()
-
-	tfJob = tfJob.DeepCopy()
-	tfJob.Status = *jobStatus.DeepCopy()
-
-	result := r.Status().Update(context.Background(), tfJob)
-
-	if result != nil {
-		r.Log.WithValues("tfjob", types.NamespacedName{
-			Namespace: tfJob.GetNamespace(),
-			Name:      tfJob.GetName(),
-		})
-		return result
-	}
-
-	return nil
-}
-
 // Same as Func (tc *TFController) SetClusterSpec(...) in pod.go
 func (r *TFJobReconciler) SetClusterSpec(job interface{}, podTemplate *corev1.PodTemplateSpec, rtype, index string) error {
 	tfjob, ok := job.(*kubeflowv1.TFJob)
@@ -595,14 +340,6 @@ func (r *TFJobReconciler) SetClusterSpec(job interface{}, podTemplate *corev1.Po
 	return nil
 }
 
-func (r *TFJobReconciler) GetDefaultContainerName() string {
-	return kubeflowv1.TFJobDefaultContainerName
-}
-
-func (r *TFJobReconciler) GetDefaultContainerPortName() string {
-	return kubeflowv1.TFJobDefaultPortName
-}
-
 func (r *TFJobReconciler) IsMasterRole(replicas map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec,
 	rtype kubeflowv1.ReplicaType, index int) bool {
 	if ContainsChiefOrMasterSpec(replicas) {
@@ -655,20 +392,3 @@ func (r *TFJobReconciler) getPodSlices(tfjob *kubeflowv1.TFJob, replicasNum *int
 	podSlices := r.GetPodSlices(pods, int(*replicasNum), logger)
 	return podSlices, nil
 }
-
-// onOwnerCreateFunc modify creation condition.
-func (r *TFJobReconciler) onOwnerCreateFunc() func(event.CreateEvent) bool {
-	return func(e event.CreateEvent) bool {
-		tfJob, ok := e.Object.(*kubeflowv1.TFJob)
-		if !ok {
-			return true
-		}
-
-		r.Scheme.Default(tfJob)
-		msg := fmt.Sprintf("TFJob %s is created.", e.Object.GetName())
-		logrus.Info(msg)
-		trainingoperatorcommon.CreatedJobsCounterInc(tfJob.Namespace, r.GetFrameworkName())
-		commonutil.UpdateJobConditions(&tfJob.Status, kubeflowv1.JobCreated, corev1.ConditionTrue, commonutil.NewReason(kubeflowv1.TFJobKind, commonutil.JobCreatedReason), msg)
-		return true
-	}
-}
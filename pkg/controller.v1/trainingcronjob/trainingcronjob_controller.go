@@ -0,0 +1,425 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trainingcronjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+	"github.com/kubeflow/training-operator/pkg/util/cron"
+)
+
+const (
+	controllerName = "trainingcronjob-controller"
+
+	SuccessfulCreateReason   = "SuccessfulCreate"
+	FailedCreateReason       = "FailedCreate"
+	SuccessfulDeleteReason   = "SuccessfulDelete"
+	FailedDeleteReason       = "FailedDelete"
+	MissScheduleReason       = "MissSchedule"
+	JobAlreadyActiveReason   = "JobAlreadyActive"
+	InvalidScheduleReason    = "InvalidSchedule"
+	InvalidJobTemplateReason = "InvalidJobTemplate"
+	TooManyMissedTimesReason = "TooManyMissedTimes"
+
+	// tooManyMissedTimes is the number of the missed scheduled times above which a warning is recorded.
+	tooManyMissedTimes = 100
+)
+
+// NewReconciler creates a TrainingCronJob Reconciler
+func NewReconciler(mgr manager.Manager) *TrainingCronJobReconciler {
+	return &TrainingCronJobReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor(controllerName),
+		Log:      log.Log,
+		Clock:    clock.RealClock{},
+	}
+}
+
+// TrainingCronJobReconciler reconciles a TrainingCronJob object
+type TrainingCronJobReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Log      logr.Logger
+	// Clock is the source of the current time the schedules are evaluated against.
+	Clock clock.PassiveClock
+}
+
+//+kubebuilder:rbac:groups=kubeflow.org,resources=trainingcronjobs,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=kubeflow.org,resources=trainingcronjobs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=kubeflow.org,resources=trainingcronjobs/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates the jobs of the TrainingCronJob at their scheduled times, removes the finished
+// jobs beyond the history limits and requeues the TrainingCronJob at its next scheduled time.
+func (r *TrainingCronJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues(kubeflowv1.TrainingCronJobSingular, req.NamespacedName)
+
+	cronJob := &kubeflowv1.TrainingCronJob{}
+	if err := r.Get(ctx, req.NamespacedName, cronJob); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	jobs, err := r.listJobs(ctx, cronJob)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	status := cronJob.Status.DeepCopy()
+	updateActive(status, jobs)
+	if err = r.cleanupFinishedJobs(ctx, cronJob, jobs); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	result, err := r.syncSchedule(ctx, logger, cronJob, status)
+	if !equality.Semantic.DeepEqual(status, &cronJob.Status) {
+		cronJob.Status = *status
+		if updateErr := r.Status().Update(ctx, cronJob); updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TrainingCronJobReconciler) SetupWithManager(mgr ctrl.Manager, controllerThreads int) error {
+	c, err := controller.New(controllerName, mgr, controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: controllerThreads,
+	})
+	if err != nil {
+		return err
+	}
+	if err = c.Watch(source.Kind(mgr.GetCache(), &kubeflowv1.TrainingCronJob{}), &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+	ownerHandler := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &kubeflowv1.TrainingCronJob{}, handler.OnlyControllerOwner())
+	for _, kind := range core.JobKinds {
+		// Skip the kinds whose CRD is not installed.
+		if _, err = mgr.GetRESTMapper().RESTMapping(schema.GroupKind{Group: kubeflowv1.GroupVersion.Group, Kind: kind},
+			kubeflowv1.GroupVersion.Version); err != nil {
+			continue
+		}
+		job, err := core.NewJob(kind)
+		if err != nil {
+			return err
+		}
+		if err = c.Watch(source.Kind(mgr.GetCache(), job), ownerHandler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listJobs returns the jobs of all kinds controlled by the TrainingCronJob.
+func (r *TrainingCronJobReconciler) listJobs(ctx context.Context, cronJob *kubeflowv1.TrainingCronJob) ([]client.Object, error) {
+	var jobs []client.Object
+	for _, kind := range core.JobKinds {
+		list, err := core.NewJobList(kind)
+		if err != nil {
+			return nil, err
+		}
+		if err = r.List(ctx, list, client.InNamespace(cronJob.Namespace)); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		if err = meta.EachListItem(list, func(item runtime.Object) error {
+			if job, ok := item.(client.Object); ok && metav1.IsControlledBy(job, cronJob) {
+				jobs = append(jobs, job)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+// updateActive sets the active jobs and the last successful time of the status from the jobs.
+func updateActive(status *kubeflowv1.TrainingCronJobStatus, jobs []client.Object) {
+	status.Active = nil
+	for _, job := range jobs {
+		_, jobStatus := core.JobRunPolicyAndStatus(job)
+		if !commonutil.IsFinished(*jobStatus) {
+			status.Active = append(status.Active, jobReference(job))
+			continue
+		}
+		if commonutil.IsSucceeded(*jobStatus) && jobStatus.CompletionTime != nil &&
+			(status.LastSuccessfulTime == nil || status.LastSuccessfulTime.Before(jobStatus.CompletionTime)) {
+			status.LastSuccessfulTime = jobStatus.CompletionTime.DeepCopy()
+		}
+	}
+	sort.Slice(status.Active, func(i, j int) bool {
+		return status.Active[i].Name < status.Active[j].Name
+	})
+}
+
+// cleanupFinishedJobs deletes the oldest finished jobs beyond the history limits.
+func (r *TrainingCronJobReconciler) cleanupFinishedJobs(ctx context.Context, cronJob *kubeflowv1.TrainingCronJob, jobs []client.Object) error {
+	var succeeded, failed []client.Object
+	for _, job := range jobs {
+		_, jobStatus := core.JobRunPolicyAndStatus(job)
+		if commonutil.IsSucceeded(*jobStatus) {
+			succeeded = append(succeeded, job)
+		} else if commonutil.IsFailed(*jobStatus) {
+			failed = append(failed, job)
+		}
+	}
+	if err := r.deleteOldestJobs(ctx, cronJob, succeeded,
+		ptr.Deref(cronJob.Spec.SuccessfulJobsHistoryLimit, kubeflowv1.DefaultSuccessfulJobsHistoryLimit)); err != nil {
+		return err
+	}
+	return r.deleteOldestJobs(ctx, cronJob, failed,
+		ptr.Deref(cronJob.Spec.FailedJobsHistoryLimit, kubeflowv1.DefaultFailedJobsHistoryLimit))
+}
+
+func (r *TrainingCronJobReconciler) deleteOldestJobs(ctx context.Context, cronJob *kubeflowv1.TrainingCronJob, jobs []client.Object, limit int32) error {
+	if len(jobs) <= int(limit) {
+		return nil
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return finishedTime(jobs[i]).Before(finishedTime(jobs[j]))
+	})
+	for _, job := range jobs[:len(jobs)-int(limit)] {
+		if err := r.deleteJob(ctx, cronJob, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func finishedTime(job client.Object) time.Time {
+	if _, jobStatus := core.JobRunPolicyAndStatus(job); jobStatus.CompletionTime != nil {
+		return jobStatus.CompletionTime.Time
+	}
+	return job.GetCreationTimestamp().Time
+}
+
+func (r *TrainingCronJobReconciler) deleteJob(ctx context.Context, cronJob *kubeflowv1.TrainingCronJob, job client.Object) error {
+	kind := core.JobKind(job)
+	err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeWarning, FailedDeleteReason, "Error deleting %s %s: %v", kind, job.GetName(), err)
+		return err
+	}
+	r.Recorder.Eventf(cronJob, corev1.EventTypeNormal, SuccessfulDeleteReason, "Deleted %s %s", kind, job.GetName())
+	return nil
+}
+
+// syncSchedule creates the job of the most recent scheduled time which has not been run yet,
+// following the concurrency policy, and returns when to reconcile the TrainingCronJob again.
+func (r *TrainingCronJobReconciler) syncSchedule(ctx context.Context, logger logr.Logger,
+	cronJob *kubeflowv1.TrainingCronJob, status *kubeflowv1.TrainingCronJobStatus) (ctrl.Result, error) {
+	if cronJob.DeletionTimestamp != nil || ptr.Deref(cronJob.Spec.Suspend, false) {
+		return ctrl.Result{}, nil
+	}
+	schedule, loc, err := parseSchedule(cronJob)
+	if err != nil {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeWarning, InvalidScheduleReason, "Invalid schedule %q: %v", cronJob.Spec.Schedule, err)
+		return ctrl.Result{}, nil
+	}
+
+	now := r.Clock.Now().In(loc)
+	result := ctrl.Result{}
+	if next := schedule.Next(now); !next.IsZero() {
+		result.RequeueAfter = next.Sub(now)
+	}
+	scheduledTime, missed := mostRecentScheduleTime(cronJob, status, schedule, now)
+	if scheduledTime == nil {
+		return result, nil
+	}
+	if missed > tooManyMissedTimes {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeWarning, TooManyMissedTimesReason,
+			"Missed %d scheduled times, check the clock skew or the startingDeadlineSeconds", missed)
+	}
+	if deadline := cronJob.Spec.StartingDeadlineSeconds; deadline != nil &&
+		scheduledTime.Add(time.Duration(*deadline)*time.Second).Before(now) {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeWarning, MissScheduleReason,
+			"Missed the scheduled time to start a job: %s", scheduledTime.UTC().Format(time.RFC3339))
+		return result, nil
+	}
+
+	switch cronJob.Spec.ConcurrencyPolicy {
+	case kubeflowv1.ForbidConcurrent:
+		if len(status.Active) != 0 {
+			// The scheduled time is kept until the active jobs finish or the starting deadline passes.
+			logger.Info("Skipping the scheduled time, a job is still active", "scheduledTime", scheduledTime)
+			r.Recorder.Eventf(cronJob, corev1.EventTypeNormal, JobAlreadyActiveReason,
+				"Not starting a job since %s is still active", status.Active[0].Name)
+			return result, nil
+		}
+	case kubeflowv1.ReplaceConcurrent:
+		for _, ref := range status.Active {
+			job, err := core.NewJob(ref.Kind)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			job.SetNamespace(ref.Namespace)
+			job.SetName(ref.Name)
+			if err = r.deleteJob(ctx, cronJob, job); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		status.Active = nil
+	}
+
+	job, err := newJob(cronJob, *scheduledTime, r.Scheme)
+	if err != nil {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeWarning, InvalidJobTemplateReason, "Invalid job template: %v", err)
+		return result, nil
+	}
+	kind := core.JobKind(job)
+	if err = r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeWarning, FailedCreateReason, "Error creating %s %s: %v", kind, job.GetName(), err)
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		r.Recorder.Eventf(cronJob, corev1.EventTypeNormal, SuccessfulCreateReason, "Created %s %s", kind, job.GetName())
+	}
+	ref := jobReference(job)
+	found := false
+	for _, active := range status.Active {
+		found = found || active.Name == ref.Name && active.Kind == ref.Kind
+	}
+	if !found {
+		status.Active = append(status.Active, ref)
+	}
+	status.LastScheduleTime = &metav1.Time{Time: *scheduledTime}
+	return result, nil
+}
+
+// parseSchedule parses the schedule of the TrainingCronJob and loads its time zone.
+func parseSchedule(cronJob *kubeflowv1.TrainingCronJob) (*cron.Schedule, *time.Location, error) {
+	loc := time.Local
+	if cronJob.Spec.TimeZone != nil {
+		var err error
+		if loc, err = time.LoadLocation(*cronJob.Spec.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("unknown time zone %q: %w", *cronJob.Spec.TimeZone, err)
+		}
+	}
+	schedule, err := cron.Parse(cronJob.Spec.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, loc, nil
+}
+
+// mostRecentScheduleTime returns the most recent scheduled time which is not after now and has not been
+// run yet, and the number of such times. The times before the starting deadline are not counted.
+func mostRecentScheduleTime(cronJob *kubeflowv1.TrainingCronJob, status *kubeflowv1.TrainingCronJobStatus,
+	schedule *cron.Schedule, now time.Time) (*time.Time, int) {
+	earliest := cronJob.CreationTimestamp.Time
+	if status.LastScheduleTime != nil {
+		earliest = status.LastScheduleTime.Time
+	}
+	earliest = earliest.In(now.Location())
+
+	var mostRecent *time.Time
+	missed := 0
+	for t := schedule.Next(earliest); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		scheduled := t
+		mostRecent = &scheduled
+		if deadline := cronJob.Spec.StartingDeadlineSeconds; deadline == nil ||
+			!t.Add(time.Duration(*deadline)*time.Second).Before(now) {
+			missed++
+		}
+	}
+	return mostRecent, missed
+}
+
+// newJob returns the job of the template of the TrainingCronJob for the scheduled time. The jobs are
+// named after the TrainingCronJob and the scheduled time in minutes since the epoch, as the CronJobs.
+func newJob(cronJob *kubeflowv1.TrainingCronJob, scheduledTime time.Time, scheme *runtime.Scheme) (client.Object, error) {
+	raw := cronJob.Spec.JobTemplate.Raw
+	if raw == nil && cronJob.Spec.JobTemplate.Object != nil {
+		var err error
+		if raw, err = json.Marshal(cronJob.Spec.JobTemplate.Object); err != nil {
+			return nil, err
+		}
+	}
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+	if typeMeta.APIVersion != kubeflowv1.GroupVersion.String() {
+		return nil, fmt.Errorf("apiVersion %q is not supported, expected %q", typeMeta.APIVersion, kubeflowv1.GroupVersion.String())
+	}
+	job, err := core.NewJob(typeMeta.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(raw, job); err != nil {
+		return nil, err
+	}
+
+	job.SetName(fmt.Sprintf("%s-%d", cronJob.Name, scheduledTime.Unix()/60))
+	job.SetNamespace(cronJob.Namespace)
+	job.SetGenerateName("")
+	job.SetResourceVersion("")
+	job.SetUID("")
+	job.SetOwnerReferences(nil)
+	annotations := job.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[kubeflowv1.CronJobScheduledTimestampAnnotation] = scheduledTime.UTC().Format(time.RFC3339)
+	job.SetAnnotations(annotations)
+	if err = controllerutil.SetControllerReference(cronJob, job, scheme); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func jobReference(job client.Object) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: kubeflowv1.GroupVersion.String(),
+		Kind:       core.JobKind(job),
+		Namespace:  job.GetNamespace(),
+		Name:       job.GetName(),
+		UID:        job.GetUID(),
+	}
+}
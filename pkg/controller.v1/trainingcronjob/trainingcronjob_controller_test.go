@@ -0,0 +1,379 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trainingcronjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/util/cron"
+)
+
+var created = time.Date(2024, time.January, 1, 0, 30, 0, 0, time.UTC)
+
+func newCronJob(t *testing.T, suspendTemplate bool) *kubeflowv1.TrainingCronJob {
+	template := &kubeflowv1.PyTorchJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kubeflowv1.GroupVersion.String(), Kind: kubeflowv1.PyTorchJobKind},
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "nightly"}},
+		Spec: kubeflowv1.PyTorchJobSpec{
+			RunPolicy: kubeflowv1.RunPolicy{Suspend: ptr.To(suspendTemplate)},
+			PyTorchReplicaSpecs: map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{
+				kubeflowv1.PyTorchJobReplicaTypeMaster: {
+					Replicas: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "pytorch", Image: "test-image"}},
+					}},
+				},
+			},
+		},
+	}
+	raw, err := json.Marshal(template)
+	if err != nil {
+		t.Fatalf("Failed to marshal the template: %v", err)
+	}
+	return &kubeflowv1.TrainingCronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly",
+			Namespace:         "default",
+			UID:               "cron-uid",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: kubeflowv1.TrainingCronJobSpec{
+			Schedule:          "0 2 * * *",
+			TimeZone:          ptr.To("UTC"),
+			ConcurrencyPolicy: kubeflowv1.AllowConcurrent,
+			JobTemplate:       runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func newOwnedJob(cronJob *kubeflowv1.TrainingCronJob, scheduledTime time.Time, conditions ...kubeflowv1.JobConditionType) *kubeflowv1.PyTorchJob {
+	job := &kubeflowv1.PyTorchJob{ObjectMeta: metav1.ObjectMeta{
+		Name:            jobName(scheduledTime),
+		Namespace:       cronJob.Namespace,
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, kubeflowv1.GroupVersion.WithKind(kubeflowv1.TrainingCronJobKind))},
+	}}
+	for _, condType := range conditions {
+		job.Status.Conditions = append(job.Status.Conditions, kubeflowv1.JobCondition{Type: condType, Status: corev1.ConditionTrue})
+	}
+	if len(conditions) != 0 {
+		job.Status.CompletionTime = ptr.To(metav1.NewTime(scheduledTime.Add(time.Hour)))
+	}
+	return job
+}
+
+func newTestReconciler(t *testing.T, now time.Time, objs ...client.Object) *TrainingCronJobReconciler {
+	scheme := runtime.NewScheme()
+	if err := kubeflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add the kubeflow scheme: %v", err)
+	}
+	return &TrainingCronJobReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&kubeflowv1.TrainingCronJob{}).
+			WithObjects(objs...).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+		Log:      log.Log,
+		Clock:    clocktesting.NewFakePassiveClock(now),
+	}
+}
+
+// jobName returns the name of the job of the TrainingCronJob of newCronJob for the scheduled time.
+func jobName(scheduledTime time.Time) string {
+	return fmt.Sprintf("nightly-%d", scheduledTime.Unix()/60)
+}
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, time.January, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestReconcile(T *testing.T) {
+	cases := map[string]struct {
+		now              time.Time
+		updateCronJob    func(*kubeflowv1.TrainingCronJob)
+		jobs             func(*kubeflowv1.TrainingCronJob) []client.Object
+		suspendTemplate  bool
+		wantJobs         []string
+		wantRequeueAfter time.Duration
+		wantLastSchedule *time.Time
+	}{
+		"before the first scheduled time": {
+			now:              at(1, 1, 0),
+			wantRequeueAfter: time.Hour,
+		},
+		"scheduled time": {
+			now:              at(1, 2, 0),
+			wantJobs:         []string{jobName(at(1, 2, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(1, 2, 0)),
+		},
+		"missed scheduled times run the most recent one": {
+			now:              at(3, 12, 0),
+			wantJobs:         []string{jobName(at(3, 2, 0))},
+			wantRequeueAfter: 14 * time.Hour,
+			wantLastSchedule: ptr.To(at(3, 2, 0)),
+		},
+		"already scheduled": {
+			now: at(1, 2, 30),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(at(1, 2, 0)))
+			},
+			wantRequeueAfter: 23*time.Hour + 30*time.Minute,
+			wantLastSchedule: ptr.To(at(1, 2, 0)),
+		},
+		"starting deadline exceeded": {
+			now: at(1, 2, 10),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.StartingDeadlineSeconds = ptr.To[int64](300)
+			},
+			wantRequeueAfter: 23*time.Hour + 50*time.Minute,
+		},
+		"within the starting deadline": {
+			now: at(1, 2, 4),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.StartingDeadlineSeconds = ptr.To[int64](300)
+			},
+			wantJobs:         []string{jobName(at(1, 2, 0))},
+			wantRequeueAfter: 23*time.Hour + 56*time.Minute,
+			wantLastSchedule: ptr.To(at(1, 2, 0)),
+		},
+		"suspended": {
+			now: at(1, 2, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.Suspend = ptr.To(true)
+			},
+		},
+		"invalid schedule": {
+			now: at(1, 2, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.Schedule = "0 25 * * *"
+			},
+		},
+		"time zone": {
+			now: at(1, 1, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.TimeZone = ptr.To("Europe/Paris")
+			},
+			wantJobs:         []string{jobName(at(1, 1, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(1, 1, 0)),
+		},
+		"concurrent jobs are allowed": {
+			now: at(2, 2, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(at(1, 2, 0)))
+			},
+			jobs: func(cronJob *kubeflowv1.TrainingCronJob) []client.Object {
+				return []client.Object{newOwnedJob(cronJob, at(1, 2, 0), kubeflowv1.JobRunning)}
+			},
+			wantJobs:         []string{jobName(at(1, 2, 0)), jobName(at(2, 2, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(2, 2, 0)),
+		},
+		"concurrent jobs are forbidden": {
+			now: at(2, 2, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.ConcurrencyPolicy = kubeflowv1.ForbidConcurrent
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(at(1, 2, 0)))
+			},
+			jobs: func(cronJob *kubeflowv1.TrainingCronJob) []client.Object {
+				return []client.Object{newOwnedJob(cronJob, at(1, 2, 0))}
+			},
+			wantJobs:         []string{jobName(at(1, 2, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(1, 2, 0)),
+		},
+		"concurrent jobs are replaced": {
+			now: at(2, 2, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.ConcurrencyPolicy = kubeflowv1.ReplaceConcurrent
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(at(1, 2, 0)))
+			},
+			jobs: func(cronJob *kubeflowv1.TrainingCronJob) []client.Object {
+				return []client.Object{newOwnedJob(cronJob, at(1, 2, 0))}
+			},
+			wantJobs:         []string{jobName(at(2, 2, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(2, 2, 0)),
+		},
+		"jobs are created suspended": {
+			now:              at(1, 2, 0),
+			suspendTemplate:  true,
+			wantJobs:         []string{jobName(at(1, 2, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(1, 2, 0)),
+		},
+		"suspended jobs are active": {
+			now: at(2, 2, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.ConcurrencyPolicy = kubeflowv1.ForbidConcurrent
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(at(1, 2, 0)))
+			},
+			jobs: func(cronJob *kubeflowv1.TrainingCronJob) []client.Object {
+				return []client.Object{newOwnedJob(cronJob, at(1, 2, 0), kubeflowv1.JobSuspended)}
+			},
+			wantJobs:         []string{jobName(at(1, 2, 0))},
+			wantRequeueAfter: 24 * time.Hour,
+			wantLastSchedule: ptr.To(at(1, 2, 0)),
+		},
+		"history limits": {
+			now: at(5, 1, 0),
+			updateCronJob: func(cronJob *kubeflowv1.TrainingCronJob) {
+				cronJob.Spec.SuccessfulJobsHistoryLimit = ptr.To[int32](1)
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(at(4, 2, 0)))
+			},
+			jobs: func(cronJob *kubeflowv1.TrainingCronJob) []client.Object {
+				return []client.Object{
+					newOwnedJob(cronJob, at(1, 2, 0), kubeflowv1.JobSucceeded),
+					newOwnedJob(cronJob, at(2, 2, 0), kubeflowv1.JobFailed),
+					newOwnedJob(cronJob, at(3, 2, 0), kubeflowv1.JobSucceeded),
+					newOwnedJob(cronJob, at(4, 2, 0), kubeflowv1.JobFailed),
+				}
+			},
+			wantJobs:         []string{jobName(at(3, 2, 0)), jobName(at(4, 2, 0))},
+			wantRequeueAfter: time.Hour,
+			wantLastSchedule: ptr.To(at(4, 2, 0)),
+		},
+	}
+	for name, tc := range cases {
+		T.Run(name, func(t *testing.T) {
+			cronJob := newCronJob(t, tc.suspendTemplate)
+			if tc.updateCronJob != nil {
+				tc.updateCronJob(cronJob)
+			}
+			objs := []client.Object{cronJob}
+			if tc.jobs != nil {
+				objs = append(objs, tc.jobs(cronJob)...)
+			}
+			r := newTestReconciler(t, tc.now, objs...)
+			ctx := context.Background()
+			key := types.NamespacedName{Namespace: cronJob.Namespace, Name: cronJob.Name}
+
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if result.RequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeue: \nwant: %v\ngot: %v\n", tc.wantRequeueAfter, result.RequeueAfter)
+			}
+
+			jobs := &kubeflowv1.PyTorchJobList{}
+			if err = r.List(ctx, jobs); err != nil {
+				t.Fatalf("Failed to list the jobs: %v", err)
+			}
+			var gotJobs []string
+			for _, job := range jobs.Items {
+				gotJobs = append(gotJobs, job.Name)
+				if len(job.Annotations[kubeflowv1.CronJobScheduledTimestampAnnotation]) == 0 {
+					continue
+				}
+				if job.Labels["app"] != "nightly" || ptr.Deref(job.Spec.RunPolicy.Suspend, false) != tc.suspendTemplate {
+					t.Errorf("Job %s doesn't match the template: %v", job.Name, job.ObjectMeta)
+				}
+			}
+			sort.Strings(gotJobs)
+			if diff := cmp.Diff(tc.wantJobs, gotJobs); len(diff) != 0 {
+				t.Errorf("Unexpected jobs (-want,+got):\n%s", diff)
+			}
+
+			if err = r.Get(ctx, key, cronJob); err != nil {
+				t.Fatalf("Failed to get the TrainingCronJob: %v", err)
+			}
+			var gotLastSchedule *time.Time
+			if cronJob.Status.LastScheduleTime != nil {
+				gotLastSchedule = ptr.To(cronJob.Status.LastScheduleTime.UTC())
+			}
+			if diff := cmp.Diff(tc.wantLastSchedule, gotLastSchedule); len(diff) != 0 {
+				t.Errorf("Unexpected last schedule time (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMostRecentScheduleTime(t *testing.T) {
+	schedule, err := cron.Parse("0 * * * *")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	cases := map[string]struct {
+		lastSchedule *time.Time
+		deadline     *int64
+		now          time.Time
+		wantTime     *time.Time
+		wantMissed   int
+	}{
+		"not scheduled yet": {
+			now: at(1, 0, 59),
+		},
+		"first scheduled time": {
+			now:        at(1, 1, 0),
+			wantTime:   ptr.To(at(1, 1, 0)),
+			wantMissed: 1,
+		},
+		"missed scheduled times": {
+			lastSchedule: ptr.To(at(1, 1, 0)),
+			now:          at(1, 4, 30),
+			wantTime:     ptr.To(at(1, 4, 0)),
+			wantMissed:   3,
+		},
+		"missed scheduled times before the deadline are not counted": {
+			lastSchedule: ptr.To(at(1, 1, 0)),
+			deadline:     ptr.To[int64](3600),
+			now:          at(1, 4, 30),
+			wantTime:     ptr.To(at(1, 4, 0)),
+			wantMissed:   1,
+		},
+		"all scheduled times are before the deadline": {
+			lastSchedule: ptr.To(at(1, 1, 0)),
+			deadline:     ptr.To[int64](60),
+			now:          at(1, 4, 30),
+			wantTime:     ptr.To(at(1, 4, 0)),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cronJob := newCronJob(t, false)
+			cronJob.Spec.StartingDeadlineSeconds = tc.deadline
+			if tc.lastSchedule != nil {
+				cronJob.Status.LastScheduleTime = ptr.To(metav1.NewTime(*tc.lastSchedule))
+			}
+			gotTime, gotMissed := mostRecentScheduleTime(cronJob, &cronJob.Status, schedule, tc.now)
+			if diff := cmp.Diff(tc.wantTime, gotTime); len(diff) != 0 {
+				t.Errorf("Unexpected scheduled time (-want,+got):\n%s", diff)
+			}
+			if gotMissed != tc.wantMissed {
+				t.Errorf("Unexpected missed times: \nwant: %v\ngot: %v\n", tc.wantMissed, gotMissed)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	commonutil "github.com/kubeflow/training-operator/pkg/util"
+)
+
+// DependsOnIndexKey is the field index of the jobs by the jobs of their RunPolicy.DependsOn,
+// the values are returned by DependencyIndexValue.
+const DependsOnIndexKey = "spec.runPolicy.dependsOn"
+
+// JobKinds are the kinds of the jobs a job can depend on.
+var JobKinds = []string{
+	apiv1.TFJobKind,
+	apiv1.PyTorchJobKind,
+	apiv1.MXJobKind,
+	apiv1.XGBoostJobKind,
+	apiv1.MPIJobKind,
+	apiv1.PaddleJobKind,
+	apiv1.JAXJobKind,
+}
+
+// NewJob returns an empty job of the kind.
+func NewJob(kind string) (client.Object, error) {
+	switch kind {
+	case apiv1.TFJobKind:
+		return &apiv1.TFJob{}, nil
+	case apiv1.PyTorchJobKind:
+		return &apiv1.PyTorchJob{}, nil
+	case apiv1.MXJobKind:
+		return &apiv1.MXJob{}, nil
+	case apiv1.XGBoostJobKind:
+		return &apiv1.XGBoostJob{}, nil
+	case apiv1.MPIJobKind:
+		return &apiv1.MPIJob{}, nil
+	case apiv1.PaddleJobKind:
+		return &apiv1.PaddleJob{}, nil
+	case apiv1.JAXJobKind:
+		return &apiv1.JAXJob{}, nil
+	}
+	return nil, fmt.Errorf("unknown job kind %q", kind)
+}
+
+// NewJobList returns an empty list of the jobs of the kind.
+func NewJobList(kind string) (client.ObjectList, error) {
+	switch kind {
+	case apiv1.TFJobKind:
+		return &apiv1.TFJobList{}, nil
+	case apiv1.PyTorchJobKind:
+		return &apiv1.PyTorchJobList{}, nil
+	case apiv1.MXJobKind:
+		return &apiv1.MXJobList{}, nil
+	case apiv1.XGBoostJobKind:
+		return &apiv1.XGBoostJobList{}, nil
+	case apiv1.MPIJobKind:
+		return &apiv1.MPIJobList{}, nil
+	case apiv1.PaddleJobKind:
+		return &apiv1.PaddleJobList{}, nil
+	case apiv1.JAXJobKind:
+		return &apiv1.JAXJobList{}, nil
+	}
+	return nil, fmt.Errorf("unknown job kind %q", kind)
+}
+
+// JobKind returns the kind of the job, the cached objects don't have their TypeMeta set.
+func JobKind(obj client.Object) string {
+	switch obj.(type) {
+	case *apiv1.TFJob:
+		return apiv1.TFJobKind
+	case *apiv1.PyTorchJob:
+		return apiv1.PyTorchJobKind
+	case *apiv1.MXJob:
+		return apiv1.MXJobKind
+	case *apiv1.XGBoostJob:
+		return apiv1.XGBoostJobKind
+	case *apiv1.MPIJob:
+		return apiv1.MPIJobKind
+	case *apiv1.PaddleJob:
+		return apiv1.PaddleJobKind
+	case *apiv1.JAXJob:
+		return apiv1.JAXJobKind
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
+// JobRunPolicyAndStatus returns the run policy and the status of the job, nil for the objects which are not jobs.
+func JobRunPolicyAndStatus(obj client.Object) (*apiv1.RunPolicy, *apiv1.JobStatus) {
+	switch job := obj.(type) {
+	case *apiv1.TFJob:
+		return &job.Spec.RunPolicy, &job.Status
+	case *apiv1.PyTorchJob:
+		return &job.Spec.RunPolicy, &job.Status
+	case *apiv1.MXJob:
+		return &job.Spec.RunPolicy, &job.Status
+	case *apiv1.XGBoostJob:
+		return &job.Spec.RunPolicy, &job.Status
+	case *apiv1.MPIJob:
+		return &job.Spec.RunPolicy, &job.Status
+	case *apiv1.PaddleJob:
+		return &job.Spec.RunPolicy, &job.Status
+	case *apiv1.JAXJob:
+		return &job.Spec.RunPolicy, &job.Status
+	}
+	return nil, nil
+}
+
+// DependencyIndexValue returns the value of DependsOnIndexKey of the jobs depending on the job.
+func DependencyIndexValue(kind, name string) string {
+	return kind + "/" + name
+}
+
+// DependsOnIndexFunc indexes the jobs by the jobs they depend on.
+func DependsOnIndexFunc(obj client.Object) []string {
+	runPolicy, _ := JobRunPolicyAndStatus(obj)
+	if runPolicy == nil {
+		return nil
+	}
+	values := make([]string, 0, len(runPolicy.DependsOn))
+	for _, dependency := range runPolicy.DependsOn {
+		values = append(values, DependencyIndexValue(dependency.Kind, dependency.Name))
+	}
+	return values
+}
+
+// GetDependency returns the job a job of the namespace depends on.
+func GetDependency(ctx context.Context, reader client.Reader, namespace string, dependency apiv1.JobDependency) (client.Object, error) {
+	job, err := NewJob(dependency.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err = reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: dependency.Name}, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// DependencyState returns whether the job reached the condition of the dependency, or can't reach it anymore
+// since the job is finished. A job which succeeded reached the Running condition.
+func DependencyState(status *apiv1.JobStatus, condition apiv1.JobConditionType) (met, unsatisfiable bool) {
+	switch condition {
+	case apiv1.JobRunning:
+		met = commonutil.IsRunning(*status) || commonutil.IsSucceeded(*status)
+		unsatisfiable = !met && commonutil.IsFailed(*status)
+	case apiv1.JobFailed:
+		met = commonutil.IsFailed(*status)
+		unsatisfiable = commonutil.IsSucceeded(*status)
+	default:
+		met = commonutil.IsSucceeded(*status)
+		unsatisfiable = commonutil.IsFailed(*status)
+	}
+	return met, unsatisfiable
+}
+
+// FindDependencyCycle returns the cycle of the dependencies leading back to the job, e.g.
+// [PyTorchJob/a TFJob/b PyTorchJob/a], and nil when the dependencies have no cycle through the job.
+// The dependencies which don't exist yet are ignored.
+func FindDependencyCycle(ctx context.Context, reader client.Reader, namespace, kind, name string,
+	dependsOn []apiv1.JobDependency) ([]string, error) {
+	root := DependencyIndexValue(kind, name)
+	visited := map[string]bool{root: true}
+	var visit func(path []string, dependsOn []apiv1.JobDependency) ([]string, error)
+	visit = func(path []string, dependsOn []apiv1.JobDependency) ([]string, error) {
+		for _, dependency := range dependsOn {
+			key := DependencyIndexValue(dependency.Kind, dependency.Name)
+			if key == root {
+				return append(path, key), nil
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			job, err := GetDependency(ctx, reader, namespace, dependency)
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			runPolicy, _ := JobRunPolicyAndStatus(job)
+			cycle, err := visit(append(path, key), runPolicy.DependsOn)
+			if cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return visit([]string{root}, dependsOn)
+}
+
+// ValidateDependencies rejects the jobs whose dependencies lead back to the job.
+func ValidateDependencies(ctx context.Context, reader client.Reader, namespace, kind, name string,
+	dependsOn []apiv1.JobDependency) error {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+	cycle, err := FindDependencyCycle(ctx, reader, namespace, kind, name, dependsOn)
+	if err != nil {
+		return err
+	}
+	if cycle != nil {
+		return fmt.Errorf("dependsOn is not valid: dependency cycle %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
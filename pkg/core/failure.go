@@ -0,0 +1,132 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+const (
+	// maxFailureMessageLength is the length of the tail of the termination message kept in the FailureDetails.
+	maxFailureMessageLength = 1024
+
+	// defaultFailureReason is the reason of the failures the kubelet doesn't give a reason for.
+	defaultFailureReason = "Error"
+)
+
+// GetFailureDetails returns the details of the first failure of the pods: the earliest container which
+// terminated with a non-zero exit code, or the failed pod. It returns nil if no pod failed.
+func GetFailureDetails(pods []*v1.Pod, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) *apiv1.FailureDetails {
+	var first *apiv1.FailureDetails
+	for _, pod := range pods {
+		details := podFailureDetails(pod)
+		if details == nil {
+			continue
+		}
+		details.ReplicaType = replicaTypeOfPod(pod, replicas)
+		if first == nil || failedBefore(details, first) {
+			first = details
+		}
+	}
+	return first
+}
+
+// FailureReason returns the reason of the Failed condition of a job failed by the failure,
+// such as WorkerOOMKilled.
+func FailureReason(details *apiv1.FailureDetails) string {
+	reason := details.Reason
+	if len(reason) == 0 {
+		reason = defaultFailureReason
+	}
+	return string(details.ReplicaType) + reason
+}
+
+// podFailureDetails returns the details of the failure of the pod, or nil if none of its
+// containers terminated with an error and the pod didn't fail.
+func podFailureDetails(pod *v1.Pod) *apiv1.FailureDetails {
+	var details *apiv1.FailureDetails
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		terminated := status.State.Terminated
+		if terminated == nil || terminated.ExitCode == 0 {
+			terminated = status.LastTerminationState.Terminated
+		}
+		if terminated == nil || terminated.ExitCode == 0 {
+			continue
+		}
+		exitCode := terminated.ExitCode
+		containerDetails := &apiv1.FailureDetails{
+			PodName:       pod.Name,
+			ContainerName: status.Name,
+			ExitCode:      &exitCode,
+			Reason:        terminated.Reason,
+			Message:       messageTail(terminated.Message),
+		}
+		if !terminated.FinishedAt.IsZero() {
+			finishedAt := terminated.FinishedAt
+			containerDetails.FailureTime = &finishedAt
+		}
+		if len(containerDetails.Reason) == 0 {
+			containerDetails.Reason = defaultFailureReason
+		}
+		if details == nil || failedBefore(containerDetails, details) {
+			details = containerDetails
+		}
+	}
+	if pod.Status.Phase != v1.PodFailed {
+		return details
+	}
+	if details == nil {
+		details = &apiv1.FailureDetails{PodName: pod.Name}
+	}
+	// The reasons of the pod, e.g. Evicted or DeadlineExceeded, explain why its containers were killed.
+	if len(pod.Status.Reason) != 0 {
+		details.Reason = pod.Status.Reason
+		details.Message = messageTail(pod.Status.Message)
+	}
+	return details
+}
+
+// failedBefore checks whether the failure a happened before the failure b.
+// The failures without a time are the last ones.
+func failedBefore(a, b *apiv1.FailureDetails) bool {
+	if a.FailureTime == nil {
+		return false
+	}
+	return b.FailureTime == nil || a.FailureTime.Before(b.FailureTime)
+}
+
+// replicaTypeOfPod returns the replica type of the pod as it is named in the replica specs.
+func replicaTypeOfPod(pod *v1.Pod, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec) apiv1.ReplicaType {
+	rt := pod.Labels[apiv1.ReplicaTypeLabel]
+	for rType := range replicas {
+		if strings.EqualFold(string(rType), rt) {
+			return rType
+		}
+	}
+	return apiv1.ReplicaType(rt)
+}
+
+// messageTail returns the end of the message, which is the most relevant part of a termination message.
+func messageTail(message string) string {
+	if len(message) <= maxFailureMessageLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[len(message)-maxFailureMessageLength:], "")
+}
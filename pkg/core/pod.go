@@ -79,8 +79,10 @@ func CalculatePodSliceSize(pods []*v1.Pod, replicas int) int {
 
 // SetRestartPolicy check the RestartPolicy defined in job spec and overwrite RestartPolicy in podTemplate if necessary
 func SetRestartPolicy(podTemplateSpec *v1.PodTemplateSpec, spec *apiv1.ReplicaSpec) {
-	// This is necessary since restartPolicyExitCode is not supported in v1.PodTemplateSpec
-	if spec.RestartPolicy == apiv1.RestartPolicyExitCode {
+	// This is necessary since restartPolicyExitCode is not supported in v1.PodTemplateSpec.
+	// Pods with a PodFailurePolicy are never restarted by the kubelet, so that every failure
+	// can be matched against the rules.
+	if spec.RestartPolicy == apiv1.RestartPolicyExitCode || spec.PodFailurePolicy != nil {
 		podTemplateSpec.Spec.RestartPolicy = v1.RestartPolicyNever
 	} else {
 		podTemplateSpec.Spec.RestartPolicy = v1.RestartPolicy(spec.RestartPolicy)
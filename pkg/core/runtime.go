@@ -0,0 +1,249 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+const (
+	// trainingRuntimeRevisionSuffix is the suffix of the ControllerRevision holding the runtime of a job.
+	trainingRuntimeRevisionSuffix = "-runtime"
+
+	// TrainingRuntimeKindAnnotation and TrainingRuntimeNameAnnotation record the runtime a revision was copied from.
+	TrainingRuntimeKindAnnotation = "kubeflow.org/runtime-kind"
+	TrainingRuntimeNameAnnotation = "kubeflow.org/runtime-name"
+)
+
+// TrainingRuntimeRevisionName returns the name of the ControllerRevision holding the runtime of the job.
+func TrainingRuntimeRevisionName(jobName string) string {
+	return jobName + trainingRuntimeRevisionSuffix
+}
+
+// ResolveTrainingRuntime returns the spec of the runtime referenced by the job. The spec is read from the
+// ControllerRevision of the job once the runtime was resolved, the revision is nil until then and the
+// spec is the one of the current runtime.
+func ResolveTrainingRuntime(ctx context.Context, reader client.Reader, job metav1.Object,
+	ref *apiv1.RuntimeRef) (*apiv1.TrainingRuntimeSpec, *appsv1.ControllerRevision, error) {
+	revision := &appsv1.ControllerRevision{}
+	key := types.NamespacedName{Namespace: job.GetNamespace(), Name: TrainingRuntimeRevisionName(job.GetName())}
+	err := reader.Get(ctx, key, revision)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if err == nil && metav1.IsControlledBy(revision, job) {
+		spec := &apiv1.TrainingRuntimeSpec{}
+		if err = json.Unmarshal(revision.Data.Raw, spec); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode the runtime of revision %s: %w", revision.Name, err)
+		}
+		return spec, revision, nil
+	}
+
+	spec, err := GetTrainingRuntimeSpec(ctx, reader, job.GetNamespace(), ref)
+	return spec, nil, err
+}
+
+// GetTrainingRuntimeSpec returns the spec of the current TrainingRuntime or ClusterTrainingRuntime referenced
+// from a job of the namespace.
+func GetTrainingRuntimeSpec(ctx context.Context, reader client.Reader, namespace string,
+	ref *apiv1.RuntimeRef) (*apiv1.TrainingRuntimeSpec, error) {
+	if ref.Kind == apiv1.TrainingRuntimeKind {
+		trainingRuntime := &apiv1.TrainingRuntime{}
+		if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, trainingRuntime); err != nil {
+			return nil, err
+		}
+		return &trainingRuntime.Spec, nil
+	}
+	clusterTrainingRuntime := &apiv1.ClusterTrainingRuntime{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: ref.Name}, clusterTrainingRuntime); err != nil {
+		return nil, err
+	}
+	return &clusterTrainingRuntime.Spec, nil
+}
+
+// NewTrainingRuntimeRevision returns the ControllerRevision saving the spec of the runtime of the job.
+func NewTrainingRuntimeRevision(job metav1.Object, gvk schema.GroupVersionKind, ref *apiv1.RuntimeRef,
+	spec *apiv1.TrainingRuntimeSpec) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	kind := ref.Kind
+	if len(kind) == 0 {
+		kind = apiv1.ClusterTrainingRuntimeKind
+	}
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TrainingRuntimeRevisionName(job.GetName()),
+			Namespace: job.GetNamespace(),
+			Labels: map[string]string{
+				apiv1.JobNameLabel: job.GetName(),
+			},
+			Annotations: map[string]string{
+				TrainingRuntimeKindAnnotation: kind,
+				TrainingRuntimeNameAnnotation: ref.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, gvk)},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: 1,
+	}, nil
+}
+
+// ApplyTrainingRuntime merges the runtime referenced by the RunPolicy into the replica specs and the run policy,
+// without saving the runtime of the job. It is a no-op for the jobs without a runtime.
+func ApplyTrainingRuntime(ctx context.Context, reader client.Reader, job metav1.Object,
+	replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec, runPolicy *apiv1.RunPolicy) error {
+	if runPolicy.RuntimeRef == nil {
+		return nil
+	}
+	spec, _, err := ResolveTrainingRuntime(ctx, reader, job, runPolicy.RuntimeRef)
+	if err != nil {
+		return err
+	}
+	return MergeTrainingRuntime(spec, replicas, runPolicy)
+}
+
+// MergeTrainingRuntime merges the replica templates and the run policy of the runtime into the ones of the job,
+// the values of the job take precedence. The pod templates are merged like a strategic merge patch of the
+// template of the job on the template of the runtime, e.g. containers, volumes and env variables by name.
+func MergeTrainingRuntime(spec *apiv1.TrainingRuntimeSpec, replicas map[apiv1.ReplicaType]*apiv1.ReplicaSpec,
+	runPolicy *apiv1.RunPolicy) error {
+	// The jobs depending on other jobs are looked up by the dependsOn of their own spec.
+	if spec.RunPolicy != nil && len(spec.RunPolicy.DependsOn) != 0 {
+		return fmt.Errorf("the dependsOn of the run policy of the runtime is not supported, it must be set in the job")
+	}
+	for rType, replicaSpec := range replicas {
+		runtimeSpec := runtimeReplicaSpec(spec, rType)
+		if replicaSpec == nil || runtimeSpec == nil {
+			continue
+		}
+		template, err := mergePodTemplate(&runtimeSpec.Template, &replicaSpec.Template)
+		if err != nil {
+			return fmt.Errorf("failed to merge the template of %v with the runtime: %w", rType, err)
+		}
+		replicaSpec.Template = *template
+		if replicaSpec.Replicas == nil && runtimeSpec.Replicas != nil {
+			replicas := *runtimeSpec.Replicas
+			replicaSpec.Replicas = &replicas
+		}
+		if len(replicaSpec.RestartPolicy) == 0 {
+			replicaSpec.RestartPolicy = runtimeSpec.RestartPolicy
+		}
+		if replicaSpec.PodFailurePolicy == nil && runtimeSpec.PodFailurePolicy != nil {
+			replicaSpec.PodFailurePolicy = runtimeSpec.PodFailurePolicy.DeepCopy()
+		}
+		if replicaSpec.RestartBackoff == nil && runtimeSpec.RestartBackoff != nil {
+			replicaSpec.RestartBackoff = runtimeSpec.RestartBackoff.DeepCopy()
+		}
+	}
+	if spec.RunPolicy != nil {
+		mergeRunPolicy(spec.RunPolicy.DeepCopy(), runPolicy)
+	}
+	return nil
+}
+
+// runtimeReplicaSpec returns the replica spec of the runtime for the replica type, the replica types are
+// compared case-insensitively since the job may not be defaulted yet.
+func runtimeReplicaSpec(spec *apiv1.TrainingRuntimeSpec, rType apiv1.ReplicaType) *apiv1.ReplicaSpec {
+	if runtimeSpec, ok := spec.ReplicaSpecs[rType]; ok {
+		return runtimeSpec
+	}
+	for runtimeType, runtimeSpec := range spec.ReplicaSpecs {
+		if strings.EqualFold(string(runtimeType), string(rType)) {
+			return runtimeSpec
+		}
+	}
+	return nil
+}
+
+// mergePodTemplate applies the fields set in the template of the job on the template of the runtime.
+func mergePodTemplate(runtimeTemplate, jobTemplate *v1.PodTemplateSpec) (*v1.PodTemplateSpec, error) {
+	original, err := json.Marshal(runtimeTemplate)
+	if err != nil {
+		return nil, err
+	}
+	empty, err := json.Marshal(&v1.PodTemplateSpec{})
+	if err != nil {
+		return nil, err
+	}
+	modified, err := json.Marshal(jobTemplate)
+	if err != nil {
+		return nil, err
+	}
+	// The patch holds the fields set in the template of the job only, e.g. not its empty list of containers.
+	patch, err := strategicpatch.CreateTwoWayMergePatch(empty, modified, v1.PodTemplateSpec{})
+	if err != nil {
+		return nil, err
+	}
+	merged, err := strategicpatch.StrategicMergePatch(original, patch, v1.PodTemplateSpec{})
+	if err != nil {
+		return nil, err
+	}
+	template := &v1.PodTemplateSpec{}
+	if err = json.Unmarshal(merged, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// mergeRunPolicy sets the fields of the run policy of the job which are unset to the ones of the runtime.
+func mergeRunPolicy(runtimePolicy, runPolicy *apiv1.RunPolicy) {
+	if runPolicy.CleanPodPolicy == nil {
+		runPolicy.CleanPodPolicy = runtimePolicy.CleanPodPolicy
+	}
+	if runPolicy.TTLSecondsAfterFinished == nil {
+		runPolicy.TTLSecondsAfterFinished = runtimePolicy.TTLSecondsAfterFinished
+	}
+	if runPolicy.ActiveDeadlineSeconds == nil {
+		runPolicy.ActiveDeadlineSeconds = runtimePolicy.ActiveDeadlineSeconds
+	}
+	if runPolicy.BackoffLimit == nil {
+		runPolicy.BackoffLimit = runtimePolicy.BackoffLimit
+	}
+	if runPolicy.SchedulingPolicy == nil {
+		runPolicy.SchedulingPolicy = runtimePolicy.SchedulingPolicy
+	}
+	if runPolicy.GracefulTermination == nil {
+		runPolicy.GracefulTermination = runtimePolicy.GracefulTermination
+	}
+	if runPolicy.SuccessPolicy == nil {
+		runPolicy.SuccessPolicy = runtimePolicy.SuccessPolicy
+	}
+	if runPolicy.StartupPolicy == nil {
+		runPolicy.StartupPolicy = runtimePolicy.StartupPolicy
+	}
+	if runPolicy.TopologyPolicy == nil {
+		runPolicy.TopologyPolicy = runtimePolicy.TopologyPolicy
+	}
+	if runPolicy.RestartScope == nil {
+		runPolicy.RestartScope = runtimePolicy.RestartScope
+	}
+}
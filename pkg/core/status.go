@@ -17,17 +17,36 @@ limitations under the License.
 package core
 
 import (
+	"sort"
+	"strconv"
+
 	apiv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
 // InitializeReplicaStatuses initializes the ReplicaStatuses for replica.
+// The restarts are cumulative, so they are carried over from the previous status.
 func InitializeReplicaStatuses(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaType) {
 	if jobStatus.ReplicaStatuses == nil {
 		jobStatus.ReplicaStatuses = make(map[apiv1.ReplicaType]*apiv1.ReplicaStatus)
 	}
 
-	jobStatus.ReplicaStatuses[rtype] = &apiv1.ReplicaStatus{}
+	status := &apiv1.ReplicaStatus{}
+	if previous := jobStatus.ReplicaStatuses[rtype]; previous != nil {
+		status.Restarts = previous.Restarts
+		status.CountedRestarts = previous.CountedRestarts
+		status.BackoffRestarts = previous.BackoffRestarts
+		status.NextRestartTime = previous.NextRestartTime
+		for _, index := range previous.Indexes {
+			if index.Restarts > 0 {
+				status.Indexes = append(status.Indexes, apiv1.ReplicaIndexStatus{
+					Index:    index.Index,
+					Restarts: index.Restarts,
+				})
+			}
+		}
+	}
+	jobStatus.ReplicaStatuses[rtype] = status
 }
 
 // UpdateJobReplicaStatuses updates the JobReplicaStatuses according to the pod.
@@ -47,4 +66,75 @@ func UpdateJobReplicaStatuses(jobStatus *apiv1.JobStatus, rtype apiv1.ReplicaTyp
 	case corev1.PodFailed:
 		jobStatus.ReplicaStatuses[rtype].Failed++
 	}
+	UpdateReplicaPodStatus(jobStatus.ReplicaStatuses[rtype], pod)
+}
+
+// UpdateReplicaPodStatus updates the ready and terminating counts and the index status of the
+// ReplicaStatus according to the pod. The phase counts are left to the caller.
+func UpdateReplicaPodStatus(status *apiv1.ReplicaStatus, pod *corev1.Pod) {
+	if pod.DeletionTimestamp != nil {
+		status.Terminating++
+	} else if pod.Status.Phase == corev1.PodRunning && isPodReady(pod) {
+		status.Ready++
+	}
+
+	indexStatus := replicaIndexStatus(status, pod)
+	if indexStatus == nil {
+		return
+	}
+	indexStatus.PodName = pod.Name
+	indexStatus.Phase = pod.Status.Phase
+	indexStatus.NodeName = pod.Spec.NodeName
+	indexStatus.ContainerRestarts = 0
+	var lastTerminated *corev1.ContainerStateTerminated
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		indexStatus.ContainerRestarts += containerStatus.RestartCount
+		terminated := containerStatus.State.Terminated
+		if terminated == nil {
+			terminated = containerStatus.LastTerminationState.Terminated
+		}
+		if terminated != nil && (lastTerminated == nil || lastTerminated.FinishedAt.Before(&terminated.FinishedAt)) {
+			lastTerminated = terminated
+		}
+	}
+	if lastTerminated != nil {
+		exitCode := lastTerminated.ExitCode
+		indexStatus.LastExitCode = &exitCode
+	}
+}
+
+// RecordReplicaRestart counts a restart of the pod by the operator in the ReplicaStatus.
+func RecordReplicaRestart(status *apiv1.ReplicaStatus, pod *corev1.Pod) {
+	status.Restarts++
+	if indexStatus := replicaIndexStatus(status, pod); indexStatus != nil {
+		indexStatus.Restarts++
+	}
+}
+
+// replicaIndexStatus returns the index status of the pod, adding it to the ReplicaStatus if it is missing.
+// It returns nil if the pod has no index, or if its index is not reported.
+func replicaIndexStatus(status *apiv1.ReplicaStatus, pod *corev1.Pod) *apiv1.ReplicaIndexStatus {
+	index, err := strconv.Atoi(pod.Labels[apiv1.ReplicaIndexLabel])
+	if err != nil || index < 0 || index >= apiv1.MaxReplicaIndexStatuses {
+		return nil
+	}
+	i := sort.Search(len(status.Indexes), func(i int) bool {
+		return status.Indexes[i].Index >= int32(index)
+	})
+	if i == len(status.Indexes) || status.Indexes[i].Index != int32(index) {
+		status.Indexes = append(status.Indexes, apiv1.ReplicaIndexStatus{})
+		copy(status.Indexes[i+1:], status.Indexes[i:])
+		status.Indexes[i] = apiv1.ReplicaIndexStatus{Index: int32(index)}
+	}
+	return &status.Indexes[i]
+}
+
+// isPodReady checks whether the pod has a Ready condition.
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
 }
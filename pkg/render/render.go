@@ -0,0 +1,549 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render runs the job controllers offline against an in-memory cluster and returns the
+// objects they create for the jobs, e.g. the pods with the environment of their framework.
+package render
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/yaml"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	volcanofake "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/common"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/control"
+	"github.com/kubeflow/training-operator/pkg/controller.v1/expectation"
+	jaxcontroller "github.com/kubeflow/training-operator/pkg/controller.v1/jax"
+	mpicontroller "github.com/kubeflow/training-operator/pkg/controller.v1/mpi"
+	mxnetcontroller "github.com/kubeflow/training-operator/pkg/controller.v1/mxnet"
+	paddlecontroller "github.com/kubeflow/training-operator/pkg/controller.v1/paddlepaddle"
+	pytorchcontroller "github.com/kubeflow/training-operator/pkg/controller.v1/pytorch"
+	tensorflowcontroller "github.com/kubeflow/training-operator/pkg/controller.v1/tensorflow"
+	xgboostcontroller "github.com/kubeflow/training-operator/pkg/controller.v1/xgboost"
+	"github.com/kubeflow/training-operator/pkg/core"
+)
+
+// maxPasses bounds the reconciliations of the jobs, each pass starts the pods created by the previous one.
+const maxPasses = 5
+
+// Options configures the rendering of the jobs.
+type Options struct {
+	// Namespace is the namespace of the objects which don't set one.
+	Namespace string
+	// GangSchedulerName is the gang scheduler the PodGroups are created for, none when empty.
+	GangSchedulerName string
+}
+
+// newReconcilerFunc creates the reconciler of a job kind and returns the JobController it embeds.
+type newReconcilerFunc func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController)
+
+var reconcilers = map[string]newReconcilerFunc{
+	kubeflowv1.TFJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := tensorflowcontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+	kubeflowv1.PyTorchJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := pytorchcontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+	kubeflowv1.MXJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := mxnetcontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+	kubeflowv1.XGBoostJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := xgboostcontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+	kubeflowv1.MPIJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := mpicontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+	kubeflowv1.PaddleJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := paddlecontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+	kubeflowv1.JAXJobKind: func(mgr manager.Manager, gangSchedulingSetupFunc common.GangSchedulingSetupFunc) (reconcile.Reconciler, *common.JobController) {
+		r := jaxcontroller.NewReconciler(mgr, gangSchedulingSetupFunc)
+		return r, &r.JobController
+	},
+}
+
+// resource is a type of the objects created by the controllers.
+type resource struct {
+	gvr schema.GroupVersionResource
+	gvk schema.GroupVersionKind
+}
+
+func newResource(gv schema.GroupVersion, kind, plural string) resource {
+	return resource{gvr: gv.WithResource(plural), gvk: gv.WithKind(kind)}
+}
+
+var (
+	podGroupResource        = newResource(volcanov1beta1.SchemeGroupVersion, "PodGroup", "podgroups")
+	pluginsPodGroupResource = newResource(schedulerpluginsv1alpha1.SchemeGroupVersion, "PodGroup", "podgroups")
+	serviceAccountResource  = newResource(corev1.SchemeGroupVersion, "ServiceAccount", "serviceaccounts")
+	roleResource            = newResource(rbacv1.SchemeGroupVersion, "Role", "roles")
+	roleBindingResource     = newResource(rbacv1.SchemeGroupVersion, "RoleBinding", "rolebindings")
+	secretResource          = newResource(corev1.SchemeGroupVersion, "Secret", "secrets")
+	configMapResource       = newResource(corev1.SchemeGroupVersion, "ConfigMap", "configmaps")
+	serviceResource         = newResource(corev1.SchemeGroupVersion, "Service", "services")
+	podResource             = newResource(corev1.SchemeGroupVersion, "Pod", "pods")
+	jobResource             = newResource(batchv1.SchemeGroupVersion, "Job", "jobs")
+	hpaResource             = newResource(autoscalingv2.SchemeGroupVersion, "HorizontalPodAutoscaler", "horizontalpodautoscalers")
+	pdbResource             = newResource(policyv1beta1.SchemeGroupVersion, "PodDisruptionBudget", "poddisruptionbudgets")
+
+	// kubeResources are created through the Kubernetes clientset of the JobController.
+	kubeResources = []resource{serviceAccountResource, roleResource, roleBindingResource, secretResource,
+		configMapResource, serviceResource, podResource, jobResource, pdbResource}
+	// clientResources are created through the controller-runtime client of the reconcilers.
+	clientResources = []resource{pluginsPodGroupResource, configMapResource, serviceResource, podResource, hpaResource}
+	// order is the order the objects are printed in.
+	order = []resource{podGroupResource, pluginsPodGroupResource, serviceAccountResource, roleResource, roleBindingResource,
+		secretResource, configMapResource, serviceResource, podResource, jobResource, hpaResource, pdbResource}
+)
+
+// NewScheme returns the scheme of the objects read and rendered.
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kubeflowv1.AddToScheme(scheme))
+	utilruntime.Must(volcanov1beta1.AddToScheme(scheme))
+	utilruntime.Must(schedulerpluginsv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+// Decode reads the objects of a YAML or JSON stream, the documents are separated by "---".
+func Decode(r io.Reader, scheme *runtime.Scheme) ([]client.Object, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 4096)
+	deserializer := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	var objs []client.Object
+	for {
+		raw := runtime.RawExtension{}
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				return objs, nil
+			}
+			return nil, err
+		}
+		if doc := bytes.TrimSpace(raw.Raw); len(doc) == 0 || bytes.Equal(doc, []byte("null")) {
+			continue
+		}
+		obj, _, err := deserializer.Decode(raw.Raw, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unsupported object %T", obj)
+		}
+		objs = append(objs, clientObj)
+	}
+}
+
+// Render reconciles the jobs of objs in an in-memory cluster holding all the objs, e.g. the
+// training runtimes the jobs reference, and returns the objects the controllers create for the jobs.
+// The pods are marked running and ready between the reconciliations, so the replicas waiting for
+// other replicas, e.g. the MPI launcher, are created too.
+func Render(objs []client.Object, opts Options) ([]client.Object, error) {
+	ctx := context.Background()
+	scheme := NewScheme()
+	if len(opts.Namespace) == 0 {
+		opts.Namespace = metav1.NamespaceDefault
+	}
+
+	var jobs []client.Object
+	inputs := map[string]bool{}
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, obj := range objs {
+		obj = obj.DeepCopyObject().(client.Object)
+		kind := core.JobKind(obj)
+		if kind != kubeflowv1.ClusterTrainingRuntimeKind && len(obj.GetNamespace()) == 0 {
+			obj.SetNamespace(opts.Namespace)
+		}
+		if runPolicy, _ := core.JobRunPolicyAndStatus(obj); runPolicy != nil {
+			if _, ok := reconcilers[kind]; !ok {
+				return nil, fmt.Errorf("unsupported job kind %q", kind)
+			}
+			// The jobs referencing a training runtime are defaulted once the runtime is merged, as by the webhooks.
+			if runPolicy.RuntimeRef == nil {
+				scheme.Default(obj)
+			}
+			jobs = append(jobs, obj)
+			builder = builder.WithStatusSubresource(obj)
+		}
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		inputs[objectKey(gvk, obj)] = true
+		builder = builder.WithObjects(obj)
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("no job found, expected any of %s", strings.Join(core.JobKinds, ", "))
+	}
+
+	c := &cluster{
+		client:  builder.Build(),
+		kube:    kubefake.NewSimpleClientset(),
+		volcano: volcanofake.NewSimpleClientset(),
+		scheme:  scheme,
+		synced:  inputs,
+	}
+	c.kube.PrependReactor("*", "*", c.mirror)
+	gangSchedulingSetupFunc, err := c.gangSchedulingSetupFunc(opts.GangSchedulerName)
+	if err != nil {
+		return nil, err
+	}
+	type jobReconciler struct {
+		reconcile.Reconciler
+		jc  *common.JobController
+		req reconcile.Request
+	}
+	var jobReconcilers []jobReconciler
+	for _, job := range jobs {
+		r, jc := reconcilers[core.JobKind(job)](c, gangSchedulingSetupFunc)
+		jc.KubeClientSet = c.kube
+		jc.PodControl = control.RealPodControl{KubeClient: c.kube, Recorder: jc.Recorder}
+		jc.ServiceControl = control.RealServiceControl{KubeClient: c.kube, Recorder: jc.Recorder}
+		jobReconcilers = append(jobReconcilers, jobReconciler{
+			Reconciler: r,
+			jc:         jc,
+			req:        reconcile.Request{NamespacedName: client.ObjectKeyFromObject(job)},
+		})
+	}
+
+	for pass := 0; pass < maxPasses; pass++ {
+		for _, r := range jobReconcilers {
+			// There are no informers to observe the created pods and services.
+			r.jc.Expectations = expectation.NewControllerExpectations()
+			if _, err = r.Reconcile(ctx, r.req); err != nil {
+				return nil, fmt.Errorf("failed to reconcile %s: %w", r.req.NamespacedName, err)
+			}
+		}
+		changed, err := c.sync(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			break
+		}
+	}
+	return c.created(ctx)
+}
+
+// cluster is the in-memory cluster of the controllers. It implements the parts of manager.Manager
+// used to create the reconcilers.
+type cluster struct {
+	manager.Manager
+
+	client  client.Client
+	kube    *kubefake.Clientset
+	volcano *volcanofake.Clientset
+	scheme  *runtime.Scheme
+	// synced holds the keys of the objects the controllers didn't create through the client,
+	// the inputs and the objects created through the clientsets.
+	synced map[string]bool
+	// changed is whether an object was created or admitted since the last sync.
+	changed bool
+}
+
+func (c *cluster) GetClient() client.Client       { return c.client }
+func (c *cluster) GetAPIReader() client.Reader    { return c.client }
+func (c *cluster) GetScheme() *runtime.Scheme     { return c.scheme }
+func (c *cluster) GetConfig() *rest.Config        { return &rest.Config{Host: "http://render.invalid"} }
+func (c *cluster) GetRESTMapper() meta.RESTMapper { return c.client.RESTMapper() }
+
+func (c *cluster) GetEventRecorderFor(string) record.EventRecorder {
+	// The events are dropped.
+	return &record.FakeRecorder{}
+}
+
+func (c *cluster) gangSchedulingSetupFunc(gangSchedulerName string) (common.GangSchedulingSetupFunc, error) {
+	if len(gangSchedulerName) == 0 || strings.EqualFold(gangSchedulerName, string(common.GangSchedulerNone)) {
+		return common.GenNonGangSchedulerSetupFunc(), nil
+	}
+	if strings.EqualFold(gangSchedulerName, control.PodGroupBackendVolcano) {
+		return common.GenPodGroupSetupFunc(gangSchedulerName, control.NewVolcanoControl(c.volcano)), nil
+	}
+	backend, registered := control.GetPodGroupBackend(gangSchedulerName)
+	if !registered {
+		backend = control.SchedulerPluginsBackend
+	}
+	pgControl, err := backend.New(c, gangSchedulerName)
+	if err != nil {
+		return nil, err
+	}
+	return common.GenPodGroupSetupFunc(gangSchedulerName, pgControl), nil
+}
+
+// mirror is a reactor of the Kubernetes clientset writing the objects created through the clientset to
+// the client the controllers read from, as the informers of the controllers would.
+func (c *cluster) mirror(action clienttesting.Action) (bool, runtime.Object, error) {
+	ctx := context.Background()
+	var err error
+	switch action.GetVerb() {
+	case "create":
+		obj := action.(clienttesting.CreateAction).GetObject().DeepCopyObject().(client.Object)
+		if len(obj.GetNamespace()) == 0 {
+			obj.SetNamespace(action.GetNamespace())
+		}
+		obj.SetResourceVersion("")
+		if err = c.client.Create(ctx, obj); err == nil {
+			c.synced[objectKey(c.gvkFor(action.GetResource()), obj)] = true
+			c.changed = true
+		}
+	case "update":
+		obj := action.(clienttesting.UpdateAction).GetObject().DeepCopyObject().(client.Object)
+		current := obj.DeepCopyObject().(client.Object)
+		if err = c.client.Get(ctx, client.ObjectKeyFromObject(obj), current); err == nil {
+			obj.SetResourceVersion(current.GetResourceVersion())
+			if action.GetSubresource() == "status" {
+				err = c.client.Status().Update(ctx, obj)
+			} else {
+				err = c.client.Update(ctx, obj)
+			}
+		}
+	case "delete":
+		obj, newErr := c.scheme.New(c.gvkFor(action.GetResource()))
+		if newErr != nil {
+			return true, nil, newErr
+		}
+		deleted := obj.(client.Object)
+		deleted.SetNamespace(action.GetNamespace())
+		deleted.SetName(action.(clienttesting.DeleteAction).GetName())
+		err = c.client.Delete(ctx, deleted)
+	}
+	if err != nil && !errors.IsAlreadyExists(err) && !errors.IsNotFound(err) {
+		return true, nil, err
+	}
+	// The action is handled by the object tracker of the clientset.
+	return false, nil, nil
+}
+
+func (c *cluster) gvkFor(gvr schema.GroupVersionResource) schema.GroupVersionKind {
+	for _, res := range kubeResources {
+		if res.gvr == gvr {
+			return res.gvk
+		}
+	}
+	return schema.GroupVersionKind{}
+}
+
+// sync starts the pods and admits the PodGroups. It returns whether an object was created since the last sync.
+func (c *cluster) sync(ctx context.Context) (bool, error) {
+	pods, err := c.kube.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		startPod(pod)
+		if _, err = c.kube.CoreV1().Pods(pod.Namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{}); err != nil {
+			return false, err
+		}
+	}
+
+	// The pods of the jobs are created once the PodGroup is admitted by volcano.
+	podGroups, err := c.volcano.SchedulingV1beta1().PodGroups(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for i := range podGroups.Items {
+		podGroup := &podGroups.Items[i]
+		if len(podGroup.Status.Phase) == 0 || podGroup.Status.Phase == volcanov1beta1.PodGroupPending {
+			podGroup.Status.Phase = volcanov1beta1.PodGroupInqueue
+			if _, err = c.volcano.SchedulingV1beta1().PodGroups(podGroup.Namespace).UpdateStatus(ctx, podGroup, metav1.UpdateOptions{}); err != nil {
+				return false, err
+			}
+			c.changed = true
+		}
+	}
+	changed := c.changed
+	c.changed = false
+	return changed, nil
+}
+
+// startPod marks the pod as scheduled, running and ready.
+func startPod(pod *corev1.Pod) {
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.PodIP = "10.0.0.1"
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	pod.Status.ContainerStatuses = nil
+	for _, container := range pod.Spec.Containers {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:    container.Name,
+			Ready:   true,
+			Started: ptrTrue(),
+			State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+	}
+}
+
+func ptrTrue() *bool {
+	started := true
+	return &started
+}
+
+// created returns the objects created by the controllers, as last written by the controllers.
+func (c *cluster) created(ctx context.Context) ([]client.Object, error) {
+	var objs []client.Object
+	for _, res := range kubeResources {
+		tracked, err := listTracked(c.kube.Tracker(), res)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, tracked...)
+	}
+	tracked, err := listTracked(c.volcano.Tracker(), podGroupResource)
+	if err != nil {
+		return nil, err
+	}
+	objs = append(objs, tracked...)
+
+	for _, res := range clientResources {
+		list, err := c.scheme.New(res.gvk.GroupVersion().WithKind(res.gvk.Kind + "List"))
+		if err != nil {
+			return nil, err
+		}
+		if err = c.client.List(ctx, list.(client.ObjectList)); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if obj := item.(client.Object); !c.synced[objectKey(res.gvk, obj)] {
+				objs = append(objs, obj)
+			}
+		}
+	}
+
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, c.scheme)
+		if err != nil {
+			return nil, err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	sort.SliceStable(objs, func(i, j int) bool {
+		oi, oj := kindOrder(objs[i]), kindOrder(objs[j])
+		if oi != oj {
+			return oi < oj
+		}
+		return client.ObjectKeyFromObject(objs[i]).String() < client.ObjectKeyFromObject(objs[j]).String()
+	})
+	return objs, nil
+}
+
+func listTracked(tracker clienttesting.ObjectTracker, res resource) ([]client.Object, error) {
+	list, err := tracker.List(res.gvr, res.gvk, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(items))
+	for _, item := range items {
+		objs = append(objs, item.DeepCopyObject().(client.Object))
+	}
+	return objs, nil
+}
+
+func objectKey(gvk schema.GroupVersionKind, obj client.Object) string {
+	return gvk.GroupKind().String() + "/" + client.ObjectKeyFromObject(obj).String()
+}
+
+func kindOrder(obj client.Object) int {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	for i, res := range order {
+		if res.gvk == gvk {
+			return i
+		}
+	}
+	return len(order)
+}
+
+// Print writes the objects as a YAML stream. The fields set by the API server and the status are
+// omitted, and the values of the secrets are redacted since the SSH keys of the MPIJobs are random.
+func Print(w io.Writer, objs []client.Object) error {
+	for i, obj := range objs {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		fields := map[string]interface{}{}
+		if err = json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		delete(fields, "status")
+		if metadata, ok := fields["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "resourceVersion")
+			delete(metadata, "creationTimestamp")
+		}
+		if secretData, ok := fields["data"].(map[string]interface{}); ok && obj.GetObjectKind().GroupVersionKind() == secretResource.gvk {
+			for key := range secretData {
+				secretData[key] = ""
+			}
+		}
+		out, err := yaml.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		if i != 0 {
+			if _, err = io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err = w.Write(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
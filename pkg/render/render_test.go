@@ -0,0 +1,109 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var update = flag.Bool("update", false, "Update the golden files of testdata.")
+
+func TestRender(t *testing.T) {
+	cases := map[string]struct {
+		input             string
+		gangSchedulerName string
+	}{
+		"pytorchjob": {
+			input: "pytorchjob.yaml",
+		},
+		"mpijob with the ssh launcher mode": {
+			input: "mpijob-ssh.yaml",
+		},
+		"tfjob with volcano": {
+			input:             "tfjob-volcano.yaml",
+			gangSchedulerName: "volcano",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in, err := os.Open(filepath.Join("testdata", tc.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer in.Close()
+			objs, err := Decode(in, NewScheme())
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			rendered, err := Render(objs, Options{GangSchedulerName: tc.gangSchedulerName})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			got := &bytes.Buffer{}
+			if err = Print(got, rendered); err != nil {
+				t.Fatalf("Print() error = %v", err)
+			}
+
+			golden := filepath.Join("testdata", strings.TrimSuffix(tc.input, ".yaml")+".golden.yaml")
+			if *update {
+				if err = os.WriteFile(golden, got.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(want), got.String()); len(diff) != 0 {
+				t.Errorf("Unexpected objects, run the test with -update to update the golden file (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	cases := map[string]string{
+		"no job": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`,
+		"unknown kind": `
+apiVersion: kubeflow.org/v1
+kind: NotAJob
+metadata:
+  name: job
+`,
+	}
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			objs, err := Decode(strings.NewReader(input), NewScheme())
+			if err == nil {
+				_, err = Render(objs, Options{})
+			}
+			if err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		spec    string
+		wantErr bool
+	}{
+		"every minute":      {spec: "* * * * *"},
+		"lists and ranges":  {spec: "0,30 1-5 1,15 jan-jun mon-fri"},
+		"steps":             {spec: "*/15 0-12/3 */2 * *"},
+		"sunday as 7":       {spec: "0 0 * * 7"},
+		"macro":             {spec: "@daily"},
+		"too few fields":    {spec: "0 0 * *", wantErr: true},
+		"out of range":      {spec: "60 * * * *", wantErr: true},
+		"invalid range":     {spec: "0 5-1 * * *", wantErr: true},
+		"invalid step":      {spec: "*/0 * * * *", wantErr: true},
+		"unknown name":      {spec: "0 0 * foo *", wantErr: true},
+		"unknown macro":     {spec: "@every", wantErr: true},
+		"seconds not valid": {spec: "0 0 0 * * *", wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(tc.spec)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Unexpected error: \nwant: %v\ngot: %v\n", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("Time zone database is not available: %v", err)
+	}
+	from := time.Date(2024, time.January, 31, 10, 17, 30, 0, time.UTC)
+	cases := map[string]struct {
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		"every minute": {
+			spec: "* * * * *",
+			from: from,
+			want: time.Date(2024, time.January, 31, 10, 18, 0, 0, time.UTC),
+		},
+		"on the scheduled time": {
+			spec: "0 2 * * *",
+			from: time.Date(2024, time.January, 31, 2, 0, 0, 0, time.UTC),
+			want: time.Date(2024, time.February, 1, 2, 0, 0, 0, time.UTC),
+		},
+		"daily": {
+			spec: "0 2 * * *",
+			from: from,
+			want: time.Date(2024, time.February, 1, 2, 0, 0, 0, time.UTC),
+		},
+		"step": {
+			spec: "*/20 * * * *",
+			from: from,
+			want: time.Date(2024, time.January, 31, 10, 20, 0, 0, time.UTC),
+		},
+		"leap day": {
+			spec: "0 0 29 2 *",
+			from: from,
+			want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"day of week": {
+			spec: "30 8 * * mon",
+			from: from,
+			want: time.Date(2024, time.February, 5, 8, 30, 0, 0, time.UTC),
+		},
+		"day of month or day of week": {
+			spec: "0 0 15 * fri",
+			from: from,
+			want: time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+		"end of year": {
+			spec: "@yearly",
+			from: from,
+			want: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"time zone": {
+			spec: "0 2 * * *",
+			from: from.In(paris),
+			want: time.Date(2024, time.February, 1, 2, 0, 0, 0, paris),
+		},
+		"skipped by daylight saving time": {
+			spec: "30 2 * * *",
+			from: time.Date(2024, time.March, 30, 12, 0, 0, 0, paris),
+			want: time.Date(2024, time.April, 1, 2, 30, 0, 0, paris),
+		},
+		"never": {
+			spec: "0 0 30 2 *",
+			from: from,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := schedule.Next(tc.from); !got.Equal(tc.want) {
+				t.Errorf("Unexpected next time: \nwant: %v\ngot: %v\n", tc.want, got)
+			}
+		})
+	}
+}
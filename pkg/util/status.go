@@ -26,6 +26,28 @@ const (
 	JobSuspendedReason = "Suspended"
 	// JobResumedReason is added in a job when it is unsuspended.
 	JobResumedReason = "Resumed"
+	// JobCheckpointingReason is added in a job when a checkpoint is requested before its pods are deleted.
+	JobCheckpointingReason = "Checkpointing"
+	// JobCheckpointCompleteReason is added in a job when the checkpoint is complete.
+	JobCheckpointCompleteReason = "CheckpointComplete"
+	// JobCheckpointTimeoutReason is added in a job when the checkpoint is not complete within the timeout.
+	JobCheckpointTimeoutReason = "CheckpointTimeout"
+	// JobCheckpointLostReason is added in a job when the master pod is gone before completing the checkpoint.
+	JobCheckpointLostReason = "CheckpointLost"
+	// JobCheckpointSignalFailedReason is added in a job when a pod can't be sent the checkpoint signal.
+	JobCheckpointSignalFailedReason = "CheckpointSignalFailed"
+	// JobPodFailurePolicyReason is added in a job when it is failed by a rule of a pod failure policy.
+	JobPodFailurePolicyReason = "PodFailurePolicy"
+	// JobDeadlineExceededReason is the reason of the failure of a job which was active longer than its deadline.
+	JobDeadlineExceededReason = "DeadlineExceeded"
+	// JobFailedRuntimeReason is added in a job when the training runtime it references can't be resolved.
+	JobFailedRuntimeReason = "FailedRuntime"
+	// JobWaitingReason is added in a job when it waits for the jobs it depends on.
+	JobWaitingReason = "Waiting"
+	// JobDependenciesMetReason is added in a job when the jobs it depends on reached their condition.
+	JobDependenciesMetReason = "DependenciesMet"
+	// JobDependencyFailedReason is added in a job when a job it depends on can't reach its condition.
+	JobDependencyFailedReason = "DependencyFailed"
 )
 
 func NewReason(kind, reason string) string {
@@ -0,0 +1,121 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+// FakeAdmissionController admits suspended jobs with the QueueNameLabel in-process, the way a queueing
+// system such as Kueue does, so that the queueing integration can be tested with envtest.
+// Jobs are admitted to open queues only, with the admission of the queue.
+type FakeAdmissionController[J client.Object] struct {
+	client.Client
+	newJob       func() J
+	getRunPolicy func(J) *kubeflowv1.RunPolicy
+
+	mu     sync.Mutex
+	queues map[string]map[kubeflowv1.ReplicaType]kubeflowv1.ReplicaAdmission
+}
+
+// NewFakeAdmissionController creates a FakeAdmissionController for jobs of the type J.
+func NewFakeAdmissionController[J client.Object](c client.Client, newJob func() J,
+	getRunPolicy func(J) *kubeflowv1.RunPolicy) *FakeAdmissionController[J] {
+	return &FakeAdmissionController[J]{
+		Client:       c,
+		newJob:       newJob,
+		getRunPolicy: getRunPolicy,
+		queues:       map[string]map[kubeflowv1.ReplicaType]kubeflowv1.ReplicaAdmission{},
+	}
+}
+
+// SetupWithManager watches the jobs of the type J.
+func (a *FakeAdmissionController[J]) SetupWithManager(mgr ctrl.Manager, name string) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(a.newJob()).
+		Complete(a)
+}
+
+// OpenQueue admits the jobs submitted to the queue from now on with the admission.
+func (a *FakeAdmissionController[J]) OpenQueue(name string, admission map[kubeflowv1.ReplicaType]kubeflowv1.ReplicaAdmission) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.queues[name] = admission
+}
+
+// CloseQueue stops admitting the jobs submitted to the queue.
+func (a *FakeAdmissionController[J]) CloseQueue(name string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.queues, name)
+}
+
+// Reconcile resumes a suspended job with the admission of its queue if the queue is open.
+func (a *FakeAdmissionController[J]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	job := a.newJob()
+	if err := a.Get(ctx, req.NamespacedName, job); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	runPolicy := a.getRunPolicy(job)
+	if !ptr.Deref(runPolicy.Suspend, false) {
+		return ctrl.Result{}, nil
+	}
+	a.mu.Lock()
+	admission, ok := a.queues[job.GetLabels()[kubeflowv1.QueueNameLabel]]
+	a.mu.Unlock()
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+
+	value, err := json.Marshal(admission)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	annotations := job.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[kubeflowv1.AdmissionAnnotation] = string(value)
+	job.SetAnnotations(annotations)
+	runPolicy.Suspend = ptr.To(false)
+	err = a.Update(ctx, job)
+	if errors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+// Evict suspends an admitted job and removes its admission, the job is admitted again once its queue is open.
+func (a *FakeAdmissionController[J]) Evict(ctx context.Context, key client.ObjectKey) error {
+	job := a.newJob()
+	if err := a.Get(ctx, key, job); err != nil {
+		return err
+	}
+	annotations := job.GetAnnotations()
+	delete(annotations, kubeflowv1.AdmissionAnnotation)
+	job.SetAnnotations(annotations)
+	a.getRunPolicy(job).Suspend = ptr.To(true)
+	return a.Update(ctx, job)
+}
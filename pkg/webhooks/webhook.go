@@ -0,0 +1,163 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	"github.com/kubeflow/training-operator/pkg/core"
+)
+
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-tfjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=tfjobs,verbs=create;update,versions=v1,name=default-tfjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-tfjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=tfjobs,verbs=create;update,versions=v1,name=validate-tfjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-pytorchjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=pytorchjobs,verbs=create;update,versions=v1,name=default-pytorchjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-pytorchjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=pytorchjobs,verbs=create;update,versions=v1,name=validate-pytorchjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-mxjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=mxjobs,verbs=create;update,versions=v1,name=default-mxjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-mxjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=mxjobs,verbs=create;update,versions=v1,name=validate-mxjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-xgboostjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=xgboostjobs,verbs=create;update,versions=v1,name=default-xgboostjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-xgboostjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=xgboostjobs,verbs=create;update,versions=v1,name=validate-xgboostjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-mpijob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=mpijobs,verbs=create;update,versions=v1,name=default-mpijob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-mpijob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=mpijobs,verbs=create;update,versions=v1,name=validate-mpijob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-paddlejob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=paddlejobs,verbs=create;update,versions=v1,name=default-paddlejob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-paddlejob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=paddlejobs,verbs=create;update,versions=v1,name=validate-paddlejob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubeflow-org-v1-jaxjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=jaxjobs,verbs=create;update,versions=v1,name=default-jaxjob.kubeflow.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubeflow-org-v1-jaxjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=jaxjobs,verbs=create;update,versions=v1,name=validate-jaxjob.kubeflow.org,admissionReviewVersions=v1
+
+// JobKind provides the accessors of a job kind admitted by a Webhook.
+type JobKind[J client.Object] struct {
+	// Kind is the kind of the job, e.g. PyTorchJob.
+	Kind string
+	// NewJob returns an empty job object.
+	NewJob func() J
+	// GetReplicaSpecs returns the replica specs of the job.
+	GetReplicaSpecs func(job J) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec
+	// GetRunPolicy returns the run policy of the job.
+	GetRunPolicy func(job J) *kubeflowv1.RunPolicy
+	// SetDefaults sets the default values of the job.
+	SetDefaults func(job J)
+	// Validate validates the spec of the job.
+	Validate func(job J) error
+}
+
+// Webhook defaults and validates the jobs of a kind at admission time.
+type Webhook[J client.Object] struct {
+	// reader reads the training runtimes and the dependencies referenced by the jobs.
+	reader client.Reader
+	kind   JobKind[J]
+}
+
+var _ webhook.CustomDefaulter = &Webhook[*kubeflowv1.PyTorchJob]{}
+var _ webhook.CustomValidator = &Webhook[*kubeflowv1.PyTorchJob]{}
+
+// NewWebhook creates a Webhook for the job kind described by kind. The reader may be nil, the
+// training runtimes and the dependencies of the jobs aren't checked then.
+func NewWebhook[J client.Object](reader client.Reader, kind JobKind[J]) *Webhook[J] {
+	return &Webhook[J]{reader: reader, kind: kind}
+}
+
+// SetupWebhook registers the defaulting and validating webhooks of the job kind with the manager.
+func SetupWebhook[J client.Object](mgr ctrl.Manager, kind JobKind[J]) error {
+	w := NewWebhook(mgr.GetAPIReader(), kind)
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(kind.NewJob()).
+		WithDefaulter(w).
+		WithValidator(w).
+		RecoverPanic().
+		Complete()
+}
+
+// Default implements webhook.CustomDefaulter.
+func (w *Webhook[J]) Default(ctx context.Context, obj runtime.Object) error {
+	job, err := w.toJob(obj)
+	if err != nil {
+		return err
+	}
+	runPolicy := w.kind.GetRunPolicy(job)
+	// The jobs referencing a training runtime are defaulted once the runtime is merged.
+	if runPolicy.RuntimeRef == nil {
+		w.kind.SetDefaults(job)
+	}
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation == admissionv1.Create {
+		kubeflowv1.SetDefaultQueuedJobSuspended(job.GetLabels(), runPolicy)
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (w *Webhook[J]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	job, err := w.toJob(obj)
+	if err != nil {
+		return nil, err
+	}
+	return nil, w.validate(ctx, job)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (w *Webhook[J]) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldJob, err := w.toJob(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	job, err := w.toJob(newObj)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.validate(ctx, job); err != nil {
+		return nil, err
+	}
+	return nil, kubeflowv1.ValidateV1ReplicaSpecsUpdate(w.kind.GetReplicaSpecs(oldJob), w.kind.GetReplicaSpecs(job))
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (w *Webhook[J]) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the job merged with the training runtime it references, and rejects the
+// dependency cycles through the job.
+func (w *Webhook[J]) validate(ctx context.Context, job J) error {
+	if w.kind.GetRunPolicy(job).RuntimeRef != nil && w.reader != nil {
+		job = job.DeepCopyObject().(J)
+		if err := core.ApplyTrainingRuntime(ctx, w.reader, job, w.kind.GetReplicaSpecs(job), w.kind.GetRunPolicy(job)); err != nil {
+			return err
+		}
+		w.kind.SetDefaults(job)
+	}
+	if err := w.kind.Validate(job); err != nil {
+		return err
+	}
+	if w.reader == nil {
+		return nil
+	}
+	return core.ValidateDependencies(ctx, w.reader, job.GetNamespace(), w.kind.Kind, job.GetName(), w.kind.GetRunPolicy(job).DependsOn)
+}
+
+func (w *Webhook[J]) toJob(obj runtime.Object) (J, error) {
+	job, ok := obj.(J)
+	if !ok {
+		return job, fmt.Errorf("expected a %s but got a %T", w.kind.Kind, obj)
+	}
+	return job, nil
+}
@@ -0,0 +1,591 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+type jobWebhook interface {
+	webhook.CustomDefaulter
+	webhook.CustomValidator
+}
+
+var (
+	tfJobWebhook      jobWebhook = NewWebhook(nil, tfJobKind)
+	pyTorchJobWebhook jobWebhook = NewWebhook(nil, pyTorchJobKind)
+	mxJobWebhook      jobWebhook = NewWebhook(nil, mxJobKind)
+	xgboostJobWebhook jobWebhook = NewWebhook(nil, xgboostJobKind)
+	mpiJobWebhook     jobWebhook = NewWebhook(nil, mpiJobKind)
+	paddleJobWebhook  jobWebhook = NewWebhook(nil, paddleJobKind)
+	jaxJobWebhook     jobWebhook = NewWebhook(nil, jaxJobKind)
+)
+
+func newReplicaSpecs(containerName string, replicaTypes ...kubeflowv1.ReplicaType) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+	replicas := map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec{}
+	for _, rType := range replicaTypes {
+		replicas[rType] = &kubeflowv1.ReplicaSpec{
+			Replicas: ptr.To[int32](1),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  containerName,
+						Image: "docker.io/kubeflow/training-test:latest",
+					}},
+				},
+			},
+		}
+	}
+	return replicas
+}
+
+func newTFJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.TFJob {
+	return &kubeflowv1.TFJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.TFJobSpec{
+			TFReplicaSpecs: newReplicaSpecs(kubeflowv1.TFJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func newPyTorchJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.PyTorchJob {
+	return &kubeflowv1.PyTorchJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.PyTorchJobSpec{
+			PyTorchReplicaSpecs: newReplicaSpecs(kubeflowv1.PyTorchJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func newMXJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.MXJob {
+	return &kubeflowv1.MXJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.MXJobSpec{
+			MXReplicaSpecs: newReplicaSpecs(kubeflowv1.MXJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func newXGBoostJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.XGBoostJob {
+	return &kubeflowv1.XGBoostJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.XGBoostJobSpec{
+			XGBReplicaSpecs: newReplicaSpecs(kubeflowv1.XGBoostJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func newMPIJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.MPIJob {
+	return &kubeflowv1.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.MPIJobSpec{
+			MPIReplicaSpecs: newReplicaSpecs(kubeflowv1.MPIJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func newPaddleJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.PaddleJob {
+	return &kubeflowv1.PaddleJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.PaddleJobSpec{
+			PaddleReplicaSpecs: newReplicaSpecs(kubeflowv1.PaddleJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func newJAXJob(replicaTypes ...kubeflowv1.ReplicaType) *kubeflowv1.JAXJob {
+	return &kubeflowv1.JAXJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubeflowv1.JAXJobSpec{
+			JAXReplicaSpecs: newReplicaSpecs(kubeflowv1.JAXJobDefaultContainerName, replicaTypes...),
+		},
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	tfInvalidName := newTFJob(kubeflowv1.TFJobReplicaTypeWorker)
+	tfInvalidName.Name = "0-test"
+	tfNoImage := newTFJob(kubeflowv1.TFJobReplicaTypeWorker)
+	tfNoImage.Spec.TFReplicaSpecs[kubeflowv1.TFJobReplicaTypeWorker].Template.Spec.Containers[0].Image = ""
+	pyTorchInvalidName := newPyTorchJob(kubeflowv1.PyTorchJobReplicaTypeMaster)
+	pyTorchInvalidName.Name = "0-test"
+	mxInvalidName := newMXJob(kubeflowv1.MXJobReplicaTypeWorker)
+	mxInvalidName.Name = "0-test"
+	mxNoMXNetContainer := newMXJob(kubeflowv1.MXJobReplicaTypeWorker)
+	mxNoMXNetContainer.Spec.MXReplicaSpecs[kubeflowv1.MXJobReplicaTypeWorker].Template.Spec.Containers[0].Name = "main"
+	xgboostInvalidName := newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster)
+	xgboostInvalidName.Name = "0-test"
+	xgboostTwoMasters := newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster)
+	xgboostTwoMasters.Spec.XGBReplicaSpecs[kubeflowv1.XGBoostJobReplicaTypeMaster].Replicas = ptr.To[int32](2)
+	mpiTwoLaunchers := newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.MPIJobReplicaTypeWorker)
+	mpiTwoLaunchers.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher].Replicas = ptr.To[int32](2)
+	mpiInvalidLauncherMode := newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.MPIJobReplicaTypeWorker)
+	mpiInvalidLauncherMode.Spec.LauncherMode = ptr.To[kubeflowv1.MPIJobLauncherMode]("Invalid")
+	mpiOutOfElasticRange := newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.MPIJobReplicaTypeWorker)
+	mpiOutOfElasticRange.Spec.ElasticPolicy = &kubeflowv1.MPIJobElasticPolicy{MinReplicas: ptr.To[int32](2), MaxReplicas: ptr.To[int32](4)}
+	paddleInvalidName := newPaddleJob(kubeflowv1.PaddleJobReplicaTypeWorker)
+	paddleInvalidName.Name = "0-test"
+	jaxInvalidName := newJAXJob(kubeflowv1.JAXJobReplicaTypeWorker)
+	jaxInvalidName.Name = "0-test"
+	jaxNoImage := newJAXJob(kubeflowv1.JAXJobReplicaTypeWorker)
+	jaxNoImage.Spec.JAXReplicaSpecs[kubeflowv1.JAXJobReplicaTypeWorker].Template.Spec.Containers[0].Image = ""
+
+	testCases := map[string]struct {
+		webhook jobWebhook
+		job     runtime.Object
+		wantErr bool
+	}{
+		"valid TFJob": {
+			webhook: tfJobWebhook,
+			job:     newTFJob(kubeflowv1.TFJobReplicaTypeChief, kubeflowv1.TFJobReplicaTypePS, kubeflowv1.TFJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"TFJob with an invalid name": {
+			webhook: tfJobWebhook,
+			job:     tfInvalidName,
+			wantErr: true,
+		},
+		"TFJob container without image": {
+			webhook: tfJobWebhook,
+			job:     tfNoImage,
+			wantErr: true,
+		},
+		"TFJob with more than one chief or master": {
+			webhook: tfJobWebhook,
+			job:     newTFJob(kubeflowv1.TFJobReplicaTypeChief, kubeflowv1.TFJobReplicaTypeMaster),
+			wantErr: true,
+		},
+		"valid PyTorchJob": {
+			webhook: pyTorchJobWebhook,
+			job:     newPyTorchJob(kubeflowv1.PyTorchJobReplicaTypeMaster, kubeflowv1.PyTorchJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"PyTorchJob with an invalid name": {
+			webhook: pyTorchJobWebhook,
+			job:     pyTorchInvalidName,
+			wantErr: true,
+		},
+		"PyTorchJob with an invalid replica type": {
+			webhook: pyTorchJobWebhook,
+			job:     newPyTorchJob(kubeflowv1.TFJobReplicaTypePS),
+			wantErr: true,
+		},
+		"valid MXJob": {
+			webhook: mxJobWebhook,
+			job:     newMXJob(kubeflowv1.MXJobReplicaTypeScheduler, kubeflowv1.MXJobReplicaTypeServer, kubeflowv1.MXJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"MXJob with an invalid name": {
+			webhook: mxJobWebhook,
+			job:     mxInvalidName,
+			wantErr: true,
+		},
+		"MXJob without mxnet container": {
+			webhook: mxJobWebhook,
+			job:     mxNoMXNetContainer,
+			wantErr: true,
+		},
+		"valid XGBoostJob": {
+			webhook: xgboostJobWebhook,
+			job:     newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster, kubeflowv1.XGBoostJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"XGBoostJob with an invalid name": {
+			webhook: xgboostJobWebhook,
+			job:     xgboostInvalidName,
+			wantErr: true,
+		},
+		"XGBoostJob with an invalid replica type": {
+			webhook: xgboostJobWebhook,
+			job:     newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster, kubeflowv1.TFJobReplicaTypePS),
+			wantErr: true,
+		},
+		"XGBoostJob without master": {
+			webhook: xgboostJobWebhook,
+			job:     newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeWorker),
+			wantErr: true,
+		},
+		"XGBoostJob with more than one master replica": {
+			webhook: xgboostJobWebhook,
+			job:     xgboostTwoMasters,
+			wantErr: true,
+		},
+		"valid MPIJob": {
+			webhook: mpiJobWebhook,
+			job:     newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.MPIJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"MPIJob with an invalid replica type": {
+			webhook: mpiJobWebhook,
+			job:     newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.PyTorchJobReplicaTypeMaster),
+			wantErr: true,
+		},
+		"MPIJob without launcher": {
+			webhook: mpiJobWebhook,
+			job:     newMPIJob(kubeflowv1.MPIJobReplicaTypeWorker),
+			wantErr: true,
+		},
+		"MPIJob with more than one launcher replica": {
+			webhook: mpiJobWebhook,
+			job:     mpiTwoLaunchers,
+			wantErr: true,
+		},
+		"MPIJob with an invalid launcher mode": {
+			webhook: mpiJobWebhook,
+			job:     mpiInvalidLauncherMode,
+			wantErr: true,
+		},
+		"MPIJob with workers out of the elastic range": {
+			webhook: mpiJobWebhook,
+			job:     mpiOutOfElasticRange,
+			wantErr: true,
+		},
+		"valid PaddleJob": {
+			webhook: paddleJobWebhook,
+			job:     newPaddleJob(kubeflowv1.PaddleJobReplicaTypeMaster, kubeflowv1.PaddleJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"PaddleJob with an invalid name": {
+			webhook: paddleJobWebhook,
+			job:     paddleInvalidName,
+			wantErr: true,
+		},
+		"PaddleJob with an invalid replica type": {
+			webhook: paddleJobWebhook,
+			job:     newPaddleJob(kubeflowv1.TFJobReplicaTypePS),
+			wantErr: true,
+		},
+		"valid JAXJob": {
+			webhook: jaxJobWebhook,
+			job:     newJAXJob(kubeflowv1.JAXJobReplicaTypeWorker),
+			wantErr: false,
+		},
+		"JAXJob with an invalid name": {
+			webhook: jaxJobWebhook,
+			job:     jaxInvalidName,
+			wantErr: true,
+		},
+		"JAXJob container without image": {
+			webhook: jaxJobWebhook,
+			job:     jaxNoImage,
+			wantErr: true,
+		},
+		"JAXJob with an invalid replica type": {
+			webhook: jaxJobWebhook,
+			job:     newJAXJob(kubeflowv1.PyTorchJobReplicaTypeMaster),
+			wantErr: true,
+		},
+		"job of another kind": {
+			webhook: pyTorchJobWebhook,
+			job:     newTFJob(kubeflowv1.TFJobReplicaTypeWorker),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, got := tc.webhook.ValidateCreate(context.Background(), tc.job)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("ValidateCreate() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	tfJob := newTFJob(kubeflowv1.TFJobReplicaTypePS, kubeflowv1.TFJobReplicaTypeWorker)
+	tfScaled := tfJob.DeepCopy()
+	tfScaled.Spec.TFReplicaSpecs[kubeflowv1.TFJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+	pyTorchJob := newPyTorchJob(kubeflowv1.PyTorchJobReplicaTypeMaster, kubeflowv1.PyTorchJobReplicaTypeWorker)
+	pyTorchScaled := pyTorchJob.DeepCopy()
+	pyTorchScaled.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+	mxJob := newMXJob(kubeflowv1.MXJobReplicaTypeScheduler, kubeflowv1.MXJobReplicaTypeServer, kubeflowv1.MXJobReplicaTypeWorker)
+	mxScaled := mxJob.DeepCopy()
+	mxScaled.Spec.MXReplicaSpecs[kubeflowv1.MXJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+	xgboostJob := newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster, kubeflowv1.XGBoostJobReplicaTypeWorker)
+	xgboostScaled := xgboostJob.DeepCopy()
+	xgboostScaled.Spec.XGBReplicaSpecs[kubeflowv1.XGBoostJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+	mpiJob := newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.MPIJobReplicaTypeWorker)
+	mpiScaled := mpiJob.DeepCopy()
+	mpiScaled.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+	paddleJob := newPaddleJob(kubeflowv1.PaddleJobReplicaTypeMaster, kubeflowv1.PaddleJobReplicaTypeWorker)
+	paddleScaled := paddleJob.DeepCopy()
+	paddleScaled.Spec.PaddleReplicaSpecs[kubeflowv1.PaddleJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+	jaxJob := newJAXJob(kubeflowv1.JAXJobReplicaTypeWorker)
+	jaxScaled := jaxJob.DeepCopy()
+	jaxScaled.Spec.JAXReplicaSpecs[kubeflowv1.JAXJobReplicaTypeWorker].Replicas = ptr.To[int32](3)
+
+	testCases := map[string]struct {
+		webhook jobWebhook
+		oldJob  runtime.Object
+		job     runtime.Object
+		wantErr bool
+	}{
+		"TFJob workers are scaled": {
+			webhook: tfJobWebhook,
+			oldJob:  tfJob,
+			job:     tfScaled,
+			wantErr: false,
+		},
+		"TFJob ps replica type is removed": {
+			webhook: tfJobWebhook,
+			oldJob:  tfJob,
+			job:     newTFJob(kubeflowv1.TFJobReplicaTypeWorker),
+			wantErr: true,
+		},
+		"PyTorchJob workers are scaled": {
+			webhook: pyTorchJobWebhook,
+			oldJob:  pyTorchJob,
+			job:     pyTorchScaled,
+			wantErr: false,
+		},
+		"PyTorchJob worker replica type is removed": {
+			webhook: pyTorchJobWebhook,
+			oldJob:  pyTorchJob,
+			job:     newPyTorchJob(kubeflowv1.PyTorchJobReplicaTypeMaster),
+			wantErr: true,
+		},
+		"MXJob workers are scaled": {
+			webhook: mxJobWebhook,
+			oldJob:  mxJob,
+			job:     mxScaled,
+			wantErr: false,
+		},
+		"MXJob server replica type is removed": {
+			webhook: mxJobWebhook,
+			oldJob:  mxJob,
+			job:     newMXJob(kubeflowv1.MXJobReplicaTypeScheduler, kubeflowv1.MXJobReplicaTypeWorker),
+			wantErr: true,
+		},
+		"XGBoostJob workers are scaled": {
+			webhook: xgboostJobWebhook,
+			oldJob:  xgboostJob,
+			job:     xgboostScaled,
+			wantErr: false,
+		},
+		"XGBoostJob worker replica type is removed": {
+			webhook: xgboostJobWebhook,
+			oldJob:  xgboostJob,
+			job:     newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster),
+			wantErr: true,
+		},
+		"MPIJob workers are scaled": {
+			webhook: mpiJobWebhook,
+			oldJob:  mpiJob,
+			job:     mpiScaled,
+			wantErr: false,
+		},
+		"MPIJob worker replica type is removed": {
+			webhook: mpiJobWebhook,
+			oldJob:  mpiJob,
+			job:     newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher),
+			wantErr: true,
+		},
+		"PaddleJob workers are scaled": {
+			webhook: paddleJobWebhook,
+			oldJob:  paddleJob,
+			job:     paddleScaled,
+			wantErr: false,
+		},
+		"PaddleJob master replica type is removed": {
+			webhook: paddleJobWebhook,
+			oldJob:  paddleJob,
+			job:     newPaddleJob(kubeflowv1.PaddleJobReplicaTypeWorker),
+			wantErr: true,
+		},
+		"JAXJob workers are scaled": {
+			webhook: jaxJobWebhook,
+			oldJob:  jaxJob,
+			job:     jaxScaled,
+			wantErr: false,
+		},
+		"JAXJob worker replica type is removed": {
+			webhook: jaxJobWebhook,
+			oldJob:  jaxJob,
+			job:     newJAXJob(),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, got := tc.webhook.ValidateUpdate(context.Background(), tc.oldJob, tc.job)
+			if (got != nil) != tc.wantErr {
+				t.Fatalf("ValidateUpdate() error = %v, wantErr %v", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	tfJob := newTFJob(kubeflowv1.TFJobReplicaTypeWorker)
+	tfJob.Spec.TFReplicaSpecs[kubeflowv1.TFJobReplicaTypeWorker].Replicas = nil
+	pyTorchJob := newPyTorchJob(kubeflowv1.PyTorchJobReplicaTypeMaster, kubeflowv1.PyTorchJobReplicaTypeWorker)
+	pyTorchJob.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeMaster].Replicas = nil
+	pyTorchJob.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].Template.Spec.Containers = nil
+	mxJob := newMXJob(kubeflowv1.MXJobReplicaTypeWorker)
+	mxJob.Spec.MXReplicaSpecs[kubeflowv1.MXJobReplicaTypeWorker].Replicas = nil
+	xgboostJob := newXGBoostJob(kubeflowv1.XGBoostJobReplicaTypeMaster)
+	xgboostJob.Spec.XGBReplicaSpecs[kubeflowv1.XGBoostJobReplicaTypeMaster].Replicas = nil
+	mpiJob := newMPIJob(kubeflowv1.MPIJobReplicaTypeLauncher, kubeflowv1.MPIJobReplicaTypeWorker)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher].Replicas = nil
+	// The replica types of a PaddleJob are camel cased by the defaulting.
+	paddleJob := newPaddleJob()
+	paddleJob.Spec.PaddleReplicaSpecs["worker"] = newReplicaSpecs(kubeflowv1.PaddleJobDefaultContainerName, "worker")["worker"]
+	paddleJob.Spec.PaddleReplicaSpecs["worker"].Replicas = nil
+	jaxJob := newJAXJob(kubeflowv1.JAXJobReplicaTypeWorker)
+	jaxJob.Spec.JAXReplicaSpecs[kubeflowv1.JAXJobReplicaTypeWorker].Replicas = nil
+
+	testCases := map[string]struct {
+		webhook           jobWebhook
+		job               runtime.Object
+		replicaSpec       func() *kubeflowv1.ReplicaSpec
+		runPolicy         *kubeflowv1.RunPolicy
+		wantRestartPolicy kubeflowv1.RestartPolicy
+		// wantPortName is empty for the kinds without a default port.
+		wantPortName string
+	}{
+		"TFJob": {
+			webhook:           tfJobWebhook,
+			job:               tfJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return tfJob.Spec.TFReplicaSpecs[kubeflowv1.TFJobReplicaTypeWorker] },
+			runPolicy:         &tfJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.TFJobDefaultRestartPolicy,
+			wantPortName:      kubeflowv1.TFJobDefaultPortName,
+		},
+		"PyTorchJob": {
+			webhook:           pyTorchJobWebhook,
+			job:               pyTorchJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return pyTorchJob.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeMaster] },
+			runPolicy:         &pyTorchJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.PyTorchJobDefaultRestartPolicy,
+			wantPortName:      kubeflowv1.PyTorchJobDefaultPortName,
+		},
+		"MXJob": {
+			webhook:           mxJobWebhook,
+			job:               mxJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return mxJob.Spec.MXReplicaSpecs[kubeflowv1.MXJobReplicaTypeWorker] },
+			runPolicy:         &mxJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.MXJobDefaultRestartPolicy,
+			wantPortName:      kubeflowv1.MXJobDefaultPortName,
+		},
+		"XGBoostJob": {
+			webhook:           xgboostJobWebhook,
+			job:               xgboostJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return xgboostJob.Spec.XGBReplicaSpecs[kubeflowv1.XGBoostJobReplicaTypeMaster] },
+			runPolicy:         &xgboostJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.XGBoostJobDefaultRestartPolicy,
+			wantPortName:      kubeflowv1.XGBoostJobDefaultPortName,
+		},
+		"MPIJob": {
+			webhook:           mpiJobWebhook,
+			job:               mpiJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return mpiJob.Spec.MPIReplicaSpecs[kubeflowv1.MPIJobReplicaTypeLauncher] },
+			runPolicy:         &mpiJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.MPIJobDefaultRestartPolicy,
+		},
+		"PaddleJob": {
+			webhook:           paddleJobWebhook,
+			job:               paddleJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return paddleJob.Spec.PaddleReplicaSpecs[kubeflowv1.PaddleJobReplicaTypeWorker] },
+			runPolicy:         &paddleJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.PaddleJobDefaultRestartPolicy,
+			wantPortName:      kubeflowv1.PaddleJobDefaultPortName,
+		},
+		"JAXJob": {
+			webhook:           jaxJobWebhook,
+			job:               jaxJob,
+			replicaSpec:       func() *kubeflowv1.ReplicaSpec { return jaxJob.Spec.JAXReplicaSpecs[kubeflowv1.JAXJobReplicaTypeWorker] },
+			runPolicy:         &jaxJob.Spec.RunPolicy,
+			wantRestartPolicy: kubeflowv1.JAXJobDefaultRestartPolicy,
+			wantPortName:      kubeflowv1.JAXJobDefaultPortName,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := tc.webhook.Default(context.Background(), tc.job); err != nil {
+				t.Fatalf("Default() error = %v", err)
+			}
+			spec := tc.replicaSpec()
+			if spec == nil {
+				t.Fatalf("expected the replica spec to be defaulted, got %v", tc.job)
+			}
+			if got := ptr.Deref(spec.Replicas, 0); got != 1 {
+				t.Errorf("expected 1 replica, got %d", got)
+			}
+			if spec.RestartPolicy != tc.wantRestartPolicy {
+				t.Errorf("expected restart policy %q, got %q", tc.wantRestartPolicy, spec.RestartPolicy)
+			}
+			if ports := spec.Template.Spec.Containers[0].Ports; tc.wantPortName != "" && (len(ports) != 1 || ports[0].Name != tc.wantPortName) {
+				t.Errorf("expected default port to be set, got %v", ports)
+			}
+			if tc.runPolicy.CleanPodPolicy == nil || *tc.runPolicy.CleanPodPolicy != kubeflowv1.CleanPodPolicyNone {
+				t.Errorf("expected default clean pod policy to be set")
+			}
+		})
+	}
+}
+
+func TestDefaultQueuedJob(t *testing.T) {
+	testCases := map[string]struct {
+		operation   admissionv1.Operation
+		labels      map[string]string
+		wantSuspend bool
+	}{
+		"queued job is created": {
+			operation:   admissionv1.Create,
+			labels:      map[string]string{kubeflowv1.QueueNameLabel: "user-queue"},
+			wantSuspend: true,
+		},
+		"queued job is updated": {
+			operation:   admissionv1.Update,
+			labels:      map[string]string{kubeflowv1.QueueNameLabel: "user-queue"},
+			wantSuspend: false,
+		},
+		"job without queue is created": {
+			operation:   admissionv1.Create,
+			wantSuspend: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			job := newPyTorchJob(kubeflowv1.PyTorchJobReplicaTypeMaster)
+			job.Labels = tc.labels
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{Operation: tc.operation},
+			})
+			if err := pyTorchJobWebhook.Default(ctx, job); err != nil {
+				t.Fatalf("Default() error = %v", err)
+			}
+			if got := ptr.Deref(job.Spec.RunPolicy.Suspend, false); got != tc.wantSuspend {
+				t.Errorf("expected suspend %v, got %v", tc.wantSuspend, got)
+			}
+		})
+	}
+}
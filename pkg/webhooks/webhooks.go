@@ -0,0 +1,118 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package webhooks
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+type WebhookSetupFunc func(manager manager.Manager) error
+
+var SupportedSchemeWebhook = map[string]WebhookSetupFunc{
+	kubeflowv1.TFJobKind:      newSetupFunc(tfJobKind),
+	kubeflowv1.PyTorchJobKind: newSetupFunc(pyTorchJobKind),
+	kubeflowv1.MXJobKind:      newSetupFunc(mxJobKind),
+	kubeflowv1.XGBoostJobKind: newSetupFunc(xgboostJobKind),
+	kubeflowv1.MPIJobKind:     newSetupFunc(mpiJobKind),
+	kubeflowv1.PaddleJobKind:  newSetupFunc(paddleJobKind),
+	kubeflowv1.JAXJobKind:     newSetupFunc(jaxJobKind),
+}
+
+func newSetupFunc[J client.Object](kind JobKind[J]) WebhookSetupFunc {
+	return func(mgr manager.Manager) error {
+		return SetupWebhook(mgr, kind)
+	}
+}
+
+var tfJobKind = JobKind[*kubeflowv1.TFJob]{
+	Kind:   kubeflowv1.TFJobKind,
+	NewJob: func() *kubeflowv1.TFJob { return &kubeflowv1.TFJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.TFJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.TFReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.TFJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_TFJob,
+	Validate:     kubeflowv1.ValidateV1TFJob,
+}
+
+var pyTorchJobKind = JobKind[*kubeflowv1.PyTorchJob]{
+	Kind:   kubeflowv1.PyTorchJobKind,
+	NewJob: func() *kubeflowv1.PyTorchJob { return &kubeflowv1.PyTorchJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.PyTorchJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.PyTorchReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.PyTorchJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_PyTorchJob,
+	Validate:     kubeflowv1.ValidateV1PyTorchJob,
+}
+
+var mxJobKind = JobKind[*kubeflowv1.MXJob]{
+	Kind:   kubeflowv1.MXJobKind,
+	NewJob: func() *kubeflowv1.MXJob { return &kubeflowv1.MXJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.MXJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.MXReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.MXJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_MXJob,
+	Validate:     kubeflowv1.ValidateV1MXJob,
+}
+
+var xgboostJobKind = JobKind[*kubeflowv1.XGBoostJob]{
+	Kind:   kubeflowv1.XGBoostJobKind,
+	NewJob: func() *kubeflowv1.XGBoostJob { return &kubeflowv1.XGBoostJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.XGBoostJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.XGBReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.XGBoostJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_XGBoostJob,
+	Validate:     kubeflowv1.ValidateV1XGBoostJob,
+}
+
+var mpiJobKind = JobKind[*kubeflowv1.MPIJob]{
+	Kind:   kubeflowv1.MPIJobKind,
+	NewJob: func() *kubeflowv1.MPIJob { return &kubeflowv1.MPIJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.MPIJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.MPIReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.MPIJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_MPIJob,
+	Validate:     func(job *kubeflowv1.MPIJob) error { return kubeflowv1.ValidateV1MpiJobSpec(&job.Spec) },
+}
+
+var paddleJobKind = JobKind[*kubeflowv1.PaddleJob]{
+	Kind:   kubeflowv1.PaddleJobKind,
+	NewJob: func() *kubeflowv1.PaddleJob { return &kubeflowv1.PaddleJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.PaddleJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.PaddleReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.PaddleJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_PaddleJob,
+	Validate:     kubeflowv1.ValidateV1PaddleJob,
+}
+
+var jaxJobKind = JobKind[*kubeflowv1.JAXJob]{
+	Kind:   kubeflowv1.JAXJobKind,
+	NewJob: func() *kubeflowv1.JAXJob { return &kubeflowv1.JAXJob{} },
+	GetReplicaSpecs: func(job *kubeflowv1.JAXJob) map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaSpec {
+		return job.Spec.JAXReplicaSpecs
+	},
+	GetRunPolicy: func(job *kubeflowv1.JAXJob) *kubeflowv1.RunPolicy { return &job.Spec.RunPolicy },
+	SetDefaults:  kubeflowv1.SetDefaults_JAXJob,
+	Validate:     kubeflowv1.ValidateV1JAXJob,
+}